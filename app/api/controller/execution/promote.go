@@ -0,0 +1,122 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package execution
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/pipeline/triggerer"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+type PromoteInput struct {
+	Target string            `json:"target"`
+	Params map[string]string `json:"params"`
+}
+
+func (in *PromoteInput) sanitize() error {
+	in.Target = strings.TrimSpace(in.Target)
+	if in.Target == "" {
+		return usererror.BadRequest("Target environment is required")
+	}
+
+	return nil
+}
+
+// Promote creates a new execution of the pipeline for the commit of the provided execution,
+// targeting the provided deployment environment. The new execution references the original one as its parent.
+func (c *Controller) Promote(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	pipelineIdentifier string,
+	executionNum int64,
+	in *PromoteInput,
+) (*types.Execution, error) {
+	if err := in.sanitize(); err != nil {
+		return nil, err
+	}
+
+	repo, err := c.repoStore.FindByRef(ctx, repoRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find repo by ref: %w", err)
+	}
+	err = apiauth.CheckPipeline(ctx, c.authorizer, session, repo.Path,
+		pipelineIdentifier, enum.PermissionPipelineExecute)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authorize: %w", err)
+	}
+
+	pipeline, err := c.pipelineStore.FindByIdentifier(ctx, repo.ID, pipelineIdentifier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pipeline: %w", err)
+	}
+
+	execution, err := c.executionStore.FindByNumber(ctx, pipeline.ID, executionNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find execution %d: %w", executionNum, err)
+	}
+
+	if execution.Status != enum.CIStatusSuccess {
+		return nil, usererror.BadRequest("Only successful executions can be promoted")
+	}
+
+	params := make(map[string]string, len(execution.Params)+len(in.Params))
+	for k, v := range execution.Params {
+		params[k] = v
+	}
+	for k, v := range in.Params {
+		params[k] = v
+	}
+
+	hook := &triggerer.Hook{
+		Parent:       execution.Number,
+		Trigger:      session.Principal.UID, // who/what triggered the build, different from commit author
+		TriggeredBy:  session.Principal.ID,
+		Action:       execution.Action,
+		Link:         execution.Link,
+		Timestamp:    execution.Timestamp,
+		Title:        execution.Title,
+		Message:      execution.Message,
+		Before:       execution.Before,
+		After:        execution.After,
+		Ref:          execution.Ref,
+		Source:       execution.Source,
+		Target:       execution.Target,
+		AuthorLogin:  execution.Author,
+		AuthorName:   execution.AuthorName,
+		AuthorEmail:  execution.AuthorEmail,
+		AuthorAvatar: execution.AuthorAvatar,
+		Deployment:   in.Target,
+		Sender:       session.Principal.UID,
+		Params:       params,
+	}
+
+	promoted, err := c.triggerer.Trigger(ctx, pipeline, hook)
+	if err != nil {
+		return nil, fmt.Errorf("failed to trigger promotion: %w", err)
+	}
+	if promoted == nil {
+		return nil, usererror.BadRequest("No pipeline matches the promotion target")
+	}
+
+	return promoted, nil
+}
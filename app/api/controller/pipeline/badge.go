@@ -0,0 +1,70 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/drone/go-scm/scm"
+)
+
+// BadgeStatus returns the status of the latest execution of the pipeline for the provided branch.
+// If no branch is provided, the default branch of the pipeline (or the repository) is used.
+// An empty status is returned in case the pipeline wasn't executed for the branch yet.
+func (c *Controller) BadgeStatus(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	identifier string,
+	branch string,
+) (enum.CIStatus, error) {
+	repo, err := c.repoStore.FindByRef(ctx, repoRef)
+	if err != nil {
+		return "", fmt.Errorf("failed to find repo by ref: %w", err)
+	}
+	err = apiauth.CheckPipeline(ctx, c.authorizer, session, repo.Path, identifier, enum.PermissionPipelineView)
+	if err != nil {
+		return "", fmt.Errorf("failed to authorize pipeline: %w", err)
+	}
+
+	pipeline, err := c.pipelineStore.FindByIdentifier(ctx, repo.ID, identifier)
+	if err != nil {
+		return "", fmt.Errorf("failed to find pipeline: %w", err)
+	}
+
+	if branch == "" {
+		branch = pipeline.DefaultBranch
+		if branch == "" {
+			branch = repo.DefaultBranch
+		}
+	}
+
+	execution, err := c.executionStore.FindLatestByRef(ctx, pipeline.ID, scm.ExpandRef(branch, "refs/heads"))
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to find latest execution: %w", err)
+	}
+
+	return execution.Status, nil
+}
@@ -21,12 +21,13 @@ import (
 )
 
 type Controller struct {
-	defaultBranch string
-	repoStore     store.RepoStore
-	triggerStore  store.TriggerStore
-	authorizer    authz.Authorizer
-	pipelineStore store.PipelineStore
-	reporter      events.Reporter
+	defaultBranch  string
+	repoStore      store.RepoStore
+	triggerStore   store.TriggerStore
+	authorizer     authz.Authorizer
+	pipelineStore  store.PipelineStore
+	executionStore store.ExecutionStore
+	reporter       events.Reporter
 }
 
 func NewController(
@@ -34,13 +35,15 @@ func NewController(
 	repoStore store.RepoStore,
 	triggerStore store.TriggerStore,
 	pipelineStore store.PipelineStore,
+	executionStore store.ExecutionStore,
 	reporter events.Reporter,
 ) *Controller {
 	return &Controller{
-		repoStore:     repoStore,
-		triggerStore:  triggerStore,
-		authorizer:    authorizer,
-		pipelineStore: pipelineStore,
-		reporter:      reporter,
+		repoStore:      repoStore,
+		triggerStore:   triggerStore,
+		authorizer:     authorizer,
+		pipelineStore:  pipelineStore,
+		executionStore: executionStore,
+		reporter:       reporter,
 	}
 }
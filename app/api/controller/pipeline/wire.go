@@ -32,6 +32,7 @@ func ProvideController(
 	triggerStore store.TriggerStore,
 	authorizer authz.Authorizer,
 	pipelineStore store.PipelineStore,
+	executionStore store.ExecutionStore,
 	reporter *events.Reporter,
 ) *Controller {
 	return NewController(
@@ -39,6 +40,7 @@ func ProvideController(
 		repoStore,
 		triggerStore,
 		pipelineStore,
+		executionStore,
 		*reporter,
 	)
 }
@@ -42,12 +42,22 @@ func (c *Controller) ActivityList(
 		return nil, fmt.Errorf("failed to find pull request by number: %w", err)
 	}
 
+	// the principal's own pending review comments are included, all other pending comments are hidden
+	filter.PendingAuthorID = session.Principal.ID
+
 	list, err := c.activityStore.List(ctx, pr.ID, filter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list pull requests activities: %w", err)
 	}
 
+	reactions, err := c.listReactions(ctx, pr.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, act := range list {
+		act.Reactions = reactions[act.ID]
+
 		if act.Metadata != nil && act.Metadata.Mentions != nil {
 			mentions, err := c.principalInfoCache.Map(ctx, act.Metadata.Mentions.IDs)
 			if err != nil {
@@ -0,0 +1,249 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/api/controller"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/bootstrap"
+	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/errors"
+	"github.com/harness/gitness/git"
+	"github.com/harness/gitness/git/sha"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// CherryPickInput is used for cherry-picking a merged pull request to another branch.
+type CherryPickInput struct {
+	// TargetBranch is the branch to which the changes of the pull request are applied.
+	TargetBranch string `json:"target_branch"`
+
+	// CreatePullReq, if set, commits the changes to a new branch and opens
+	// a backport pull request for the target branch, instead of updating the target branch directly.
+	CreatePullReq bool `json:"create_pull_request"`
+	// NewBranch is the name of the branch created for the backport pull request.
+	// If not provided, it's generated from the pull request number and the target branch.
+	NewBranch string `json:"new_branch"`
+
+	DryRunRules bool `json:"dry_run_rules"`
+	BypassRules bool `json:"bypass_rules"`
+}
+
+func (in *CherryPickInput) sanitize() error {
+	in.TargetBranch = strings.TrimSpace(in.TargetBranch)
+	in.NewBranch = strings.TrimSpace(in.NewBranch)
+
+	if in.TargetBranch == "" {
+		return usererror.BadRequest("Target branch must be provided.")
+	}
+
+	if in.NewBranch != "" && !in.CreatePullReq {
+		return usererror.BadRequest("New branch can only be provided when creating a pull request.")
+	}
+
+	return nil
+}
+
+// CherryPick applies the changes of a merged pull request to another branch.
+// Optionally, the changes are committed to a new branch and a backport pull request is opened.
+//
+//nolint:gocognit,gocyclo,cyclop // refactor if needed.
+func (c *Controller) CherryPick(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	pullreqNum int64,
+	in *CherryPickInput,
+) (types.CherryPickOutput, []types.RuleViolations, error) {
+	if err := in.sanitize(); err != nil {
+		return types.CherryPickOutput{}, nil, err
+	}
+
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoPush)
+	if err != nil {
+		return types.CherryPickOutput{}, nil, fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	pr, err := c.pullreqStore.FindByNumber(ctx, repo.ID, pullreqNum)
+	if err != nil {
+		return types.CherryPickOutput{}, nil, fmt.Errorf("failed to get pull request by number: %w", err)
+	}
+
+	if pr.State != enum.PullReqStateMerged || pr.MergeSHA == nil || pr.MergeTargetSHA == nil {
+		return types.CherryPickOutput{}, nil, usererror.BadRequest("Only merged pull requests can be cherry-picked.")
+	}
+
+	if in.TargetBranch == pr.TargetBranch {
+		return types.CherryPickOutput{}, nil,
+			usererror.BadRequest("The pull request is already merged into the target branch.")
+	}
+
+	if _, err = c.verifyBranchExistence(ctx, repo, in.TargetBranch); err != nil {
+		return types.CherryPickOutput{}, nil, err
+	}
+
+	var refAction protection.RefAction
+	var branchName string
+	if in.CreatePullReq {
+		if in.NewBranch == "" {
+			in.NewBranch = fmt.Sprintf("cherry-pick-%d-to-%s", pr.Number, in.TargetBranch)
+		}
+
+		refAction = protection.RefActionCreate
+		branchName = in.NewBranch
+	} else {
+		refAction = protection.RefActionUpdate
+		branchName = in.TargetBranch
+	}
+
+	rules, isRepoOwner, err := c.fetchRules(ctx, session, repo)
+	if err != nil {
+		return types.CherryPickOutput{}, nil, fmt.Errorf("failed to fetch rules: %w", err)
+	}
+
+	violations, err := rules.RefChangeVerify(ctx, protection.RefChangeVerifyInput{
+		ResolveUserGroupID: c.userGroupService.ListUserIDsByGroupIDs,
+		Actor:              &session.Principal,
+		AllowBypass:        in.BypassRules,
+		IsRepoOwner:        isRepoOwner,
+		Repo:               repo,
+		RefAction:          refAction,
+		RefType:            protection.RefTypeBranch,
+		RefNames:           []string{branchName},
+	})
+	if err != nil {
+		return types.CherryPickOutput{}, nil, fmt.Errorf("failed to verify protection rules: %w", err)
+	}
+
+	if in.DryRunRules {
+		return types.CherryPickOutput{
+			DryRunRulesOutput: types.DryRunRulesOutput{
+				DryRunRules:    true,
+				RuleViolations: violations,
+			},
+		}, nil, nil
+	}
+
+	if protection.IsCritical(violations) {
+		return types.CherryPickOutput{}, violations, nil
+	}
+
+	// Create internal write params. Note: This will skip the pre-commit protection rules check.
+	writeParams, err := controller.CreateRPCInternalWriteParams(ctx, c.urlProvider, session, repo)
+	if err != nil {
+		return types.CherryPickOutput{}, nil, fmt.Errorf("failed to create RPC write params: %w", err)
+	}
+
+	now := time.Now()
+	cherryPickOutput, err := c.git.CherryPick(ctx, &git.CherryPickParams{
+		WriteParams:   writeParams,
+		CommitSHA:     sha.Must(*pr.MergeSHA),
+		ParentSHA:     sha.Must(*pr.MergeTargetSHA),
+		Branch:        in.TargetBranch,
+		NewBranch:     in.NewBranch,
+		Title:         fmt.Sprintf("%s (#%d)", pr.Title, pr.Number),
+		Message:       fmt.Sprintf("(cherry picked from commit %s)", *pr.MergeSHA),
+		Committer:     identityFromPrincipalInfo(*bootstrap.NewSystemServiceSession().Principal.ToPrincipalInfo()),
+		CommitterDate: &now,
+		Author:        identityFromPrincipalInfo(pr.Author),
+		AuthorDate:    &now,
+	})
+	if err != nil {
+		return types.CherryPickOutput{}, nil, fmt.Errorf("cherry-pick execution failed: %w", err)
+	}
+
+	if len(cherryPickOutput.ConflictFiles) > 0 {
+		return types.CherryPickOutput{}, nil, errors.Conflict("Cherry-pick blocked by conflicting files: %v",
+			cherryPickOutput.ConflictFiles)
+	}
+
+	out := types.CherryPickOutput{
+		CommitSHA: cherryPickOutput.CommitSHA.String(),
+		Branch:    branchName,
+		DryRunRulesOutput: types.DryRunRulesOutput{
+			RuleViolations: violations,
+		},
+	}
+
+	if in.CreatePullReq {
+		out.PullReq, err = c.Create(ctx, session, repoRef, &CreateInput{
+			Title:        fmt.Sprintf("[%s] %s", in.TargetBranch, pr.Title),
+			Description:  fmt.Sprintf("Cherry-picked from #%d.", pr.Number),
+			SourceBranch: in.NewBranch,
+			TargetBranch: in.TargetBranch,
+		})
+		if err != nil {
+			// don't leave behind the branch that was created only for the backport pull request.
+			errDelete := c.git.DeleteBranch(ctx, &git.DeleteBranchParams{
+				WriteParams: writeParams,
+				BranchName:  in.NewBranch,
+				SHA:         out.CommitSHA,
+			})
+			if errDelete != nil {
+				log.Ctx(ctx).Err(errDelete).Msgf("failed to delete branch %q after backport pull request creation failed",
+					in.NewBranch)
+			}
+
+			return types.CherryPickOutput{}, nil, fmt.Errorf("failed to create backport pull request: %w", err)
+		}
+	}
+
+	c.writeCherryPickActivities(ctx, session, pr, out.PullReq, in.TargetBranch, out.CommitSHA)
+
+	return out, nil, nil
+}
+
+// writeCherryPickActivities writes the cherry-pick activity to the pull request
+// and, if it's been opened, to the backport pull request.
+func (c *Controller) writeCherryPickActivities(
+	ctx context.Context,
+	session *auth.Session,
+	pr *types.PullReq,
+	backportPR *types.PullReq,
+	targetBranch string,
+	commitSHA string,
+) {
+	var backportNumber int64
+	if backportPR != nil {
+		backportNumber = backportPR.Number
+	}
+
+	c.writeSystemActivity(ctx, pr, session.Principal.ID, &types.PullRequestActivityPayloadCherryPick{
+		TargetBranch: targetBranch,
+		CommitSHA:    commitSHA,
+		Number:       backportNumber,
+		Incoming:     false,
+	})
+
+	if backportPR == nil {
+		return
+	}
+
+	c.writeSystemActivity(ctx, backportPR, session.Principal.ID, &types.PullRequestActivityPayloadCherryPick{
+		TargetBranch: targetBranch,
+		CommitSHA:    commitSHA,
+		Number:       pr.Number,
+		Incoming:     true,
+	})
+}
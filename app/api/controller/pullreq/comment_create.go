@@ -47,6 +47,9 @@ type CommentCreateInput struct {
 	LineStartNew    bool   `json:"line_start_new"`
 	LineEnd         int    `json:"line_end"`
 	LineEndNew      bool   `json:"line_end_new"`
+	// Pending marks the comment as a part of a review that is not yet submitted.
+	// Pending comments are visible only to their author until the review is submitted.
+	Pending bool `json:"pending"`
 }
 
 func (in *CommentCreateInput) IsReply() bool {
@@ -117,12 +120,29 @@ func (c *Controller) CommentCreate(
 		return nil, fmt.Errorf("failed to find pull request by number: %w", err)
 	}
 
+	if err = c.checkConversationLocked(ctx, session, repo, pr); err != nil {
+		return nil, err
+	}
+
+	if in.Pending && pr.CreatedBy == session.Principal.ID {
+		return nil, usererror.BadRequest("Can't add review comments to own pull requests.")
+	}
+
 	var parentAct *types.PullReqActivity
 	if in.IsReply() {
 		parentAct, err = c.checkIsReplyable(ctx, pr, in.ParentID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to verify reply: %w", err)
 		}
+
+		if parentAct.Pending {
+			if parentAct.CreatedBy != session.Principal.ID {
+				return nil, usererror.BadRequest("Parent pull request activity not found.")
+			}
+
+			// replies to own pending comments are part of the same review
+			in.Pending = true
+		}
 	}
 
 	// fetch code snippet from git for code comments
@@ -190,6 +210,11 @@ func (c *Controller) CommentCreate(
 			return fmt.Errorf("failed to write pull request comment: %w", err)
 		}
 
+		if act.Pending {
+			// pending comments are counted when the review gets submitted
+			return nil
+		}
+
 		pr.CommentCount++
 		if act.IsBlocking() {
 			pr.UnresolvedCount++
@@ -216,13 +241,16 @@ func (c *Controller) CommentCreate(
 		c.migrateCodeComment(ctx, repo, pr, in, act.AsCodeComment(), cut)
 	}
 
-	if err = c.sseStreamer.Publish(ctx, repo.ParentID, enum.SSETypePullRequestUpdated, pr); err != nil {
-		log.Ctx(ctx).Warn().Err(err).Msg("failed to publish PR changed event")
-	}
+	// pending comments are invisible to others, the review submit will notify about them.
+	if !act.Pending {
+		if err = c.sseStreamer.Publish(ctx, repo.ParentID, enum.SSETypePullRequestUpdated, pr); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msg("failed to publish PR changed event")
+		}
 
-	// if it's a regular comment publish a comment create event
-	if act.Type == enum.PullReqActivityTypeComment && act.Kind == enum.PullReqActivityKindComment {
-		c.reportCommentCreated(ctx, pr, session.Principal.ID, act.ID, act.IsReply())
+		// if it's a regular comment publish a comment create event
+		if act.Type == enum.PullReqActivityTypeComment && act.Kind == enum.PullReqActivityKindComment {
+			c.reportCommentCreated(ctx, pr, session.Principal.ID, act.ID, act.IsReply())
+		}
 	}
 
 	err = c.instrumentation.Track(ctx, instrument.Event{
@@ -342,6 +370,7 @@ func getCommentActivity(
 		Metadata:   nil,
 		ResolvedBy: nil,
 		Resolved:   nil,
+		Pending:    in.Pending,
 		Author:     *session.Principal.ToPrincipalInfo(),
 	}
 
@@ -69,6 +69,23 @@ func (c *Controller) CommentDelete(
 			return fmt.Errorf("failed to mark comment as deleted: %w", err)
 		}
 
+		// keep a tombstone with the text the comment had when deleted, visible only to repository owners.
+		err = c.commentRevisionStore.Create(ctx, &types.PullReqCommentRevision{
+			ActivityID: act.ID,
+			Type:       enum.PullReqCommentRevisionTypeDelete,
+			CreatedBy:  session.Principal.ID,
+			Created:    now,
+			Text:       act.Text,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record comment tombstone: %w", err)
+		}
+
+		if act.Pending {
+			// pending comments aren't included in the pull request comment counters
+			return nil
+		}
+
 		pr.CommentCount--
 		if isBlocking {
 			pr.UnresolvedCount--
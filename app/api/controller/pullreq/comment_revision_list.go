@@ -0,0 +1,82 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"fmt"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// CommentRevisionList returns the revision history of a pull request comment, the latest revision first.
+// The history of deleted comments, including the tombstone, is available only to repository owners.
+func (c *Controller) CommentRevisionList(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	prNum int64,
+	commentID int64,
+) ([]*types.PullReqCommentRevision, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	pr, err := c.pullreqStore.FindByNumber(ctx, repo.ID, prNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pull request by number: %w", err)
+	}
+
+	act, err := c.activityStore.Find(ctx, commentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find comment by ID: %w", err)
+	}
+
+	if act.RepoID != pr.TargetRepoID || act.PullReqID != pr.ID {
+		return nil, usererror.ErrNotFound
+	}
+
+	if act.Kind == enum.PullReqActivityKindSystem ||
+		(act.Type != enum.PullReqActivityTypeComment && act.Type != enum.PullReqActivityTypeCodeComment) {
+		return nil, usererror.BadRequest("Only comments and code comments have a revision history.")
+	}
+
+	if act.Pending && act.CreatedBy != session.Principal.ID {
+		return nil, usererror.ErrNotFound
+	}
+
+	if act.Deleted != nil {
+		isRepoOwner, err := apiauth.IsRepoOwner(ctx, c.authorizer, session, repo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to determine if user is repo owner: %w", err)
+		}
+
+		if !isRepoOwner {
+			return nil, usererror.ErrNotFound
+		}
+	}
+
+	revs, err := c.commentRevisionStore.List(ctx, act.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comment revisions: %w", err)
+	}
+
+	return revs, nil
+}
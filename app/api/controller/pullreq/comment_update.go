@@ -68,6 +68,10 @@ func (c *Controller) CommentUpdate(
 		return nil, fmt.Errorf("failed to find pull request by number: %w", err)
 	}
 
+	if err = c.checkConversationLocked(ctx, session, repo, pr); err != nil {
+		return nil, err
+	}
+
 	act, err := c.getCommentCheckEditAccess(ctx, session, pr, commentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get comment: %w", err)
@@ -101,15 +105,37 @@ func (c *Controller) CommentUpdate(
 		metadataUpdates = appendMetadataUpdateForSuggestions(metadataUpdates, in.Text)
 	}
 
-	act, err = c.activityStore.UpdateOptLock(ctx, act, func(act *types.PullReqActivity) error {
-		now := time.Now().UnixMilli()
-		act.Edited = now
-		act.Text = in.Text
-		act.UpdateMetadata(metadataUpdates...)
+	err = c.tx.WithTx(ctx, func(ctx context.Context) error {
+		rev := &types.PullReqCommentRevision{
+			ActivityID: act.ID,
+			Type:       enum.PullReqCommentRevisionTypeEdit,
+			CreatedBy:  session.Principal.ID,
+		}
+
+		act, err = c.activityStore.UpdateOptLock(ctx, act, func(act *types.PullReqActivity) error {
+			now := time.Now().UnixMilli()
+
+			// keep the prior version of the text in the comment's revision history.
+			rev.Created = now
+			rev.Text = act.Text
+
+			act.Edited = now
+			act.Text = in.Text
+			act.UpdateMetadata(metadataUpdates...)
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update comment: %w", err)
+		}
+
+		if err = c.commentRevisionStore.Create(ctx, rev); err != nil {
+			return fmt.Errorf("failed to record comment revision: %w", err)
+		}
+
 		return nil
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to update comment: %w", err)
+		return nil, err
 	}
 
 	// Populate activity mentions (used only for response purposes).
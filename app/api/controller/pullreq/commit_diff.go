@@ -0,0 +1,161 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/git"
+	gittypes "github.com/harness/gitness/git/api"
+	"github.com/harness/gitness/git/sha"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// RawCommitDiff writes raw git diff of a commit, or a range of commits, of the pull request to writer w.
+func (c *Controller) RawCommitDiff(
+	ctx context.Context,
+	w io.Writer,
+	session *auth.Session,
+	repoRef string,
+	pullreqNum int64,
+	commitSHA string,
+	fromSHA string,
+	opts gittypes.DiffOptions,
+	files ...gittypes.FileDiffRequest,
+) error {
+	params, err := c.getCommitDiffParams(ctx, session, repoRef, pullreqNum, commitSHA, fromSHA, opts)
+	if err != nil {
+		return err
+	}
+
+	return c.git.RawDiff(ctx, w, params, files...)
+}
+
+// CommitDiff returns the diff of a commit of the pull request. If fromSHA is provided,
+// the diff of the range of commits from fromSHA (exclusive) to commitSHA (inclusive) is returned.
+func (c *Controller) CommitDiff(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	pullreqNum int64,
+	commitSHA string,
+	fromSHA string,
+	includePatch bool,
+	opts gittypes.DiffOptions,
+	files ...gittypes.FileDiffRequest,
+) (types.Stream[*git.FileDiff], error) {
+	params, err := c.getCommitDiffParams(ctx, session, repoRef, pullreqNum, commitSHA, fromSHA, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	params.IncludePatch = includePatch
+
+	return git.NewStreamReader(c.git.Diff(ctx, params, files...)), nil
+}
+
+// getCommitDiffParams resolves the commit range and verifies that it's a part of the pull request,
+// i.e. that both ends of the range are between the merge base and the head of the pull request.
+func (c *Controller) getCommitDiffParams(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	pullreqNum int64,
+	commitSHA string,
+	fromSHA string,
+	opts gittypes.DiffOptions,
+) (*git.DiffParams, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire access to target repo: %w", err)
+	}
+
+	pr, err := c.pullreqStore.FindByNumber(ctx, repo.ID, pullreqNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request by number: %w", err)
+	}
+
+	readParams := git.CreateReadParams(repo)
+
+	commit, err := c.git.GetCommit(ctx, &git.GetCommitParams{
+		ReadParams: readParams,
+		Revision:   commitSHA,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit: %w", err)
+	}
+
+	headSHA := commit.Commit.SHA
+
+	var baseSHA sha.SHA
+	if fromSHA == "" {
+		if len(commit.Commit.ParentSHAs) == 0 {
+			return nil, usererror.BadRequest("The commit has no parent commit.")
+		}
+		baseSHA = commit.Commit.ParentSHAs[0]
+	} else {
+		fromCommit, err := c.git.GetCommit(ctx, &git.GetCommitParams{
+			ReadParams: readParams,
+			Revision:   fromSHA,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get commit the range starts from: %w", err)
+		}
+		baseSHA = fromCommit.Commit.SHA
+	}
+
+	prSourceSHA, err := sha.New(pr.SourceSHA)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse pull request source SHA: %w", err)
+	}
+
+	prMergeBaseSHA, err := sha.New(pr.MergeBaseSHA)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse pull request merge base SHA: %w", err)
+	}
+
+	for _, pair := range [][2]sha.SHA{
+		{headSHA, prSourceSHA},    // the commit must be reachable from the head of the pull request
+		{prMergeBaseSHA, baseSHA}, // the range must start at or after the merge base
+		{baseSHA, headSHA},        // the range must be ordered
+	} {
+		result, err := c.git.IsAncestor(ctx, git.IsAncestorParams{
+			ReadParams:          readParams,
+			AncestorCommitSHA:   pair[0],
+			DescendantCommitSHA: pair[1],
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to check commit ancestry: %w", err)
+		}
+
+		if !result.Ancestor {
+			return nil, usererror.BadRequest("The commit range isn't a part of the pull request.")
+		}
+	}
+
+	return &git.DiffParams{
+		ReadParams:       readParams,
+		BaseRef:          baseSHA.String(),
+		HeadRef:          headSHA.String(),
+		MergeBase:        false,
+		IgnoreWhitespace: opts.IgnoreWhitespace,
+		ContextLines:     opts.ContextLines,
+	}, nil
+}
@@ -29,9 +29,11 @@ import (
 	"github.com/harness/gitness/app/services/instrument"
 	"github.com/harness/gitness/app/services/label"
 	locker "github.com/harness/gitness/app/services/locker"
+	"github.com/harness/gitness/app/services/mergequeue"
 	"github.com/harness/gitness/app/services/migrate"
 	"github.com/harness/gitness/app/services/protection"
 	"github.com/harness/gitness/app/services/pullreq"
+	"github.com/harness/gitness/app/services/settings"
 	"github.com/harness/gitness/app/services/usergroup"
 	"github.com/harness/gitness/app/sse"
 	"github.com/harness/gitness/app/store"
@@ -44,6 +46,8 @@ import (
 	"github.com/harness/gitness/store/database/dbtx"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
 )
 
 type Controller struct {
@@ -62,8 +66,13 @@ type Controller struct {
 	userGroupStore         store.UserGroupStore
 	principalInfoCache     store.PrincipalInfoCache
 	fileViewStore          store.PullReqFileViewStore
+	reactionStore          store.PullReqReactionStore
 	membershipStore        store.MembershipStore
 	checkStore             store.CheckStore
+	editStore              store.PullReqEditStore
+	commentRevisionStore   store.PullReqCommentRevisionStore
+	mentionStore           store.PullReqMentionStore
+	dependencyStore        store.PullReqDependencyStore
 	git                    git.Interface
 	eventReporter          *pullreqevents.Reporter
 	codeCommentMigrator    *codecomments.Migrator
@@ -77,6 +86,8 @@ type Controller struct {
 	labelSvc               *label.Service
 	instrumentation        instrument.Service
 	userGroupService       usergroup.SearchService
+	settings               *settings.Service
+	mergeQueue             *mergequeue.Service
 }
 
 func NewController(
@@ -95,8 +106,13 @@ func NewController(
 	userGroupReviewerStore store.UserGroupReviewersStore,
 	principalInfoCache store.PrincipalInfoCache,
 	fileViewStore store.PullReqFileViewStore,
+	reactionStore store.PullReqReactionStore,
 	membershipStore store.MembershipStore,
 	checkStore store.CheckStore,
+	editStore store.PullReqEditStore,
+	commentRevisionStore store.PullReqCommentRevisionStore,
+	mentionStore store.PullReqMentionStore,
+	dependencyStore store.PullReqDependencyStore,
 	git git.Interface,
 	eventReporter *pullreqevents.Reporter,
 	codeCommentMigrator *codecomments.Migrator,
@@ -110,6 +126,8 @@ func NewController(
 	labelSvc *label.Service,
 	instrumentation instrument.Service,
 	userGroupService usergroup.SearchService,
+	settings *settings.Service,
+	mergeQueue *mergequeue.Service,
 ) *Controller {
 	return &Controller{
 		tx:                     tx,
@@ -127,8 +145,13 @@ func NewController(
 		userGroupReviewerStore: userGroupReviewerStore,
 		principalInfoCache:     principalInfoCache,
 		fileViewStore:          fileViewStore,
+		reactionStore:          reactionStore,
 		membershipStore:        membershipStore,
 		checkStore:             checkStore,
+		editStore:              editStore,
+		commentRevisionStore:   commentRevisionStore,
+		mentionStore:           mentionStore,
+		dependencyStore:        dependencyStore,
 		git:                    git,
 		codeCommentMigrator:    codeCommentMigrator,
 		eventReporter:          eventReporter,
@@ -142,6 +165,8 @@ func NewController(
 		labelSvc:               labelSvc,
 		instrumentation:        instrumentation,
 		userGroupService:       userGroupService,
+		settings:               settings,
+		mergeQueue:             mergeQueue,
 	}
 }
 
@@ -281,6 +306,10 @@ func (c *Controller) getCommentCheckChangeStatusAccess(ctx context.Context,
 		return nil, usererror.BadRequest("Can't change status of replies.")
 	}
 
+	if comment.Pending {
+		return nil, usererror.BadRequest("Can't change status of pending comments.")
+	}
+
 	return comment, nil
 }
 
@@ -324,6 +353,26 @@ func eventBase(pr *types.PullReq, principal *types.Principal) pullreqevents.Base
 	}
 }
 
+// writeSystemActivity increments the activity sequence of the pull request and writes the activity payload to it.
+// The activity isn't critical for the operation that triggered it, so a failure is only logged.
+func (c *Controller) writeSystemActivity(
+	ctx context.Context,
+	pr *types.PullReq,
+	principalID int64,
+	payload types.PullReqActivityPayload,
+) {
+	pr, err := c.pullreqStore.UpdateActivitySeq(ctx, pr)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msgf("failed to update pull request activity sequence for %s activity",
+			payload.ActivityType())
+		return
+	}
+
+	if _, err = c.activityStore.CreateWithPayload(ctx, pr, principalID, payload, nil); err != nil {
+		log.Ctx(ctx).Err(err).Msgf("failed to write pull request %s activity", payload.ActivityType())
+	}
+}
+
 func validateTitle(title string) error {
 	if title == "" {
 		return usererror.BadRequest("pull request title can't be empty")
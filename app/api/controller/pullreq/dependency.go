@@ -0,0 +1,263 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/errors"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+type DependencyAddInput struct {
+	// PullReqNumber is the number of the pull request, in the same repository, that must be merged first.
+	PullReqNumber int64 `json:"pullreq_number"`
+}
+
+// DependencyAdd declares that the pull request can't be merged before another pull request is merged.
+func (c *Controller) DependencyAdd(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	pullreqNum int64,
+	in *DependencyAddInput,
+) (*types.PullReqDependencies, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoPush)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	pr, err := c.pullreqStore.FindByNumber(ctx, repo.ID, pullreqNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pull request by number: %w", err)
+	}
+
+	if pr.State != enum.PullReqStateOpen {
+		return nil, usererror.BadRequest("Dependencies can be added only to open pull requests.")
+	}
+
+	dependsOn, err := c.pullreqStore.FindByNumber(ctx, repo.ID, in.PullReqNumber)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return nil, usererror.NotFoundf("Pull request #%d not found.", in.PullReqNumber)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find dependency pull request by number: %w", err)
+	}
+
+	if err = c.checkDependency(ctx, pr, dependsOn); err != nil {
+		return nil, err
+	}
+
+	err = c.dependencyStore.Create(ctx, &types.PullReqDependency{
+		PullReqID:   pr.ID,
+		DependsOnID: dependsOn.ID,
+		CreatedBy:   session.Principal.ID,
+		Created:     time.Now().UnixMilli(),
+	})
+	if errors.Is(err, store.ErrDuplicate) {
+		return nil, usererror.Conflict(
+			fmt.Sprintf("Pull request already depends on pull request #%d.", dependsOn.Number))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pull request dependency: %w", err)
+	}
+
+	if err = c.sseStreamer.Publish(ctx, repo.ParentID, enum.SSETypePullRequestUpdated, pr); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("failed to publish PR changed event")
+	}
+
+	return c.dependencies(ctx, pr)
+}
+
+// DependencyDelete removes the dependency of the pull request on another pull request.
+func (c *Controller) DependencyDelete(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	pullreqNum int64,
+	dependencyNum int64,
+) error {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoPush)
+	if err != nil {
+		return fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	pr, err := c.pullreqStore.FindByNumber(ctx, repo.ID, pullreqNum)
+	if err != nil {
+		return fmt.Errorf("failed to find pull request by number: %w", err)
+	}
+
+	dependsOn, err := c.pullreqStore.FindByNumber(ctx, repo.ID, dependencyNum)
+	if err != nil {
+		return fmt.Errorf("failed to find dependency pull request by number: %w", err)
+	}
+
+	if err = c.dependencyStore.Delete(ctx, pr.ID, dependsOn.ID); err != nil {
+		return fmt.Errorf("failed to delete pull request dependency: %w", err)
+	}
+
+	if err = c.sseStreamer.Publish(ctx, repo.ParentID, enum.SSETypePullRequestUpdated, pr); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("failed to publish PR changed event")
+	}
+
+	return nil
+}
+
+// DependencyList returns the dependency chain of the pull request.
+func (c *Controller) DependencyList(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	pullreqNum int64,
+) (*types.PullReqDependencies, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	pr, err := c.pullreqStore.FindByNumber(ctx, repo.ID, pullreqNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pull request by number: %w", err)
+	}
+
+	return c.dependencies(ctx, pr)
+}
+
+func (c *Controller) dependencies(ctx context.Context, pr *types.PullReq) (*types.PullReqDependencies, error) {
+	chain, err := c.listDependencyChain(ctx, pr.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	dependentIDs, err := c.dependencyStore.ListDependentIDs(ctx, pr.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull request dependents: %w", err)
+	}
+
+	dependsOn, err := c.findPullReqs(ctx, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	dependents, err := c.findPullReqs(ctx, dependentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.PullReqDependencies{
+		DependsOn:  dependsOn,
+		Dependents: dependents,
+	}, nil
+}
+
+// checkDependency returns an error if the pull request can't depend on the other pull request:
+// A pull request can depend only on other open pull requests, and the dependencies must not form a cycle.
+func (c *Controller) checkDependency(ctx context.Context, pr, dependsOn *types.PullReq) error {
+	if dependsOn.ID == pr.ID {
+		return usererror.BadRequest("A pull request can't depend on itself.")
+	}
+
+	if dependsOn.State != enum.PullReqStateOpen {
+		return usererror.BadRequestf("Pull request #%d isn't open.", dependsOn.Number)
+	}
+
+	// the dependency must not (transitively) depend on the pull request itself.
+	chain, err := c.listDependencyChain(ctx, dependsOn.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range chain {
+		if id == pr.ID {
+			return usererror.BadRequestf(
+				"Pull request #%d already depends on this pull request.", dependsOn.Number)
+		}
+	}
+
+	return nil
+}
+
+// listDependencyChain returns IDs of all pull requests the pull request depends on, directly or transitively.
+// The direct dependencies are listed first.
+func (c *Controller) listDependencyChain(ctx context.Context, pullReqID int64) ([]int64, error) {
+	var chain []int64
+	visited := map[int64]struct{}{pullReqID: {}}
+	queue := []int64{pullReqID}
+
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		ids, err := c.dependencyStore.ListDependsOnIDs(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pull request dependencies: %w", err)
+		}
+
+		for _, depID := range ids {
+			if _, ok := visited[depID]; ok {
+				continue
+			}
+			visited[depID] = struct{}{}
+			chain = append(chain, depID)
+			queue = append(queue, depID)
+		}
+	}
+
+	return chain, nil
+}
+
+func (c *Controller) findPullReqs(ctx context.Context, ids []int64) ([]*types.PullReq, error) {
+	prs := make([]*types.PullReq, len(ids))
+	for i, id := range ids {
+		pr, err := c.pullreqStore.Find(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find pull request: %w", err)
+		}
+		prs[i] = pr
+	}
+
+	return prs, nil
+}
+
+// checkDependenciesMerged returns an error if any of the pull requests the pull request
+// directly depends on isn't merged.
+func (c *Controller) checkDependenciesMerged(ctx context.Context, pr *types.PullReq) error {
+	ids, err := c.dependencyStore.ListDependsOnIDs(ctx, pr.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list pull request dependencies: %w", err)
+	}
+
+	for _, id := range ids {
+		dependsOn, err := c.pullreqStore.Find(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to find dependency pull request: %w", err)
+		}
+
+		if dependsOn.State != enum.PullReqStateMerged {
+			return usererror.BadRequestf(
+				"The pull request depends on pull request #%d, which must be merged first.", dependsOn.Number)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// dependencyGraphStore lists the dependencies of the graph, all other methods aren't implemented.
+type dependencyGraphStore struct {
+	store.PullReqDependencyStore
+	// dependsOn maps pull request IDs to the IDs of the pull requests they directly depend on.
+	dependsOn map[int64][]int64
+}
+
+func (s dependencyGraphStore) ListDependsOnIDs(_ context.Context, pullReqID int64) ([]int64, error) {
+	return s.dependsOn[pullReqID], nil
+}
+
+func TestController_listDependencyChain(t *testing.T) {
+	c := &Controller{dependencyStore: dependencyGraphStore{dependsOn: map[int64][]int64{
+		1: {2, 3},
+		2: {4},
+		3: {4, 5},
+		5: {1},
+	}}}
+
+	got, err := c.listDependencyChain(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// direct dependencies first, every pull request once, the cycle back to #1 is ignored.
+	if want := []int64{2, 3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("listDependencyChain() = %v, want %v", got, want)
+	}
+}
+
+func TestController_checkDependency(t *testing.T) {
+	open := func(id int64) *types.PullReq {
+		return &types.PullReq{ID: id, Number: id, State: enum.PullReqStateOpen}
+	}
+
+	tests := []struct {
+		name      string
+		dependsOn map[int64][]int64
+		pr        *types.PullReq
+		dep       *types.PullReq
+		wantErr   string
+	}{
+		{
+			name: "valid dependency",
+			pr:   open(1),
+			dep:  open(2),
+		},
+		{
+			name:      "shared dependency isn't a cycle",
+			dependsOn: map[int64][]int64{1: {3}, 2: {3}},
+			pr:        open(1),
+			dep:       open(2),
+		},
+		{
+			name:    "self dependency",
+			pr:      open(1),
+			dep:     open(1),
+			wantErr: "A pull request can't depend on itself.",
+		},
+		{
+			name:    "dependency isn't open",
+			pr:      open(1),
+			dep:     &types.PullReq{ID: 2, Number: 2, State: enum.PullReqStateMerged},
+			wantErr: "Pull request #2 isn't open.",
+		},
+		{
+			name:      "direct cycle",
+			dependsOn: map[int64][]int64{2: {1}},
+			pr:        open(1),
+			dep:       open(2),
+			wantErr:   "Pull request #2 already depends on this pull request.",
+		},
+		{
+			name:      "indirect cycle",
+			dependsOn: map[int64][]int64{3: {2}, 2: {4}, 4: {1}},
+			pr:        open(1),
+			dep:       open(3),
+			wantErr:   "Pull request #3 already depends on this pull request.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Controller{dependencyStore: dependencyGraphStore{dependsOn: tt.dependsOn}}
+
+			err := c.checkDependency(context.Background(), tt.pr, tt.dep)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			var uErr *usererror.Error
+			if !errors.As(err, &uErr) {
+				t.Fatalf("err = %v, want a user error", err)
+			}
+			if uErr.Message != tt.wantErr {
+				t.Errorf("message = %q, want %q", uErr.Message, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+type LockInput struct {
+	Reason enum.PullReqLockReason `json:"reason"`
+}
+
+func (in *LockInput) sanitize() error {
+	reason, ok := in.Reason.Sanitize()
+	if !ok {
+		return usererror.BadRequestf("Invalid lock reason: %q", in.Reason)
+	}
+
+	in.Reason = reason
+
+	return nil
+}
+
+// Lock locks the conversation of a pull request. Once locked, only principals
+// with the repo edit permission can comment on it.
+func (c *Controller) Lock(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	pullreqNum int64,
+	in *LockInput,
+) (*types.PullReq, error) {
+	if err := in.sanitize(); err != nil {
+		return nil, err
+	}
+
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoEdit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	pr, err := c.pullreqStore.FindByNumber(ctx, repo.ID, pullreqNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pull request by number: %w", err)
+	}
+
+	if pr.Locked != nil {
+		return pr, nil
+	}
+
+	pr, err = c.pullreqStore.UpdateOptLock(ctx, pr, func(pr *types.PullReq) error {
+		now := time.Now().UnixMilli()
+		reason := in.Reason
+
+		pr.Locked = &now
+		pr.LockReason = &reason
+		pr.ActivitySeq++ // because we need to add the activity entry
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock pull request: %w", err)
+	}
+
+	payload := &types.PullRequestActivityPayloadLock{Reason: in.Reason}
+	if _, errAct := c.activityStore.CreateWithPayload(ctx, pr, session.Principal.ID, payload, nil); errAct != nil {
+		// non-critical error
+		log.Ctx(ctx).Err(errAct).Msgf("failed to write pull request activity after lock")
+	}
+
+	if err = c.sseStreamer.Publish(ctx, repo.ParentID, enum.SSETypePullRequestUpdated, pr); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("failed to publish PR changed event")
+	}
+
+	return pr, nil
+}
+
+// Unlock unlocks the conversation of a pull request.
+func (c *Controller) Unlock(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	pullreqNum int64,
+) (*types.PullReq, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoEdit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	pr, err := c.pullreqStore.FindByNumber(ctx, repo.ID, pullreqNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pull request by number: %w", err)
+	}
+
+	if pr.Locked == nil {
+		return pr, nil
+	}
+
+	var oldReason enum.PullReqLockReason
+	if pr.LockReason != nil {
+		oldReason = *pr.LockReason
+	}
+
+	pr, err = c.pullreqStore.UpdateOptLock(ctx, pr, func(pr *types.PullReq) error {
+		pr.Locked = nil
+		pr.LockReason = nil
+		pr.ActivitySeq++ // because we need to add the activity entry
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to unlock pull request: %w", err)
+	}
+
+	payload := &types.PullRequestActivityPayloadUnlock{Reason: oldReason}
+	if _, errAct := c.activityStore.CreateWithPayload(ctx, pr, session.Principal.ID, payload, nil); errAct != nil {
+		// non-critical error
+		log.Ctx(ctx).Err(errAct).Msgf("failed to write pull request activity after unlock")
+	}
+
+	if err = c.sseStreamer.Publish(ctx, repo.ParentID, enum.SSETypePullRequestUpdated, pr); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("failed to publish PR changed event")
+	}
+
+	return pr, nil
+}
+
+// checkConversationLocked returns an error if the pull request conversation is locked
+// and the principal isn't allowed to comment on locked pull requests.
+// Commenting on locked pull requests requires the repo edit permission, the same as locking them.
+func (c *Controller) checkConversationLocked(
+	ctx context.Context,
+	session *auth.Session,
+	repo *types.Repository,
+	pr *types.PullReq,
+) error {
+	if pr.Locked == nil {
+		return nil
+	}
+
+	// the same permission is required to lock and unlock the conversation
+	err := apiauth.CheckRepo(ctx, c.authorizer, session, repo, enum.PermissionRepoEdit)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, apiauth.ErrNotAuthorized):
+		return usererror.Forbidden("Conversation of the pull request is locked.")
+	default:
+		return fmt.Errorf("failed to check if the principal can bypass the conversation lock: %w", err)
+	}
+}
@@ -18,18 +18,40 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"strconv"
+	"time"
 
+	"github.com/harness/gitness/app/auth"
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
 	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
 
 	"github.com/rs/zerolog/log"
 )
 
+// processMentions returns all principals mentioned in the text, either by ID (@[123]) or by UID (@username).
+// Mentions of unknown principals are ignored, UID mentions are only resolved for users.
 func (c *Controller) processMentions(
 	ctx context.Context,
 	text string,
 ) (map[int64]*types.PrincipalInfo, error) {
 	mentions := parseMentions(ctx, text)
+
+	if uids := parseUIDMentions(text); len(uids) > 0 {
+		principals, err := c.principalStore.FindManyByUID(ctx, uids)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find mentioned principals by UID: %w", err)
+		}
+
+		for _, principal := range principals {
+			if principal.Type != enum.PrincipalTypeUser {
+				continue
+			}
+			mentions = append(mentions, principal.ID)
+		}
+	}
+
 	if len(mentions) == 0 {
 		return map[int64]*types.PrincipalInfo{}, nil
 	}
@@ -61,3 +83,81 @@ func parseMentions(ctx context.Context, text string) []int64 {
 
 	return mentions
 }
+
+// uidMentionRegex matches @username mentions. The mention must not be preceded by a character
+// that could make it a part of an email address or a path, and it can't end with a dot or a dash,
+// so that punctuation following the mention isn't included.
+var uidMentionRegex = regexp.MustCompile(`(?:^|[^\w@/.\-])@([\w.\-]*\w)`)
+
+func parseUIDMentions(text string) []string {
+	matches := uidMentionRegex.FindAllStringSubmatch(text, -1)
+
+	var uids []string
+	for _, match := range matches {
+		if len(match) < 2 || slices.Contains(uids, match[1]) {
+			continue
+		}
+		uids = append(uids, match[1])
+	}
+
+	return uids
+}
+
+// updateDescriptionMentions synchronizes the stored mentions of the pull request with the principals
+// mentioned in its description and reports an event for the principals that weren't mentioned before.
+func (c *Controller) updateDescriptionMentions(
+	ctx context.Context,
+	session *auth.Session,
+	pr *types.PullReq,
+) error {
+	infos, err := c.processMentions(ctx, pr.Description)
+	if err != nil {
+		return fmt.Errorf("failed to process mentions: %w", err)
+	}
+
+	existing, err := c.mentionStore.List(ctx, pr.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list pull request mentions: %w", err)
+	}
+
+	for _, mention := range existing {
+		if _, ok := infos[mention.PrincipalID]; ok {
+			delete(infos, mention.PrincipalID)
+			continue
+		}
+
+		if err = c.mentionStore.Delete(ctx, pr.ID, mention.PrincipalID); err != nil {
+			return fmt.Errorf("failed to delete pull request mention: %w", err)
+		}
+	}
+
+	if len(infos) == 0 {
+		return nil
+	}
+
+	now := time.Now().UnixMilli()
+	added := make([]int64, 0, len(infos))
+
+	for id := range infos {
+		err = c.mentionStore.Create(ctx, &types.PullReqMention{
+			PullReqID:   pr.ID,
+			PrincipalID: id,
+			CreatedBy:   session.Principal.ID,
+			Created:     now,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create pull request mention: %w", err)
+		}
+
+		added = append(added, id)
+	}
+
+	slices.Sort(added)
+
+	c.eventReporter.Mentioned(ctx, &pullreqevents.MentionedPayload{
+		Base:         eventBase(pr, &session.Principal),
+		PrincipalIDs: added,
+	})
+
+	return nil
+}
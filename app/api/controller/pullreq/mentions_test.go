@@ -0,0 +1,71 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_parseUIDMentions(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  string
+		want []string
+	}{
+		{
+			name: "test empty",
+			arg:  "",
+			want: nil,
+		},
+		{
+			name: "test single mention",
+			arg:  "@john please take a look",
+			want: []string{"john"},
+		},
+		{
+			name: "test multiple mentions with duplicates",
+			arg:  "cc @john, @jane.doe and @john",
+			want: []string{"john", "jane.doe"},
+		},
+		{
+			name: "test trailing punctuation",
+			arg:  "thanks @john-smith.",
+			want: []string{"john-smith"},
+		},
+		{
+			name: "test email address",
+			arg:  "mail john@example.com",
+			want: nil,
+		},
+		{
+			name: "test id mention",
+			arg:  "@[123] and @[456]",
+			want: nil,
+		},
+		{
+			name: "test mention in parentheses",
+			arg:  "(@john)",
+			want: []string{"john"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseUIDMentions(tt.arg); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseUIDMentions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/harness/gitness/git"
 	gitenum "github.com/harness/gitness/git/enum"
 	"github.com/harness/gitness/git/sha"
+	"github.com/harness/gitness/store"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
 
@@ -164,11 +165,32 @@ func (c *Controller) Merge(
 		)
 	}
 
+	if !in.DryRun {
+		if err = c.checkDependenciesMerged(ctx, pr); err != nil {
+			return nil, nil, err
+		}
+
+		_, err = c.mergeQueue.Find(ctx, pr.ID)
+		if err == nil {
+			return nil, nil, usererror.BadRequest(
+				"Pull request is in the merge queue. Remove it from the queue to merge it directly.",
+			)
+		}
+		if !errors.Is(err, store.ErrResourceNotFound) {
+			return nil, nil, fmt.Errorf("failed to check if pull request is in the merge queue: %w", err)
+		}
+	}
+
 	reviewers, err := c.reviewerStore.List(ctx, pr.ID)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to load list of reviwers: %w", err)
 	}
 
+	userGroupReviewers, err := c.userGroupReviewerStore.List(ctx, pr.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load list of user group reviewers: %w", err)
+	}
+
 	targetWriteParams, err := controller.CreateRPCInternalWriteParams(ctx, c.urlProvider, session, targetRepo)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create RPC write params: %w", err)
@@ -213,6 +235,7 @@ func (c *Controller) Merge(
 		SourceRepo:         sourceRepo,
 		PullReq:            pr,
 		Reviewers:          reviewers,
+		UserGroupReviewers: userGroupReviewers,
 		Method:             in.Method,
 		CheckResults:       checkResults,
 		CodeOwners:         codeOwnerWithApproval,
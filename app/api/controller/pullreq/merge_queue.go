@@ -0,0 +1,220 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/services/codeowners"
+	"github.com/harness/gitness/app/services/mergequeue"
+	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/errors"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+type MergeQueueAddInput struct {
+	Method    enum.MergeMethod `json:"method"`
+	SourceSHA string           `json:"source_sha"`
+}
+
+func (in *MergeQueueAddInput) sanitize() error {
+	if in.SourceSHA == "" {
+		return usererror.BadRequest("source SHA must be provided")
+	}
+
+	if in.Method == "" {
+		in.Method = enum.MergeMethodMerge
+	}
+
+	method, ok := in.Method.Sanitize()
+	if !ok {
+		return usererror.BadRequestf("unsupported merge method: %s", in.Method)
+	}
+
+	if method == enum.MergeMethodFastForward {
+		return usererror.BadRequest("The merge queue doesn't support the fast-forward merge method.")
+	}
+
+	in.Method = method
+
+	return nil
+}
+
+// MergeQueueAdd adds the pull request to the merge queue of its target branch.
+// The pull request must satisfy all protection rules of the target branch to enter the queue.
+func (c *Controller) MergeQueueAdd(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	pullreqNum int64,
+	in *MergeQueueAddInput,
+) (*types.MergeQueueEntry, error) {
+	if err := in.sanitize(); err != nil {
+		return nil, err
+	}
+
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoPush)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	pr, err := c.pullreqStore.FindByNumber(ctx, repo.ID, pullreqNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request by number: %w", err)
+	}
+
+	if pr.State != enum.PullReqStateOpen {
+		return nil, usererror.BadRequest("Pull request must be open")
+	}
+
+	if pr.IsDraft {
+		return nil, usererror.BadRequest("Draft pull requests can't be added to the merge queue.")
+	}
+
+	if err = c.checkDependenciesMerged(ctx, pr); err != nil {
+		return nil, err
+	}
+
+	if pr.SourceRepoID != pr.TargetRepoID {
+		return nil, usererror.BadRequest("Pull requests from forks can't be added to the merge queue.")
+	}
+
+	if pr.SourceSHA != in.SourceSHA {
+		return nil, usererror.BadRequest("A newer commit is available. Only the latest commit can be merged.")
+	}
+
+	if pr.MergeCheckStatus == enum.MergeCheckStatusConflict {
+		return nil, usererror.BadRequest("Pull request has merge conflicts with the target branch.")
+	}
+
+	enabled, err := mergequeue.IsEnabled(ctx, c.settings, repo.ID, pr.TargetBranch)
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, usererror.BadRequestf("The merge queue is not enabled for branch %q.", pr.TargetBranch)
+	}
+
+	reviewers, err := c.reviewerStore.List(ctx, pr.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load list of reviwers: %w", err)
+	}
+
+	userGroupReviewers, err := c.userGroupReviewerStore.List(ctx, pr.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load list of user group reviewers: %w", err)
+	}
+
+	protectionRules, isRepoOwner, err := c.fetchRules(ctx, session, repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch rules: %w", err)
+	}
+
+	checkResults, err := c.checkStore.ListResults(ctx, repo.ID, pr.SourceSHA)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list status checks: %w", err)
+	}
+
+	codeOwnerWithApproval, err := c.codeOwners.Evaluate(ctx, repo, pr, reviewers)
+	if err != nil && !errors.Is(err, codeowners.ErrNotFound) {
+		return nil, fmt.Errorf("CODEOWNERS evaluation failed: %w", err)
+	}
+
+	ruleOut, violations, err := protectionRules.MergeVerify(ctx, protection.MergeVerifyInput{
+		ResolveUserGroupID: c.userGroupService.ListUserIDsByGroupIDs,
+		Actor:              &session.Principal,
+		AllowBypass:        false,
+		IsRepoOwner:        isRepoOwner,
+		TargetRepo:         repo,
+		SourceRepo:         repo,
+		PullReq:            pr,
+		Reviewers:          reviewers,
+		UserGroupReviewers: userGroupReviewers,
+		Method:             in.Method,
+		CheckResults:       checkResults,
+		CodeOwners:         codeOwnerWithApproval,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify protection rules: %w", err)
+	}
+
+	if protection.IsCritical(violations) {
+		return nil, usererror.BadRequest(protection.GenerateErrorMessageForBlockingViolations(violations))
+	}
+
+	if !slices.Contains(ruleOut.AllowedMethods, in.Method) {
+		return nil, usererror.BadRequestf("Merge method %q is not allowed for the target branch.", in.Method)
+	}
+
+	entry, err := c.mergeQueue.Add(ctx, &session.Principal, repo, pr, in.Method)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add pull request to the merge queue: %w", err)
+	}
+
+	return entry, nil
+}
+
+// MergeQueueRemove removes the pull request from the merge queue.
+func (c *Controller) MergeQueueRemove(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	pullreqNum int64,
+) error {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoPush)
+	if err != nil {
+		return fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	pr, err := c.pullreqStore.FindByNumber(ctx, repo.ID, pullreqNum)
+	if err != nil {
+		return fmt.Errorf("failed to get pull request by number: %w", err)
+	}
+
+	if err = c.mergeQueue.Remove(ctx, &session.Principal, repo, pr); err != nil {
+		return fmt.Errorf("failed to remove pull request from the merge queue: %w", err)
+	}
+
+	return nil
+}
+
+// MergeQueueList returns the pull requests in the merge queue of the branch in the order they will be merged.
+func (c *Controller) MergeQueueList(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	branch string,
+) ([]*types.MergeQueueEntry, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	if branch == "" {
+		branch = repo.DefaultBranch
+	}
+
+	entries, err := c.mergeQueue.List(ctx, repo.ID, branch)
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
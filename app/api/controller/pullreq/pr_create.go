@@ -37,6 +37,7 @@ type CreateInput struct {
 
 	Title       string `json:"title"`
 	Description string `json:"description"`
+	Template    string `json:"template"` // name of the template used for the description
 
 	SourceRepoRef string `json:"source_repo_ref"`
 	SourceBranch  string `json:"source_branch"`
@@ -46,6 +47,7 @@ type CreateInput struct {
 func (in *CreateInput) Sanitize() error {
 	in.Title = strings.TrimSpace(in.Title)
 	in.Description = strings.TrimSpace(in.Description)
+	in.Template = strings.TrimSpace(in.Template)
 
 	if err := validateTitle(in.Title); err != nil {
 		return err
@@ -100,6 +102,10 @@ func (c *Controller) Create(
 		return nil, err
 	}
 
+	if err = c.validateTemplate(ctx, targetRepo, in.TargetBranch, in.Template); err != nil {
+		return nil, err
+	}
+
 	mergeBaseResult, err := c.git.MergeBase(ctx, git.MergeBaseParams{
 		ReadParams: git.ReadParams{RepoUID: sourceRepo.GitUID},
 		Ref1:       in.SourceBranch,
@@ -151,6 +157,26 @@ func (c *Controller) Create(
 		SourceSHA:    sourceSHA.String(),
 	})
 
+	if err = c.updateDescriptionMentions(ctx, session, pr); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("failed to update pull request description mentions")
+	}
+
+	if _, err = c.pullreqService.AddCodeOwnerReviewers(ctx, &session.Principal, targetRepo, pr); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("failed to add code owners as reviewers")
+	}
+
+	if _, err = c.pullreqService.AddDefaultReviewers(ctx, &session.Principal, targetRepo, pr); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("failed to add default reviewers")
+	}
+
+	// adding mentions and reviewers writes activities, so return the pull request with its latest activity sequence.
+	refreshedPR, err := c.pullreqStore.Find(ctx, pr.ID)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("failed to reload pull request after adding reviewers")
+	} else {
+		pr = refreshedPR
+	}
+
 	if err = c.sseStreamer.Publish(ctx, targetRepo.ParentID, enum.SSETypePullRequestUpdated, pr); err != nil {
 		log.Ctx(ctx).Warn().Err(err).Msg("failed to publish PR changed event")
 	}
@@ -194,6 +220,7 @@ func newPullReq(
 		IsDraft:           in.IsDraft,
 		Title:             in.Title,
 		Description:       in.Description,
+		Template:          in.Template,
 		SourceRepoID:      sourceRepo.ID,
 		SourceBranch:      in.SourceBranch,
 		SourceSHA:         sourceSHA.String(),
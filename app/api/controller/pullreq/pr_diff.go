@@ -34,6 +34,7 @@ func (c *Controller) RawDiff(
 	repoRef string,
 	pullreqNum int64,
 	setSHAs func(sourceSHA, mergeBaseSHA string),
+	opts gittypes.DiffOptions,
 	files ...gittypes.FileDiffRequest,
 ) error {
 	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView)
@@ -51,10 +52,12 @@ func (c *Controller) RawDiff(
 	}
 
 	return c.git.RawDiff(ctx, w, &git.DiffParams{
-		ReadParams: git.CreateReadParams(repo),
-		BaseRef:    pr.MergeBaseSHA,
-		HeadRef:    pr.SourceSHA,
-		MergeBase:  true,
+		ReadParams:       git.CreateReadParams(repo),
+		BaseRef:          pr.MergeBaseSHA,
+		HeadRef:          pr.SourceSHA,
+		MergeBase:        true,
+		IgnoreWhitespace: opts.IgnoreWhitespace,
+		ContextLines:     opts.ContextLines,
 	}, files...)
 }
 
@@ -65,6 +68,7 @@ func (c *Controller) Diff(
 	pullreqNum int64,
 	setSHAs func(sourceSHA, mergeBaseSHA string),
 	includePatch bool,
+	opts gittypes.DiffOptions,
 	files ...gittypes.FileDiffRequest,
 ) (types.Stream[*git.FileDiff], error) {
 	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView)
@@ -82,12 +86,59 @@ func (c *Controller) Diff(
 	}
 
 	reader := git.NewStreamReader(c.git.Diff(ctx, &git.DiffParams{
-		ReadParams:   git.CreateReadParams(repo),
-		BaseRef:      pr.MergeBaseSHA,
-		HeadRef:      pr.SourceSHA,
-		MergeBase:    true,
-		IncludePatch: includePatch,
+		ReadParams:       git.CreateReadParams(repo),
+		BaseRef:          pr.MergeBaseSHA,
+		HeadRef:          pr.SourceSHA,
+		MergeBase:        true,
+		IncludePatch:     includePatch,
+		IgnoreWhitespace: opts.IgnoreWhitespace,
+		ContextLines:     opts.ContextLines,
 	}, files...))
 
 	return reader, nil
 }
+
+// DiffManifest returns one page of the list of files changed in the pull request, along with the total
+// number of changed files. The manifest only contains the per-file line counts, no patch is generated,
+// so clients can render the file list of huge pull requests first and then load the diff of individual
+// files on demand with the Diff endpoint.
+func (c *Controller) DiffManifest(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	pullreqNum int64,
+	setSHAs func(sourceSHA, mergeBaseSHA string),
+	pagination types.Pagination,
+) ([]types.CommitFileStats, int, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to acquire access to target repo: %w", err)
+	}
+
+	pr, err := c.pullreqStore.FindByNumber(ctx, repo.ID, pullreqNum)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get pull request by number: %w", err)
+	}
+
+	if setSHAs != nil {
+		setSHAs(pr.SourceSHA, pr.MergeBaseSHA)
+	}
+
+	stats, err := c.pullreqService.FileStats(ctx, pr)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get pull request file stats: %w", err)
+	}
+
+	total := len(stats.Files)
+
+	// compare pages rather than offsets, a huge page number would overflow the offset.
+	pages := (total + pagination.Size - 1) / pagination.Size
+	if pagination.Page > pages {
+		return []types.CommitFileStats{}, total, nil
+	}
+
+	from := (pagination.Page - 1) * pagination.Size
+	to := min(from+pagination.Size, total)
+
+	return stats.Files[from:to], total, nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/git"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// Export writes the changes of the pull request to the writer w in the requested format:
+// as a unified diff, as a single patch in the email format or as a patch series in the mbox format.
+// The setFilename callback is called with the suggested name of the file before anything is written to w.
+func (c *Controller) Export(
+	ctx context.Context,
+	w io.Writer,
+	session *auth.Session,
+	repoRef string,
+	pullreqNum int64,
+	format enum.PullReqExportFormat,
+	setFilename func(filename string),
+) error {
+	format, ok := format.Sanitize()
+	if !ok {
+		return usererror.BadRequestf("Unsupported export format: %s", format)
+	}
+
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView)
+	if err != nil {
+		return fmt.Errorf("failed to acquire access to target repo: %w", err)
+	}
+
+	pr, err := c.pullreqStore.FindByNumber(ctx, repo.ID, pullreqNum)
+	if err != nil {
+		return fmt.Errorf("failed to get pull request by number: %w", err)
+	}
+
+	if setFilename != nil {
+		setFilename(fmt.Sprintf("pullreq-%d.%s", pr.Number, format))
+	}
+
+	diffParams := &git.DiffParams{
+		ReadParams: git.CreateReadParams(repo),
+		BaseRef:    pr.MergeBaseSHA,
+		HeadRef:    pr.SourceSHA,
+		MergeBase:  true,
+	}
+
+	switch format {
+	case enum.PullReqExportFormatMbox:
+		return c.git.FormatPatch(ctx, diffParams, w)
+	case enum.PullReqExportFormatPatch:
+		if err = writePatchHeader(w, pr); err != nil {
+			return fmt.Errorf("failed to write patch header: %w", err)
+		}
+		return c.git.RawDiff(ctx, w, diffParams)
+	case enum.PullReqExportFormatDiff:
+		return c.git.RawDiff(ctx, w, diffParams)
+	}
+
+	return nil
+}
+
+// writePatchHeader writes the email header of a patch, in the same format as git format-patch does,
+// so that the patch can be applied with git am.
+func writePatchHeader(w io.Writer, pr *types.PullReq) error {
+	from := mail.Address{Name: pr.Author.DisplayName, Address: pr.Author.Email}
+
+	_, err := fmt.Fprintf(w, "From %s Mon Sep 17 00:00:00 2001\n"+
+		"From: %s\n"+
+		"Date: %s\n"+
+		"Subject: %s\n"+
+		"MIME-Version: 1.0\n"+
+		"Content-Type: text/plain; charset=UTF-8\n"+
+		"Content-Transfer-Encoding: 8bit\n\n",
+		pr.SourceSHA,
+		from.String(),
+		time.UnixMilli(pr.Created).UTC().Format(time.RFC1123Z),
+		mime.QEncoding.Encode("utf-8", "[PATCH] "+pr.Title))
+	if err != nil {
+		return err
+	}
+
+	if pr.Description != "" {
+		for _, line := range strings.Split(pr.Description, "\n") {
+			if _, err = fmt.Fprintf(w, "%s\n", escapePatchDescriptionLine(line)); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = io.WriteString(w, "---\n")
+
+	return err
+}
+
+// escapePatchDescriptionLine escapes a line of the pull request description that git am would otherwise
+// treat as the start of a new message ("From ") or as the end of the commit message ("---" or a diff).
+func escapePatchDescriptionLine(line string) string {
+	switch {
+	case strings.HasPrefix(line, "From "):
+		return ">" + line
+	case strings.HasPrefix(line, "---"), strings.HasPrefix(line, "diff -"), strings.HasPrefix(line, "Index: "):
+		return " " + line
+	default:
+		return line
+	}
+}
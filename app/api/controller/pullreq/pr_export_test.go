@@ -0,0 +1,88 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/harness/gitness/types"
+)
+
+func TestWritePatchHeader(t *testing.T) {
+	tests := []struct {
+		name        string
+		title       string
+		description string
+		authorName  string
+		want        string
+	}{
+		{
+			name:        "plain",
+			title:       "Fix the build",
+			description: "The build was broken.",
+			authorName:  "Jane Doe",
+			want: "From a0b1c2d3e4f5a0b1c2d3e4f5a0b1c2d3e4f5a0b1 Mon Sep 17 00:00:00 2001\n" +
+				"From: \"Jane Doe\" <jane@example.com>\n" +
+				"Date: Tue, 14 Nov 2023 22:13:20 +0000\n" +
+				"Subject: [PATCH] Fix the build\n" +
+				"MIME-Version: 1.0\n" +
+				"Content-Type: text/plain; charset=UTF-8\n" +
+				"Content-Transfer-Encoding: 8bit\n" +
+				"\n" +
+				"The build was broken.\n" +
+				"---\n",
+		},
+		{
+			name:        "encoded headers and escaped description",
+			title:       "Füge Tests hinzu",
+			description: "From the start\n---\ndiff --git a b",
+			authorName:  "Jörg",
+			want: "From a0b1c2d3e4f5a0b1c2d3e4f5a0b1c2d3e4f5a0b1 Mon Sep 17 00:00:00 2001\n" +
+				"From: =?utf-8?q?J=C3=B6rg?= <jane@example.com>\n" +
+				"Date: Tue, 14 Nov 2023 22:13:20 +0000\n" +
+				"Subject: =?utf-8?q?[PATCH]_F=C3=BCge_Tests_hinzu?=\n" +
+				"MIME-Version: 1.0\n" +
+				"Content-Type: text/plain; charset=UTF-8\n" +
+				"Content-Transfer-Encoding: 8bit\n" +
+				"\n" +
+				">From the start\n" +
+				" ---\n" +
+				" diff --git a b\n" +
+				"---\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := &types.PullReq{
+				Title:       tt.title,
+				Description: tt.description,
+				SourceSHA:   "a0b1c2d3e4f5a0b1c2d3e4f5a0b1c2d3e4f5a0b1",
+				Created:     1700000000000,
+				Author:      types.PrincipalInfo{DisplayName: tt.authorName, Email: "jane@example.com"},
+			}
+
+			buf := &bytes.Buffer{}
+			if err := writePatchHeader(buf, pr); err != nil {
+				t.Fatalf("writePatchHeader() failed: %v", err)
+			}
+
+			if got := buf.String(); got != tt.want {
+				t.Errorf("writePatchHeader() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -26,6 +26,10 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+// maxPullReqFindFileStats is the maximum number of per-file statistics returned with a single pull request.
+// The statistics of larger pull requests are only available from the file stats endpoint.
+const maxPullReqFindFileStats = 100
+
 // Find returns a pull request from the provided repository.
 func (c *Controller) Find(
 	ctx context.Context,
@@ -56,5 +60,39 @@ func (c *Controller) Find(
 		log.Ctx(ctx).Warn().Err(err).Msg("failed to backfill PR stats")
 	}
 
+	if err := c.backfillReviewStats(ctx, pr); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("failed to backfill PR review stats")
+	}
+
+	// the file stats are computed when the source branch moves, so only the cached ones are returned here.
+	if fileStats, err := c.pullreqService.CachedFileStats(ctx, pr); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("failed to backfill PR file stats")
+	} else if fileStats != nil && len(fileStats.Files) <= maxPullReqFindFileStats {
+		pr.Stats.Files = fileStats.Files
+	}
+
 	return pr, nil
 }
+
+// backfillReviewStats sets the aggregated review decisions of the pull request reviewers in the PR stats.
+func (c *Controller) backfillReviewStats(ctx context.Context, pr *types.PullReq) error {
+	reviewers, err := c.reviewerStore.List(ctx, pr.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list reviewers: %w", err)
+	}
+
+	for _, reviewer := range reviewers {
+		switch reviewer.ReviewDecision {
+		case enum.PullReqReviewDecisionApproved:
+			pr.Stats.Approvals++
+			if reviewer.SHA == pr.SourceSHA {
+				pr.Stats.ApprovalsLatest++
+			}
+		case enum.PullReqReviewDecisionChangeReq:
+			pr.Stats.ChangeRequests++
+		case enum.PullReqReviewDecisionPending, enum.PullReqReviewDecisionReviewed:
+		}
+	}
+
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	apiauth "github.com/harness/gitness/app/api/auth"
 	"github.com/harness/gitness/app/auth"
@@ -29,13 +30,17 @@ import (
 )
 
 type UpdateInput struct {
-	Title       string `json:"title"`
-	Description string `json:"description"`
+	Title       string  `json:"title"`
+	Description string  `json:"description"`
+	Template    *string `json:"template"` // name of the template used for the description, unchanged if not provided
 }
 
 func (in *UpdateInput) Sanitize() error {
 	in.Title = strings.TrimSpace(in.Title)
 	in.Description = strings.TrimSpace(in.Description)
+	if in.Template != nil {
+		*in.Template = strings.TrimSpace(*in.Template)
+	}
 
 	if err := validateTitle(in.Title); err != nil {
 		return err
@@ -85,23 +90,66 @@ func (c *Controller) Update(ctx context.Context,
 
 	titleChanged := titleOld != in.Title
 	descriptionChanged := descriptionOld != in.Description
+	templateChanged := in.Template != nil && *in.Template != pr.Template
 
-	if !titleChanged && !descriptionChanged {
+	if !titleChanged && !descriptionChanged && !templateChanged {
 		return pr, nil
 	}
 
+	if templateChanged {
+		if err = c.validateTemplate(ctx, targetRepo, pr.TargetBranch, *in.Template); err != nil {
+			return nil, err
+		}
+	}
+
 	needToWriteActivity := titleChanged
 
-	pr, err = c.pullreqStore.UpdateOptLock(ctx, pr, func(pr *types.PullReq) error {
-		pr.Title = in.Title
-		pr.Description = in.Description
-		if needToWriteActivity {
-			pr.ActivitySeq++
+	// the edit history only tracks the title and the description, a template change alone isn't an edit.
+	contentChanged := titleChanged || descriptionChanged
+
+	err = c.tx.WithTx(ctx, func(ctx context.Context) error {
+		edit := &types.PullReqEdit{
+			PullReqID: pr.ID,
+			CreatedBy: session.Principal.ID,
+		}
+
+		pr, err = c.pullreqStore.UpdateOptLock(ctx, pr, func(pr *types.PullReq) error {
+			now := time.Now().UnixMilli()
+
+			// keep the prior version of the title and the description in the edit history.
+			edit.Created = now
+			edit.Title = pr.Title
+			edit.Description = pr.Description
+
+			pr.Title = in.Title
+			pr.Description = in.Description
+			if in.Template != nil {
+				pr.Template = *in.Template
+			}
+			if contentChanged {
+				pr.ContentEdited = &now
+			}
+			if needToWriteActivity {
+				pr.ActivitySeq++
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update pull request: %w", err)
+		}
+
+		if !contentChanged {
+			return nil
+		}
+
+		if err = c.editStore.Create(ctx, edit); err != nil {
+			return fmt.Errorf("failed to record pull request edit: %w", err)
 		}
+
 		return nil
 	})
 	if err != nil {
-		return pr, fmt.Errorf("failed to update pull request: %w", err)
+		return nil, err
 	}
 
 	if needToWriteActivity {
@@ -132,6 +180,12 @@ func (c *Controller) Update(ctx context.Context,
 
 	c.eventReporter.Updated(ctx, updateEvent)
 
+	if descriptionChanged {
+		if err = c.updateDescriptionMentions(ctx, session, pr); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msg("failed to update pull request description mentions")
+		}
+	}
+
 	if err = c.sseStreamer.Publish(ctx, targetRepo.ParentID, enum.SSETypePullRequestUpdated, pr); err != nil {
 		log.Ctx(ctx).Warn().Err(err).Msg("failed to publish PR changed event")
 	}
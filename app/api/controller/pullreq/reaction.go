@@ -0,0 +1,208 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+type ReactionInput struct {
+	Reaction enum.PullReqReaction `json:"reaction"`
+}
+
+func (in *ReactionInput) Validate() error {
+	if _, ok := in.Reaction.Sanitize(); !ok {
+		return usererror.BadRequest("Invalid value provided for reaction")
+	}
+
+	return nil
+}
+
+// ReactionAdd adds a reaction of the principal to a pull request comment.
+// If the comment ID is zero, the reaction is added to the pull request description.
+// It returns the summary of all reactions to the comment (or description).
+func (c *Controller) ReactionAdd(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	prNum int64,
+	commentID int64,
+	in *ReactionInput,
+) ([]types.PullReqReactionSummary, error) {
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
+	pr, err := c.getPullReqForReaction(ctx, session, repoRef, prNum, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.reactionStore.Create(ctx, &types.PullReqReaction{
+		PullReqID:   pr.ID,
+		ActivityID:  commentID,
+		PrincipalID: session.Principal.ID,
+		Reaction:    in.Reaction,
+		Created:     time.Now().UnixMilli(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add pull request reaction: %w", err)
+	}
+
+	reactions, err := c.listReactions(ctx, pr.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return reactions[commentID], nil
+}
+
+// ReactionDelete removes a reaction of the principal from a pull request comment.
+// If the comment ID is zero, the reaction is removed from the pull request description.
+func (c *Controller) ReactionDelete(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	prNum int64,
+	commentID int64,
+	reaction enum.PullReqReaction,
+) error {
+	pr, err := c.getPullReqForReaction(ctx, session, repoRef, prNum, commentID)
+	if err != nil {
+		return err
+	}
+
+	err = c.reactionStore.Delete(ctx, pr.ID, commentID, session.Principal.ID, reaction)
+	if err != nil {
+		return fmt.Errorf("failed to delete pull request reaction: %w", err)
+	}
+
+	return nil
+}
+
+// ReactionList returns the summary of all reactions to the pull request description.
+// Reactions to comments are returned as part of the activity list.
+func (c *Controller) ReactionList(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	prNum int64,
+) ([]types.PullReqReactionSummary, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	pr, err := c.pullreqStore.FindByNumber(ctx, repo.ID, prNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pull request by number: %w", err)
+	}
+
+	reactions, err := c.listReactions(ctx, pr.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if reactions[0] == nil {
+		return []types.PullReqReactionSummary{}, nil
+	}
+
+	return reactions[0], nil
+}
+
+func (c *Controller) getPullReqForReaction(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	prNum int64,
+	commentID int64,
+) (*types.PullReq, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoReview)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	pr, err := c.pullreqStore.FindByNumber(ctx, repo.ID, prNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find pull request by number: %w", err)
+	}
+
+	if err = c.checkConversationLocked(ctx, session, repo, pr); err != nil {
+		return nil, err
+	}
+
+	if commentID != 0 {
+		comment, err := c.getCommentForPR(ctx, pr, commentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get comment: %w", err)
+		}
+
+		if comment.Pending {
+			return nil, usererror.BadRequest("Can't react to pending comments.")
+		}
+	}
+
+	return pr, nil
+}
+
+// listReactions returns summaries of all reactions of the pull request grouped by activity ID.
+// Reactions to the pull request description are stored under the activity ID zero.
+func (c *Controller) listReactions(
+	ctx context.Context,
+	prID int64,
+) (map[int64][]types.PullReqReactionSummary, error) {
+	reactions, err := c.reactionStore.List(ctx, prID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull request reactions: %w", err)
+	}
+
+	return summarizeReactions(reactions), nil
+}
+
+// summarizeReactions groups reactions by activity ID and reaction, preserving the order of first occurrence.
+func summarizeReactions(reactions []*types.PullReqReaction) map[int64][]types.PullReqReactionSummary {
+	summaries := make(map[int64][]types.PullReqReactionSummary)
+
+	for _, reaction := range reactions {
+		list := summaries[reaction.ActivityID]
+
+		idx := -1
+		for i := range list {
+			if list[i].Reaction == reaction.Reaction {
+				idx = i
+				break
+			}
+		}
+
+		if idx < 0 {
+			list = append(list, types.PullReqReactionSummary{Reaction: reaction.Reaction})
+			idx = len(list) - 1
+		}
+
+		list[idx].Count++
+		list[idx].PrincipalIDs = append(list[idx].PrincipalIDs, reaction.PrincipalID)
+
+		summaries[reaction.ActivityID] = list
+	}
+
+	return summaries
+}
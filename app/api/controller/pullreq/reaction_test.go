@@ -0,0 +1,46 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+func TestSummarizeReactions(t *testing.T) {
+	reactions := []*types.PullReqReaction{
+		{ActivityID: 0, PrincipalID: 1, Reaction: enum.PullReqReactionHooray},
+		{ActivityID: 7, PrincipalID: 1, Reaction: enum.PullReqReactionThumbsUp},
+		{ActivityID: 7, PrincipalID: 2, Reaction: enum.PullReqReactionHeart},
+		{ActivityID: 7, PrincipalID: 3, Reaction: enum.PullReqReactionThumbsUp},
+	}
+
+	want := map[int64][]types.PullReqReactionSummary{
+		0: {
+			{Reaction: enum.PullReqReactionHooray, Count: 1, PrincipalIDs: []int64{1}},
+		},
+		7: {
+			{Reaction: enum.PullReqReactionThumbsUp, Count: 2, PrincipalIDs: []int64{1, 3}},
+			{Reaction: enum.PullReqReactionHeart, Count: 1, PrincipalIDs: []int64{2}},
+		},
+	}
+
+	if got := summarizeReactions(reactions); !reflect.DeepEqual(got, want) {
+		t.Errorf("summarizeReactions() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/api/controller"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/bootstrap"
+	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/errors"
+	"github.com/harness/gitness/git"
+	"github.com/harness/gitness/git/sha"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// RevertInput is used for reverting a merged pull request.
+type RevertInput struct {
+	// Title is the title of the revert commit and of the new pull request.
+	// If not provided, it's generated from the title of the reverted pull request.
+	Title string `json:"title"`
+	// RevertBranch is the name of the branch created for the revert commit.
+	// If not provided, it's generated from the number of the reverted pull request.
+	RevertBranch string `json:"revert_branch"`
+
+	DryRunRules bool `json:"dry_run_rules"`
+	BypassRules bool `json:"bypass_rules"`
+}
+
+func (in *RevertInput) sanitize(pr *types.PullReq) error {
+	in.Title = strings.TrimSpace(in.Title)
+	in.RevertBranch = strings.TrimSpace(in.RevertBranch)
+
+	if in.Title == "" {
+		in.Title = fmt.Sprintf("Revert %q", pr.Title)
+	}
+
+	if in.RevertBranch == "" {
+		in.RevertBranch = fmt.Sprintf("revert-pullreq-%d", pr.Number)
+	}
+
+	return validateTitle(in.Title)
+}
+
+// Revert creates a commit, on a new branch, that reverts the changes of a merged pull request
+// and opens a pull request for it.
+//
+//nolint:gocognit // refactor if needed.
+func (c *Controller) Revert(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	pullreqNum int64,
+	in *RevertInput,
+) (types.RevertOutput, []types.RuleViolations, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoPush)
+	if err != nil {
+		return types.RevertOutput{}, nil, fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	pr, err := c.pullreqStore.FindByNumber(ctx, repo.ID, pullreqNum)
+	if err != nil {
+		return types.RevertOutput{}, nil, fmt.Errorf("failed to get pull request by number: %w", err)
+	}
+
+	if pr.State != enum.PullReqStateMerged || pr.MergeSHA == nil || pr.MergeTargetSHA == nil {
+		return types.RevertOutput{}, nil, usererror.BadRequest("Only merged pull requests can be reverted.")
+	}
+
+	if err = in.sanitize(pr); err != nil {
+		return types.RevertOutput{}, nil, err
+	}
+
+	if _, err = c.verifyBranchExistence(ctx, repo, pr.TargetBranch); err != nil {
+		return types.RevertOutput{}, nil, err
+	}
+
+	rules, isRepoOwner, err := c.fetchRules(ctx, session, repo)
+	if err != nil {
+		return types.RevertOutput{}, nil, fmt.Errorf("failed to fetch rules: %w", err)
+	}
+
+	violations, err := rules.RefChangeVerify(ctx, protection.RefChangeVerifyInput{
+		ResolveUserGroupID: c.userGroupService.ListUserIDsByGroupIDs,
+		Actor:              &session.Principal,
+		AllowBypass:        in.BypassRules,
+		IsRepoOwner:        isRepoOwner,
+		Repo:               repo,
+		RefAction:          protection.RefActionCreate,
+		RefType:            protection.RefTypeBranch,
+		RefNames:           []string{in.RevertBranch},
+	})
+	if err != nil {
+		return types.RevertOutput{}, nil, fmt.Errorf("failed to verify protection rules: %w", err)
+	}
+
+	if in.DryRunRules {
+		return types.RevertOutput{
+			DryRunRulesOutput: types.DryRunRulesOutput{
+				DryRunRules:    true,
+				RuleViolations: violations,
+			},
+		}, nil, nil
+	}
+
+	if protection.IsCritical(violations) {
+		return types.RevertOutput{}, violations, nil
+	}
+
+	// Create internal write params. Note: This will skip the pre-commit protection rules check.
+	writeParams, err := controller.CreateRPCInternalWriteParams(ctx, c.urlProvider, session, repo)
+	if err != nil {
+		return types.RevertOutput{}, nil, fmt.Errorf("failed to create RPC write params: %w", err)
+	}
+
+	now := time.Now()
+	revertOutput, err := c.git.Revert(ctx, &git.RevertParams{
+		WriteParams:   writeParams,
+		CommitSHA:     sha.Must(*pr.MergeSHA),
+		ParentSHA:     sha.Must(*pr.MergeTargetSHA),
+		Branch:        pr.TargetBranch,
+		NewBranch:     in.RevertBranch,
+		Title:         in.Title,
+		Message:       fmt.Sprintf("This reverts commit %s.", *pr.MergeSHA),
+		Committer:     identityFromPrincipalInfo(*bootstrap.NewSystemServiceSession().Principal.ToPrincipalInfo()),
+		CommitterDate: &now,
+		Author:        identityFromPrincipalInfo(*session.Principal.ToPrincipalInfo()),
+		AuthorDate:    &now,
+	})
+	if err != nil {
+		return types.RevertOutput{}, nil, fmt.Errorf("revert execution failed: %w", err)
+	}
+
+	if len(revertOutput.ConflictFiles) > 0 {
+		return types.RevertOutput{}, nil, errors.Conflict("Revert blocked by conflicting files: %v",
+			revertOutput.ConflictFiles)
+	}
+
+	revertPR, err := c.Create(ctx, session, repoRef, &CreateInput{
+		Title:        in.Title,
+		Description:  fmt.Sprintf("Reverts #%d.", pr.Number),
+		SourceBranch: in.RevertBranch,
+		TargetBranch: pr.TargetBranch,
+	})
+	if err != nil {
+		// don't leave behind the branch that was created only for the revert pull request.
+		errDelete := c.git.DeleteBranch(ctx, &git.DeleteBranchParams{
+			WriteParams: writeParams,
+			BranchName:  in.RevertBranch,
+			SHA:         revertOutput.CommitSHA.String(),
+		})
+		if errDelete != nil {
+			log.Ctx(ctx).Err(errDelete).Msgf("failed to delete branch %q after revert pull request creation failed",
+				in.RevertBranch)
+		}
+
+		return types.RevertOutput{}, nil, fmt.Errorf("failed to create revert pull request: %w", err)
+	}
+
+	c.writeRevertActivities(ctx, session, pr, revertPR, revertOutput.CommitSHA.String())
+
+	return types.RevertOutput{
+		CommitSHA: revertOutput.CommitSHA.String(),
+		Branch:    in.RevertBranch,
+		PullReq:   revertPR,
+		DryRunRulesOutput: types.DryRunRulesOutput{
+			RuleViolations: violations,
+		},
+	}, nil, nil
+}
+
+// writeRevertActivities writes the revert activity to both the reverted and the reverting pull request.
+func (c *Controller) writeRevertActivities(
+	ctx context.Context,
+	session *auth.Session,
+	pr *types.PullReq,
+	revertPR *types.PullReq,
+	commitSHA string,
+) {
+	c.writeSystemActivity(ctx, pr, session.Principal.ID, &types.PullRequestActivityPayloadRevert{
+		Number:    revertPR.Number,
+		Incoming:  false,
+		CommitSHA: commitSHA,
+	})
+
+	c.writeSystemActivity(ctx, revertPR, session.Principal.ID, &types.PullRequestActivityPayloadRevert{
+		Number:    pr.Number,
+		Incoming:  true,
+		CommitSHA: commitSHA,
+	})
+}
@@ -85,6 +85,21 @@ func (c *Controller) ReviewSubmit(
 		return nil, usererror.BadRequest("Can't submit review to own pull requests.")
 	}
 
+	// submitting a review publishes the reviewer's pending comments, which isn't allowed on locked conversations
+	if pr.Locked != nil {
+		var pending []*types.PullReqActivity
+		pending, err = c.activityStore.ListPending(ctx, pr.ID, session.Principal.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pending comments: %w", err)
+		}
+
+		if len(pending) > 0 {
+			if err = c.checkConversationLocked(ctx, session, repo, pr); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	commit, err := c.git.GetCommit(ctx, &git.GetCommitParams{
 		ReadParams: git.ReadParams{RepoUID: repo.GitUID},
 		Revision:   in.CommitSHA,
@@ -96,6 +111,7 @@ func (c *Controller) ReviewSubmit(
 	commitSHA := commit.Commit.SHA
 
 	var review *types.PullReqReview
+	var published []*types.PullReqActivity
 
 	err = c.tx.WithTx(ctx, func(ctx context.Context) error {
 		now := time.Now().UnixMilli()
@@ -120,12 +136,30 @@ func (c *Controller) ReviewSubmit(
 		})
 
 		_, err = c.updateReviewer(ctx, session, pr, review, commitSHA.String())
+		if err != nil {
+			return err
+		}
+
+		pr, published, err = c.publishPendingComments(ctx, session, pr)
 		return err
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	if len(published) > 0 {
+		if err = c.sseStreamer.Publish(ctx, repo.ParentID, enum.SSETypePullRequestUpdated, pr); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msg("failed to publish PR changed event")
+		}
+	}
+
+	// published comments are reported the same way as comments created without a review
+	for _, act := range published {
+		if act.Type == enum.PullReqActivityTypeComment && act.Kind == enum.PullReqActivityKindComment {
+			c.reportCommentCreated(ctx, pr, session.Principal.ID, act.ID, act.IsReply())
+		}
+	}
+
 	err = func() error {
 		if pr, err = c.pullreqStore.UpdateActivitySeq(ctx, pr); err != nil {
 			return fmt.Errorf("failed to increment pull request activity sequence: %w", err)
@@ -204,3 +238,50 @@ func (c *Controller) updateReviewer(
 
 	return reviewer, nil
 }
+
+// publishPendingComments makes all pending comments of the reviewer visible
+// and adds them to the pull request's comment counters.
+// It returns the updated pull request and the published comments.
+func (c *Controller) publishPendingComments(
+	ctx context.Context,
+	session *auth.Session,
+	pr *types.PullReq,
+) (*types.PullReq, []*types.PullReqActivity, error) {
+	pending, err := c.activityStore.ListPending(ctx, pr.ID, session.Principal.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list pending comments: %w", err)
+	}
+
+	if len(pending) == 0 {
+		return pr, nil, nil
+	}
+
+	err = c.activityStore.PublishPending(ctx, pr.ID, session.Principal.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to publish pending comments: %w", err)
+	}
+
+	published := make([]*types.PullReqActivity, 0, len(pending))
+	var unresolvedCount int
+	for _, act := range pending {
+		if act.Deleted != nil {
+			continue
+		}
+
+		published = append(published, act)
+		if act.IsBlocking() {
+			unresolvedCount++
+		}
+	}
+
+	pr, err = c.pullreqStore.UpdateOptLock(ctx, pr, func(pr *types.PullReq) error {
+		pr.CommentCount += len(published)
+		pr.UnresolvedCount += unresolvedCount
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to update pull request comment counters: %w", err)
+	}
+
+	return pr, published, nil
+}
@@ -16,15 +16,12 @@ package pullreq
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"time"
 
 	apiauth "github.com/harness/gitness/app/api/auth"
 	"github.com/harness/gitness/app/api/usererror"
 	"github.com/harness/gitness/app/auth"
 	events "github.com/harness/gitness/app/events/pullreq"
-	"github.com/harness/gitness/store"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
 
@@ -97,59 +94,11 @@ func (c *Controller) ReviewerAdd(
 		}
 	}
 
-	var reviewer *types.PullReqReviewer
-	var added bool
-
-	err = c.tx.WithTx(ctx, func(ctx context.Context) error {
-		reviewer, err = c.reviewerStore.Find(ctx, pr.ID, in.ReviewerID)
-		if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
-			return err
-		}
-
-		if reviewer != nil {
-			return nil
-		}
-
-		reviewer = newPullReqReviewer(session, pr, repo, reviewerInfo, addedByInfo, reviewerType, in)
-		added = true
-
-		return c.reviewerStore.Create(ctx, reviewer)
-	})
+	reviewer, _, err := c.pullreqService.AddReviewer(ctx, &session.Principal, repo, pr, reviewerInfo, reviewerType)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create pull request reviewer: %w", err)
+		return nil, fmt.Errorf("failed to add pull request reviewer: %w", err)
 	}
 
-	if !added {
-		return reviewer, nil
-	}
-
-	err = func() error {
-		payload := &types.PullRequestActivityPayloadReviewerAdd{
-			PrincipalID:  reviewer.PrincipalID,
-			ReviewerType: reviewerType,
-		}
-
-		metadata := &types.PullReqActivityMetadata{
-			Mentions: &types.PullReqActivityMentionsMetadata{IDs: []int64{reviewer.PrincipalID}},
-		}
-
-		if pr, err = c.pullreqStore.UpdateActivitySeq(ctx, pr); err != nil {
-			return fmt.Errorf("failed to increment pull request activity sequence: %w", err)
-		}
-
-		_, err = c.activityStore.CreateWithPayload(ctx, pr, session.Principal.ID, payload, metadata)
-		if err != nil {
-			return fmt.Errorf("failed to create pull request activity: %w", err)
-		}
-
-		return nil
-	}()
-	if err != nil {
-		log.Ctx(ctx).Err(err).Msg("failed to write pull request activity after adding a reviewer")
-	}
-
-	c.reportReviewerAddition(ctx, session, pr, reviewer)
-
 	return reviewer, nil
 }
 
@@ -164,26 +113,3 @@ func (c *Controller) reportReviewerAddition(
 		ReviewerID: reviewer.PrincipalID,
 	})
 }
-
-// newPullReqReviewer creates new pull request reviewer object.
-func newPullReqReviewer(
-	session *auth.Session, pullReq *types.PullReq,
-	repo *types.Repository, reviewerInfo, addedByInfo *types.PrincipalInfo,
-	reviewerType enum.PullReqReviewerType, in *ReviewerAddInput,
-) *types.PullReqReviewer {
-	now := time.Now().UnixMilli()
-	return &types.PullReqReviewer{
-		PullReqID:      pullReq.ID,
-		PrincipalID:    in.ReviewerID,
-		CreatedBy:      session.Principal.ID,
-		Created:        now,
-		Updated:        now,
-		RepoID:         repo.ID,
-		Type:           reviewerType,
-		LatestReviewID: nil,
-		ReviewDecision: enum.PullReqReviewDecisionPending,
-		SHA:            "",
-		Reviewer:       *reviewerInfo,
-		AddedBy:        *addedByInfo,
-	}
-}
@@ -59,6 +59,8 @@ func (c *Controller) ReviewersListCombined(
 	}
 
 	for _, userGroupReviewer := range userGroupReviewers {
+		userGroupReviewer.Decision = enum.PullReqReviewDecisionPending
+
 		userGroup, err := c.userGroupStore.Find(ctx, userGroupReviewer.UserGroupID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to find user group: %w", err)
@@ -89,6 +91,7 @@ func (c *Controller) ReviewersListCombined(
 			}
 		}
 		userGroupReviewer.Reviewers = userGroupReviewerDecisions
+		userGroupReviewer.Decision, userGroupReviewer.SHA = aggregateUserGroupDecision(userGroupReviewerDecisions)
 	}
 
 	return &CombinedListResponse{
@@ -97,6 +100,32 @@ func (c *Controller) ReviewersListCombined(
 	}, nil
 }
 
+// aggregateUserGroupDecision returns the review decision of a user group and the SHA the decision was made for.
+// Membership of the group is resolved at the evaluation time, so the decisions are of the current members only.
+// An approval of any member satisfies the review request, otherwise requested changes take precedence.
+func aggregateUserGroupDecision(
+	decisions []types.UserGroupReviewerDecision,
+) (enum.PullReqReviewDecision, string) {
+	priority := map[enum.PullReqReviewDecision]int{
+		enum.PullReqReviewDecisionPending:   0,
+		enum.PullReqReviewDecisionReviewed:  1,
+		enum.PullReqReviewDecisionChangeReq: 2,
+		enum.PullReqReviewDecisionApproved:  3,
+	}
+
+	decision := enum.PullReqReviewDecisionPending
+	var sha string
+
+	for _, d := range decisions {
+		if priority[d.ReviewDecision] > priority[decision] {
+			decision = d.ReviewDecision
+			sha = d.SHA
+		}
+	}
+
+	return decision, sha
+}
+
 func createReviewerMap(reviewers []*types.PullReqReviewer) map[int64]*types.PullReqReviewer {
 	reviewerMap := make(map[int64]*types.PullReqReviewer)
 	for _, reviewer := range reviewers {
@@ -0,0 +1,64 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"testing"
+
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+func Test_aggregateUserGroupDecision(t *testing.T) {
+	tests := []struct {
+		name      string
+		decisions []types.UserGroupReviewerDecision
+		want      enum.PullReqReviewDecision
+		wantSHA   string
+	}{
+		{
+			name:      "no-decisions",
+			decisions: nil,
+			want:      enum.PullReqReviewDecisionPending,
+		},
+		{
+			name: "any-approval-satisfies",
+			decisions: []types.UserGroupReviewerDecision{
+				{ReviewDecision: enum.PullReqReviewDecisionChangeReq, SHA: "a"},
+				{ReviewDecision: enum.PullReqReviewDecisionApproved, SHA: "b"},
+				{ReviewDecision: enum.PullReqReviewDecisionReviewed, SHA: "c"},
+			},
+			want:    enum.PullReqReviewDecisionApproved,
+			wantSHA: "b",
+		},
+		{
+			name: "change-request-over-reviewed",
+			decisions: []types.UserGroupReviewerDecision{
+				{ReviewDecision: enum.PullReqReviewDecisionReviewed, SHA: "a"},
+				{ReviewDecision: enum.PullReqReviewDecisionChangeReq, SHA: "b"},
+			},
+			want:    enum.PullReqReviewDecisionChangeReq,
+			wantSHA: "b",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotSHA := aggregateUserGroupDecision(tt.decisions)
+			if got != tt.want || gotSHA != tt.wantSHA {
+				t.Errorf("aggregateUserGroupDecision() = %s %s, want %s %s", got, gotSHA, tt.want, tt.wantSHA)
+			}
+		})
+	}
+}
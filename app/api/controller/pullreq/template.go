@@ -0,0 +1,181 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/errors"
+	"github.com/harness/gitness/git"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// pullReqTemplatePath is the path of the default pull request template.
+	pullReqTemplatePath = ".harness/pull_request_template.md"
+	// pullReqTemplateDir is the directory containing named pull request templates.
+	pullReqTemplateDir = ".harness/PULL_REQUEST_TEMPLATE"
+
+	pullReqTemplateDefaultName = "default"
+	pullReqTemplateExt         = ".md"
+
+	maxPullReqTemplateSize = 64 << 10 // 64K, same as the max length of the pull request description
+)
+
+// Templates returns all pull request templates of the repository found on the provided branch.
+// If no branch is provided, the templates are read from the default branch.
+func (c *Controller) Templates(
+	ctx context.Context,
+	session *auth.Session,
+	repoRef string,
+	branch string,
+) ([]*types.PullReqTemplate, error) {
+	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire access to repo: %w", err)
+	}
+
+	if branch == "" {
+		branch = repo.DefaultBranch
+	}
+
+	return c.listTemplates(ctx, repo, branch)
+}
+
+// validateTemplate checks that the pull request template with the provided name exists on the branch.
+// An empty name is valid, as it means no template was used.
+func (c *Controller) validateTemplate(
+	ctx context.Context,
+	repo *types.Repository,
+	branch string,
+	name string,
+) error {
+	if name == "" {
+		return nil
+	}
+
+	templates, err := c.listTemplates(ctx, repo, branch)
+	if err != nil {
+		return err
+	}
+
+	for _, template := range templates {
+		if template.Name == name {
+			return nil
+		}
+	}
+
+	return usererror.BadRequestf("Pull request template %q doesn't exist on branch %q", name, branch)
+}
+
+func (c *Controller) listTemplates(
+	ctx context.Context,
+	repo *types.Repository,
+	branch string,
+) ([]*types.PullReqTemplate, error) {
+	readParams := git.CreateReadParams(repo)
+	gitRef := "refs/heads/" + branch
+
+	templates := make([]*types.PullReqTemplate, 0)
+
+	template, err := c.readTemplate(ctx, readParams, gitRef, pullReqTemplateDefaultName, pullReqTemplatePath)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if template != nil {
+		templates = append(templates, template)
+	}
+
+	nodes, err := c.git.ListTreeNodes(ctx, &git.ListTreeNodeParams{
+		ReadParams: readParams,
+		GitREF:     gitRef,
+		Path:       pullReqTemplateDir,
+	})
+	if errors.IsNotFound(err) {
+		return templates, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull request templates: %w", err)
+	}
+
+	for _, node := range nodes.Nodes {
+		if node.Type != git.TreeNodeTypeBlob || !strings.EqualFold(path.Ext(node.Name), pullReqTemplateExt) {
+			continue
+		}
+
+		name := strings.TrimSuffix(node.Name, path.Ext(node.Name))
+
+		template, err = c.readTemplate(ctx, readParams, gitRef, name, node.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		templates = append(templates, template)
+	}
+
+	return templates, nil
+}
+
+func (c *Controller) readTemplate(
+	ctx context.Context,
+	readParams git.ReadParams,
+	gitRef string,
+	name string,
+	filePath string,
+) (*types.PullReqTemplate, error) {
+	node, err := c.git.GetTreeNode(ctx, &git.GetTreeNodeParams{
+		ReadParams: readParams,
+		GitREF:     gitRef,
+		Path:       filePath,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request template %q: %w", filePath, err)
+	}
+
+	output, err := c.git.GetBlob(ctx, &git.GetBlobParams{
+		ReadParams: readParams,
+		SHA:        node.Node.SHA,
+		SizeLimit:  maxPullReqTemplateSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request template %q content: %w", filePath, err)
+	}
+
+	defer func() {
+		if err := output.Content.Close(); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msgf("failed to close blob content reader.")
+		}
+	}()
+
+	content, err := io.ReadAll(output.Content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pull request template %q content: %w", filePath, err)
+	}
+
+	return &types.PullReqTemplate{
+		Name:    name,
+		Path:    filePath,
+		Content: string(content),
+	}, nil
+}
@@ -22,9 +22,11 @@ import (
 	"github.com/harness/gitness/app/services/instrument"
 	"github.com/harness/gitness/app/services/label"
 	"github.com/harness/gitness/app/services/locker"
+	"github.com/harness/gitness/app/services/mergequeue"
 	"github.com/harness/gitness/app/services/migrate"
 	"github.com/harness/gitness/app/services/protection"
 	"github.com/harness/gitness/app/services/pullreq"
+	"github.com/harness/gitness/app/services/settings"
 	"github.com/harness/gitness/app/services/usergroup"
 	"github.com/harness/gitness/app/sse"
 	"github.com/harness/gitness/app/store"
@@ -55,8 +57,13 @@ func ProvideController(
 	userGroupReviewerStore store.UserGroupReviewersStore,
 	principalInfoCache store.PrincipalInfoCache,
 	fileViewStore store.PullReqFileViewStore,
+	reactionStore store.PullReqReactionStore,
 	membershipStore store.MembershipStore,
 	checkStore store.CheckStore,
+	editStore store.PullReqEditStore,
+	commentRevisionStore store.PullReqCommentRevisionStore,
+	mentionStore store.PullReqMentionStore,
+	dependencyStore store.PullReqDependencyStore,
 	rpcClient git.Interface, eventReporter *pullreqevents.Reporter, codeCommentMigrator *codecomments.Migrator,
 	pullreqService *pullreq.Service, pullreqListService *pullreq.ListService,
 	ruleManager *protection.Manager, sseStreamer sse.Streamer,
@@ -64,6 +71,8 @@ func ProvideController(
 	labelSvc *label.Service,
 	instrumentation instrument.Service,
 	userGroupService usergroup.SearchService,
+	settings *settings.Service,
+	mergeQueue *mergequeue.Service,
 ) *Controller {
 	return NewController(tx,
 		urlProvider,
@@ -80,8 +89,13 @@ func ProvideController(
 		userGroupReviewerStore,
 		principalInfoCache,
 		fileViewStore,
+		reactionStore,
 		membershipStore,
 		checkStore,
+		editStore,
+		commentRevisionStore,
+		mentionStore,
+		dependencyStore,
 		rpcClient,
 		eventReporter,
 		codeCommentMigrator,
@@ -95,5 +109,7 @@ func ProvideController(
 		labelSvc,
 		instrumentation,
 		userGroupService,
+		settings,
+		mergeQueue,
 	)
 }
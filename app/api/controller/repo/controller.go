@@ -35,6 +35,7 @@ import (
 	"github.com/harness/gitness/app/services/locker"
 	"github.com/harness/gitness/app/services/protection"
 	"github.com/harness/gitness/app/services/publicaccess"
+	"github.com/harness/gitness/app/services/reviewanalytics"
 	"github.com/harness/gitness/app/services/settings"
 	"github.com/harness/gitness/app/services/usergroup"
 	"github.com/harness/gitness/app/store"
@@ -99,6 +100,7 @@ type Controller struct {
 	publicAccess       publicaccess.Service
 	labelSvc           *label.Service
 	instrumentation    instrument.Service
+	reviewAnalytics    *reviewanalytics.Service
 }
 
 func NewController(
@@ -130,6 +132,7 @@ func NewController(
 	instrumentation instrument.Service,
 	userGroupStore store.UserGroupStore,
 	userGroupService usergroup.SearchService,
+	reviewAnalytics *reviewanalytics.Service,
 ) *Controller {
 	return &Controller{
 		defaultBranch:      config.Git.DefaultBranch,
@@ -160,6 +163,7 @@ func NewController(
 		instrumentation:    instrumentation,
 		userGroupStore:     userGroupStore,
 		userGroupService:   userGroupService,
+		reviewAnalytics:    reviewAnalytics,
 	}
 }
 
@@ -34,6 +34,7 @@ func (c *Controller) RawDiff(
 	session *auth.Session,
 	repoRef string,
 	path string,
+	opts gittypes.DiffOptions,
 	files ...gittypes.FileDiffRequest,
 ) error {
 	repo, err := c.getRepoCheckAccess(ctx, session, repoRef, enum.PermissionRepoView)
@@ -47,10 +48,12 @@ func (c *Controller) RawDiff(
 	}
 
 	return c.git.RawDiff(ctx, w, &git.DiffParams{
-		ReadParams: git.CreateReadParams(repo),
-		BaseRef:    info.BaseRef,
-		HeadRef:    info.HeadRef,
-		MergeBase:  info.MergeBase,
+		ReadParams:       git.CreateReadParams(repo),
+		BaseRef:          info.BaseRef,
+		HeadRef:          info.HeadRef,
+		MergeBase:        info.MergeBase,
+		IgnoreWhitespace: opts.IgnoreWhitespace,
+		ContextLines:     opts.ContextLines,
 	}, files...)
 }
 
@@ -132,6 +135,7 @@ func (c *Controller) Diff(
 	repoRef string,
 	path string,
 	includePatch bool,
+	opts gittypes.DiffOptions,
 	files ...gittypes.FileDiffRequest,
 ) (types.Stream[*git.FileDiff], error) {
 	repo, err := c.repoStore.FindByRef(ctx, repoRef)
@@ -149,11 +153,13 @@ func (c *Controller) Diff(
 	}
 
 	reader := git.NewStreamReader(c.git.Diff(ctx, &git.DiffParams{
-		ReadParams:   git.CreateReadParams(repo),
-		BaseRef:      info.BaseRef,
-		HeadRef:      info.HeadRef,
-		MergeBase:    info.MergeBase,
-		IncludePatch: includePatch,
+		ReadParams:       git.CreateReadParams(repo),
+		BaseRef:          info.BaseRef,
+		HeadRef:          info.HeadRef,
+		MergeBase:        info.MergeBase,
+		IncludePatch:     includePatch,
+		IgnoreWhitespace: opts.IgnoreWhitespace,
+		ContextLines:     opts.ContextLines,
 	}, files...))
 
 	return reader, nil
@@ -23,22 +23,27 @@ import (
 // GeneralSettings represent the general repository settings as exposed externally.
 type GeneralSettings struct {
 	FileSizeLimit *int64 `json:"file_size_limit" yaml:"file_size_limit"`
+	// MergeQueueBranches lists the branches for which pull requests are merged using the merge queue.
+	MergeQueueBranches *[]string `json:"merge_queue_branches" yaml:"merge_queue_branches"`
 }
 
 func GetDefaultGeneralSettings() *GeneralSettings {
+	mergeQueueBranches := settings.DefaultMergeQueueBranches
 	return &GeneralSettings{
-		FileSizeLimit: ptr.Int64(settings.DefaultFileSizeLimit),
+		FileSizeLimit:      ptr.Int64(settings.DefaultFileSizeLimit),
+		MergeQueueBranches: &mergeQueueBranches,
 	}
 }
 
 func GetGeneralSettingsMappings(s *GeneralSettings) []settings.SettingHandler {
 	return []settings.SettingHandler{
 		settings.Mapping(settings.KeyFileSizeLimit, s.FileSizeLimit),
+		settings.Mapping(settings.KeyMergeQueueBranches, s.MergeQueueBranches),
 	}
 }
 
 func GetGeneralSettingsAsKeyValues(s *GeneralSettings) []settings.KeyValue {
-	kvs := make([]settings.KeyValue, 0, 1)
+	kvs := make([]settings.KeyValue, 0, 2)
 
 	if s.FileSizeLimit != nil {
 		kvs = append(kvs, settings.KeyValue{
@@ -46,5 +51,11 @@ func GetGeneralSettingsAsKeyValues(s *GeneralSettings) []settings.KeyValue {
 			Value: s.FileSizeLimit,
 		})
 	}
+	if s.MergeQueueBranches != nil {
+		kvs = append(kvs, settings.KeyValue{
+			Key:   settings.KeyMergeQueueBranches,
+			Value: *s.MergeQueueBranches,
+		})
+	}
 	return kvs
 }
@@ -0,0 +1,95 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reposettings
+
+import (
+	"slices"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/services/settings"
+)
+
+// ReviewerSettings represents the default reviewer part of repository settings as exposed externally.
+// Default reviewers are requested on pull requests opened against protected branches.
+type ReviewerSettings struct {
+	DefaultReviewerIDs          *[]int64 `json:"default_reviewer_ids" yaml:"default_reviewer_ids"`
+	DefaultUserGroupReviewerIDs *[]int64 `json:"default_usergroup_reviewer_ids" yaml:"default_usergroup_reviewer_ids"`
+}
+
+func GetDefaultReviewerSettings() *ReviewerSettings {
+	defaultReviewerIDs := settings.DefaultDefaultReviewerIDs
+	defaultUserGroupReviewerIDs := settings.DefaultDefaultUserGroupReviewerIDs
+	return &ReviewerSettings{
+		DefaultReviewerIDs:          &defaultReviewerIDs,
+		DefaultUserGroupReviewerIDs: &defaultUserGroupReviewerIDs,
+	}
+}
+
+func GetReviewerSettingsMappings(s *ReviewerSettings) []settings.SettingHandler {
+	return []settings.SettingHandler{
+		settings.Mapping(settings.KeyDefaultReviewerIDs, s.DefaultReviewerIDs),
+		settings.Mapping(settings.KeyDefaultUserGroupReviewerIDs, s.DefaultUserGroupReviewerIDs),
+	}
+}
+
+func GetReviewerSettingsAsKeyValues(s *ReviewerSettings) []settings.KeyValue {
+	kvs := make([]settings.KeyValue, 0, 2)
+
+	if s.DefaultReviewerIDs != nil {
+		kvs = append(kvs, settings.KeyValue{
+			Key:   settings.KeyDefaultReviewerIDs,
+			Value: *s.DefaultReviewerIDs,
+		})
+	}
+	if s.DefaultUserGroupReviewerIDs != nil {
+		kvs = append(kvs, settings.KeyValue{
+			Key:   settings.KeyDefaultUserGroupReviewerIDs,
+			Value: *s.DefaultUserGroupReviewerIDs,
+		})
+	}
+	return kvs
+}
+
+func (s *ReviewerSettings) sanitize() error {
+	if s.DefaultReviewerIDs != nil {
+		ids, err := sanitizeIDs(*s.DefaultReviewerIDs, "reviewer")
+		if err != nil {
+			return err
+		}
+		s.DefaultReviewerIDs = &ids
+	}
+	if s.DefaultUserGroupReviewerIDs != nil {
+		ids, err := sanitizeIDs(*s.DefaultUserGroupReviewerIDs, "user group")
+		if err != nil {
+			return err
+		}
+		s.DefaultUserGroupReviewerIDs = &ids
+	}
+	return nil
+}
+
+// sanitizeIDs rejects invalid IDs and returns the sorted list of unique IDs.
+func sanitizeIDs(ids []int64, kind string) ([]int64, error) {
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, usererror.BadRequestf("Invalid %s ID: %d", kind, id)
+		}
+	}
+
+	ids = slices.Clone(ids)
+	slices.Sort(ids)
+
+	return slices.Compact(ids), nil
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/harness/gitness/app/services/label"
 	"github.com/harness/gitness/app/services/publicaccess"
 	"github.com/harness/gitness/app/services/pullreq"
+	"github.com/harness/gitness/app/services/reviewanalytics"
 	"github.com/harness/gitness/app/sse"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/app/url"
@@ -89,6 +90,7 @@ type Controller struct {
 	gitspaceSvc     *gitspace.Service
 	labelSvc        *label.Service
 	instrumentation instrument.Service
+	reviewAnalytics *reviewanalytics.Service
 }
 
 func NewController(config *types.Config, tx dbtx.Transactor, urlProvider url.Provider,
@@ -100,7 +102,7 @@ func NewController(config *types.Config, tx dbtx.Transactor, urlProvider url.Pro
 	importer *importer.Repository, exporter *exporter.Repository,
 	limiter limiter.ResourceLimiter, publicAccess publicaccess.Service, auditService audit.Service,
 	gitspaceSvc *gitspace.Service, labelSvc *label.Service,
-	instrumentation instrument.Service,
+	instrumentation instrument.Service, reviewAnalytics *reviewanalytics.Service,
 ) *Controller {
 	return &Controller{
 		nestedSpacesEnabled: config.NestedSpacesEnabled,
@@ -128,5 +130,6 @@ func NewController(config *types.Config, tx dbtx.Transactor, urlProvider url.Pro
 		gitspaceSvc:         gitspaceSvc,
 		labelSvc:            labelSvc,
 		instrumentation:     instrumentation,
+		reviewAnalytics:     reviewAnalytics,
 	}
 }
@@ -0,0 +1,97 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package space
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	searchQualifierAuthor = "author"
+	searchQualifierLabel  = "label"
+	searchQualifierState  = "state"
+)
+
+// SearchPullReqs searches pull requests of the provided space.
+// The search query consists of the text, matched against pull request titles, descriptions and comments,
+// and of optional qualifiers: "author:<principal uid>", "label:<label key>" and "state:<pull request state>".
+// The results aren't ranked by relevance, they are ordered by the filter's sort attribute like any other list.
+func (c *Controller) SearchPullReqs(
+	ctx context.Context,
+	session *auth.Session,
+	spaceRef string,
+	includeSubspaces bool,
+	filter *types.PullReqFilter,
+) ([]types.PullReqRepo, error) {
+	if err := c.applySearchQuery(ctx, filter); err != nil {
+		return nil, err
+	}
+
+	return c.ListPullReqs(ctx, session, spaceRef, includeSubspaces, filter)
+}
+
+// applySearchQuery parses the filter's query and replaces it with the search filter options.
+func (c *Controller) applySearchQuery(ctx context.Context, filter *types.PullReqFilter) error {
+	var words []string
+
+	for _, token := range strings.Fields(filter.Query) {
+		qualifier, value, ok := strings.Cut(token, ":")
+		if !ok || value == "" {
+			words = append(words, token)
+			continue
+		}
+
+		switch strings.ToLower(qualifier) {
+		case searchQualifierAuthor:
+			author, err := c.principalStore.FindByUID(ctx, value)
+			if errors.Is(err, gitness_store.ErrResourceNotFound) {
+				return usererror.BadRequestf("Unknown author %q.", value)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to find author: %w", err)
+			}
+
+			filter.CreatedBy = append(filter.CreatedBy, author.ID)
+
+		case searchQualifierLabel:
+			filter.LabelKeys = append(filter.LabelKeys, value)
+
+		case searchQualifierState:
+			state, ok := enum.PullReqState(strings.ToLower(value)).Sanitize()
+			if !ok {
+				return usererror.BadRequestf("Unknown pull request state %q.", value)
+			}
+
+			filter.States = append(filter.States, state)
+
+		default:
+			words = append(words, token)
+		}
+	}
+
+	filter.Query = ""
+	filter.SearchText = strings.Join(words, " ")
+
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package space
+
+import (
+	"context"
+	"fmt"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// ReviewAnalytics returns the pull request review metrics aggregated over the repositories of the space.
+func (c *Controller) ReviewAnalytics(
+	ctx context.Context,
+	session *auth.Session,
+	spaceRef string,
+	includeSubspaces bool,
+	filter *types.ReviewAnalyticsFilter,
+) (*types.ReviewAnalytics, error) {
+	space, err := c.spaceStore.FindByRef(ctx, spaceRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find space: %w", err)
+	}
+
+	if err = apiauth.CheckSpaceScope(
+		ctx,
+		c.authorizer,
+		session,
+		space,
+		enum.ResourceTypeRepo,
+		enum.PermissionRepoView,
+	); err != nil {
+		return nil, err
+	}
+
+	spaceIDs := []int64{space.ID}
+	if includeSubspaces {
+		subspaces, err := c.spaceStore.GetDescendantsData(ctx, space.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get space descendant data: %w", err)
+		}
+
+		spaceIDs = make([]int64, len(subspaces))
+		for i := range subspaces {
+			spaceIDs[i] = subspaces[i].ID
+		}
+	}
+
+	analytics, err := c.reviewAnalytics.GetForSpaces(ctx, spaceIDs, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review analytics: %w", err)
+	}
+
+	return analytics, nil
+}
@@ -35,32 +35,40 @@ import (
 )
 
 const (
-	MaxFileSize       = 10 << 20 // 10 MB file limit set in Handler
 	fileBucketPathFmt = "uploads/%d/%s"
 	peekBytes         = 512
 )
 
-var supportedFileTypes = map[string]struct{}{
-	"image": {},
-	"video": {},
-}
-
 type Controller struct {
-	authorizer authz.Authorizer
-	repoStore  store.RepoStore
-	blobStore  blob.Store
+	authorizer   authz.Authorizer
+	repoStore    store.RepoStore
+	blobStore    blob.Store
+	scanner      Scanner
+	maxFileSize  int64
+	allowedTypes []string
 }
 
 func NewController(authorizer authz.Authorizer,
 	repoStore store.RepoStore,
 	blobStore blob.Store,
+	scanner Scanner,
+	maxFileSize int64,
+	allowedTypes []string,
 ) *Controller {
 	return &Controller{
-		authorizer: authorizer,
-		repoStore:  repoStore,
-		blobStore:  blobStore,
+		authorizer:   authorizer,
+		repoStore:    repoStore,
+		blobStore:    blobStore,
+		scanner:      scanner,
+		maxFileSize:  maxFileSize,
+		allowedTypes: allowedTypes,
 	}
 }
+
+// MaxFileSize returns the maximum size of an uploaded file in bytes.
+func (c *Controller) MaxFileSize() int64 {
+	return c.maxFileSize
+}
 func (c *Controller) getRepoCheckAccess(ctx context.Context,
 	session *auth.Session,
 	repoRef string,
@@ -88,20 +96,36 @@ func (c *Controller) getFileExtension(file *bufio.Reader) (string, error) {
 		return "", fmt.Errorf("failed to read file: %w", err)
 	}
 
-	// Example: mType.String() = image/png
-	// Splitting on "/" and taking the first element of the slice
-	// will give us the file type.
 	mType := mimetype.Detect(buf)
-	if _, ok := supportedFileTypes[strings.Split(mType.String(), "/")[0]]; !ok {
+	if !c.isAllowedType(mType) {
 		return "",
 			usererror.BadRequestf(
-				"only image and video files are supported, uploaded file is of type %s",
-				mType.String())
+				"files of type %s are not supported, supported types are: %s",
+				mType.String(), strings.Join(c.allowedTypes, ", "))
 	}
 
 	return mType.Extension(), nil
 }
 
+// isAllowedType checks the MIME type against the allowed types.
+// An allowed type can be a wildcard for all subtypes, like "image/*".
+func (c *Controller) isAllowedType(mType *mimetype.MIME) bool {
+	for _, allowed := range c.allowedTypes {
+		if prefix, ok := strings.CutSuffix(allowed, "/*"); ok {
+			if strings.HasPrefix(mType.String(), prefix+"/") {
+				return true
+			}
+			continue
+		}
+
+		if mType.Is(allowed) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func getFileBucketPath(repoID int64, fileName string) string {
 	return fmt.Sprintf(fileBucketPathFmt, repoID, fileName)
 }
@@ -0,0 +1,62 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+)
+
+// ErrFileRejected is returned by a Scanner if the file must not be stored.
+var ErrFileRejected = errors.New("file rejected by scanner")
+
+// Scanner scans uploaded files for malicious content, e.g. viruses, before they are stored.
+type Scanner interface {
+	Scan(ctx context.Context, file io.Reader) error
+}
+
+// CommandScanner scans files by piping them to an external command, like clamdscan.
+// The command is expected to follow the convention of exiting with code 1 if the file is infected.
+type CommandScanner struct {
+	command []string
+}
+
+func NewCommandScanner(command string) *CommandScanner {
+	return &CommandScanner{
+		command: strings.Fields(command),
+	}
+}
+
+func (s *CommandScanner) Scan(ctx context.Context, file io.Reader) error {
+	//nolint:gosec // the command is provided by the system administrator via config
+	cmd := exec.CommandContext(ctx, s.command[0], s.command[1:]...)
+	cmd.Stdin = file
+
+	output, err := cmd.CombinedOutput()
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+		return ErrFileRejected
+	}
+	if err != nil {
+		return fmt.Errorf("failed to run scan command: %w; output: %s", err, output)
+	}
+
+	return nil
+}
@@ -16,7 +16,9 @@ package upload
 
 import (
 	"bufio"
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 
@@ -51,17 +53,25 @@ func (c *Controller) Upload(ctx context.Context,
 		return nil, usererror.BadRequest("no file provided")
 	}
 	bufReader := bufio.NewReader(file)
-	// Check if the file is an image or video
+	// Check if the file is of an allowed type
 	extn, err := c.getFileExtension(bufReader)
 	if err != nil {
 		return nil, fmt.Errorf("failed to determine file type: %w", err)
 	}
 
+	var content io.Reader = bufReader
+	if c.scanner != nil {
+		content, err = c.scan(ctx, bufReader)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	identifier := uuid.New().String()
 	fileName := fmt.Sprintf(fileNameFmt, identifier, extn)
 
 	fileBucketPath := getFileBucketPath(repo.ID, fileName)
-	err = c.blobStore.Upload(ctx, bufReader, fileBucketPath)
+	err = c.blobStore.Upload(ctx, content, fileBucketPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to upload file: %w", err)
 	}
@@ -69,3 +79,22 @@ func (c *Controller) Upload(ctx context.Context,
 		FilePath: fileName,
 	}, nil
 }
+
+// scan reads the whole file (its size is limited by the handler) and passes it to the scanner.
+// It returns a reader of the file content if the scanner accepted the file.
+func (c *Controller) scan(ctx context.Context, file io.Reader) (io.Reader, error) {
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	err = c.scanner.Scan(ctx, bytes.NewReader(data))
+	if errors.Is(err, ErrFileRejected) {
+		return nil, usererror.BadRequest("The file has been rejected by the malware scanner.")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan file: %w", err)
+	}
+
+	return bytes.NewReader(data), nil
+}
@@ -15,9 +15,12 @@
 package upload
 
 import (
+	"strings"
+
 	"github.com/harness/gitness/app/auth/authz"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/blob"
+	"github.com/harness/gitness/types"
 
 	"github.com/google/wire"
 )
@@ -25,12 +28,25 @@ import (
 // WireSet provides a wire set for this package.
 var WireSet = wire.NewSet(
 	ProvideController,
+	ProvideScanner,
 )
 
 func ProvideController(
+	config *types.Config,
 	authorizer authz.Authorizer,
 	repoStore store.RepoStore,
 	blobStore blob.Store,
+	scanner Scanner,
 ) *Controller {
-	return NewController(authorizer, repoStore, blobStore)
+	return NewController(authorizer, repoStore, blobStore, scanner,
+		config.Uploads.MaxFileSize, config.Uploads.AllowedTypes)
+}
+
+// ProvideScanner provides the scanner for uploaded files, or nil if file scanning is not configured.
+func ProvideScanner(config *types.Config) Scanner {
+	if strings.TrimSpace(config.Uploads.ScanCommand) == "" {
+		return nil
+	}
+
+	return NewCommandScanner(config.Uploads.ScanCommand)
 }
@@ -28,13 +28,15 @@ import (
 )
 
 type Controller struct {
-	tx                dbtx.Transactor
-	principalUIDCheck check.PrincipalUID
-	authorizer        authz.Authorizer
-	principalStore    store.PrincipalStore
-	tokenStore        store.TokenStore
-	membershipStore   store.MembershipStore
-	publicKeyStore    store.PublicKeyStore
+	tx                        dbtx.Transactor
+	principalUIDCheck         check.PrincipalUID
+	authorizer                authz.Authorizer
+	principalStore            store.PrincipalStore
+	tokenStore                store.TokenStore
+	membershipStore           store.MembershipStore
+	publicKeyStore            store.PublicKeyStore
+	notificationSettingsStore store.NotificationSettingsStore
+	savedReplyStore           store.SavedReplyStore
 }
 
 func NewController(
@@ -45,15 +47,19 @@ func NewController(
 	tokenStore store.TokenStore,
 	membershipStore store.MembershipStore,
 	publicKeyStore store.PublicKeyStore,
+	notificationSettingsStore store.NotificationSettingsStore,
+	savedReplyStore store.SavedReplyStore,
 ) *Controller {
 	return &Controller{
-		tx:                tx,
-		principalUIDCheck: principalUIDCheck,
-		authorizer:        authorizer,
-		principalStore:    principalStore,
-		tokenStore:        tokenStore,
-		membershipStore:   membershipStore,
-		publicKeyStore:    publicKeyStore,
+		tx:                        tx,
+		principalUIDCheck:         principalUIDCheck,
+		authorizer:                authorizer,
+		principalStore:            principalStore,
+		tokenStore:                tokenStore,
+		membershipStore:           membershipStore,
+		publicKeyStore:            publicKeyStore,
+		notificationSettingsStore: notificationSettingsStore,
+		savedReplyStore:           savedReplyStore,
 	}
 }
 
@@ -0,0 +1,133 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/auth"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// FindNotificationSettings returns the notification settings of the user.
+func (c *Controller) FindNotificationSettings(
+	ctx context.Context,
+	session *auth.Session,
+	userUID string,
+) (*types.NotificationSettings, error) {
+	user, err := findUserFromUID(ctx, c.principalStore, userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = apiauth.CheckUser(ctx, c.authorizer, session, user, enum.PermissionUserView); err != nil {
+		return nil, err
+	}
+
+	return c.findNotificationSettings(ctx, user.ID)
+}
+
+// UpdateNotificationSettings updates the notification settings of the user.
+func (c *Controller) UpdateNotificationSettings(
+	ctx context.Context,
+	session *auth.Session,
+	userUID string,
+	in *types.NotificationSettingsUpdate,
+) (*types.NotificationSettings, error) {
+	user, err := findUserFromUID(ctx, c.principalStore, userUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = apiauth.CheckUser(ctx, c.authorizer, session, user, enum.PermissionUserEdit); err != nil {
+		return nil, err
+	}
+
+	settings, err := c.findNotificationSettings(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.ReviewerRemindersDisabled != nil {
+		settings.ReviewerRemindersDisabled = *in.ReviewerRemindersDisabled
+	}
+	if in.ClearQuietHours {
+		settings.QuietHoursStart = nil
+		settings.QuietHoursEnd = nil
+	}
+	if in.QuietHoursStart != nil {
+		settings.QuietHoursStart = in.QuietHoursStart
+	}
+	if in.QuietHoursEnd != nil {
+		settings.QuietHoursEnd = in.QuietHoursEnd
+	}
+	if in.Timezone != nil {
+		settings.Timezone = *in.Timezone
+	}
+
+	if err = sanitizeNotificationSettings(settings); err != nil {
+		return nil, err
+	}
+
+	settings.Updated = time.Now().UnixMilli()
+
+	if err = c.notificationSettingsStore.Upsert(ctx, settings); err != nil {
+		return nil, fmt.Errorf("failed to update notification settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+// findNotificationSettings returns the notification settings of the principal,
+// or the default settings if the principal never changed them.
+func (c *Controller) findNotificationSettings(
+	ctx context.Context,
+	principalID int64,
+) (*types.NotificationSettings, error) {
+	settings, err := c.notificationSettingsStore.Find(ctx, principalID)
+	if errors.Is(err, gitness_store.ErrResourceNotFound) {
+		return &types.NotificationSettings{PrincipalID: principalID}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find notification settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+func sanitizeNotificationSettings(settings *types.NotificationSettings) error {
+	if (settings.QuietHoursStart == nil) != (settings.QuietHoursEnd == nil) {
+		return usererror.BadRequest("Both the start and the end of quiet hours must be provided.")
+	}
+
+	for _, hour := range []*int{settings.QuietHoursStart, settings.QuietHoursEnd} {
+		if hour != nil && (*hour < 0 || *hour > 23) {
+			return usererror.BadRequest("Quiet hours must be between 0 and 23.")
+		}
+	}
+
+	if _, err := time.LoadLocation(settings.Timezone); err != nil {
+		return usererror.BadRequestf("Invalid time zone %q.", settings.Timezone)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/errors"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/check"
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	savedReplyTitleMaxLength   = 256
+	savedReplyContentMaxLength = 65536
+)
+
+type CreateSavedReplyInput struct {
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+	Content    string `json:"content"`
+}
+
+func (c *Controller) CreateSavedReply(
+	ctx context.Context,
+	session *auth.Session,
+	userUID string,
+	in *CreateSavedReplyInput,
+) (*types.SavedReply, error) {
+	user, err := c.principalStore.FindUserByUID(ctx, userUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user by uid: %w", err)
+	}
+
+	if err = apiauth.CheckUser(ctx, c.authorizer, session, user, enum.PermissionUserEdit); err != nil {
+		return nil, err
+	}
+
+	if err := sanitizeSavedReply(&in.Identifier, &in.Title, &in.Content); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UnixMilli()
+
+	reply := &types.SavedReply{
+		PrincipalID: user.ID,
+		Identifier:  in.Identifier,
+		Title:       in.Title,
+		Content:     in.Content,
+		Created:     now,
+		Updated:     now,
+	}
+
+	err = c.savedReplyStore.Create(ctx, reply)
+	if errors.Is(err, gitness_store.ErrDuplicate) {
+		return nil, errors.Conflict("A saved reply with the identifier %q already exists", in.Identifier)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert saved reply: %w", err)
+	}
+
+	return reply, nil
+}
+
+func sanitizeSavedReply(identifier, title, content *string) error {
+	if err := check.Identifier(*identifier); err != nil {
+		return err
+	}
+
+	*title = strings.TrimSpace(*title)
+	if *title == "" {
+		return errors.InvalidArgument("saved reply title not provided")
+	}
+	if len(*title) > savedReplyTitleMaxLength {
+		return errors.InvalidArgument("saved reply title can have at most %d characters", savedReplyTitleMaxLength)
+	}
+
+	if strings.TrimSpace(*content) == "" {
+		return errors.InvalidArgument("saved reply content not provided")
+	}
+	if len(*content) > savedReplyContentMaxLength {
+		return errors.InvalidArgument("saved reply content can have at most %d bytes", savedReplyContentMaxLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,369 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/auth/authz"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/errors"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+)
+
+// userStore finds users by UID, all other methods aren't implemented.
+type userStore struct {
+	store.PrincipalStore
+	users map[string]*types.User
+}
+
+func (s userStore) FindUserByUID(_ context.Context, uid string) (*types.User, error) {
+	user, ok := s.users[uid]
+	if !ok {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+	return user, nil
+}
+
+// memorySavedReplyStore keeps the saved replies in memory,
+// identifiers are unique per principal and compared case-insensitively like in the database.
+type memorySavedReplyStore struct {
+	replies []types.SavedReply
+}
+
+func (s *memorySavedReplyStore) index(principalID int64, identifier string) int {
+	for i := range s.replies {
+		if s.replies[i].PrincipalID == principalID && strings.EqualFold(s.replies[i].Identifier, identifier) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *memorySavedReplyStore) FindByIdentifier(
+	_ context.Context,
+	principalID int64,
+	identifier string,
+) (*types.SavedReply, error) {
+	i := s.index(principalID, identifier)
+	if i < 0 {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+	reply := s.replies[i]
+	return &reply, nil
+}
+
+func (s *memorySavedReplyStore) Create(_ context.Context, reply *types.SavedReply) error {
+	if s.index(reply.PrincipalID, reply.Identifier) >= 0 {
+		return gitness_store.ErrDuplicate
+	}
+	reply.ID = int64(len(s.replies) + 1)
+	s.replies = append(s.replies, *reply)
+	return nil
+}
+
+func (s *memorySavedReplyStore) Update(_ context.Context, reply *types.SavedReply) error {
+	if i := s.index(reply.PrincipalID, reply.Identifier); i >= 0 && s.replies[i].ID != reply.ID {
+		return gitness_store.ErrDuplicate
+	}
+	for i := range s.replies {
+		if s.replies[i].ID == reply.ID && s.replies[i].PrincipalID == reply.PrincipalID {
+			s.replies[i] = *reply
+			return nil
+		}
+	}
+	return errors.NotFound("Saved reply not found")
+}
+
+func (s *memorySavedReplyStore) DeleteByIdentifier(_ context.Context, principalID int64, identifier string) error {
+	i := s.index(principalID, identifier)
+	if i < 0 {
+		return errors.NotFound("Saved reply not found")
+	}
+	s.replies = append(s.replies[:i], s.replies[i+1:]...)
+	return nil
+}
+
+func (s *memorySavedReplyStore) Count(
+	ctx context.Context,
+	principalID int64,
+	filter *types.ListQueryFilter,
+) (int, error) {
+	list, err := s.List(ctx, principalID, &types.ListQueryFilter{Query: filter.Query})
+	return len(list), err
+}
+
+func (s *memorySavedReplyStore) List(
+	_ context.Context,
+	principalID int64,
+	filter *types.ListQueryFilter,
+) ([]types.SavedReply, error) {
+	list := []types.SavedReply{}
+	for _, reply := range s.replies {
+		if reply.PrincipalID == principalID && strings.Contains(reply.Identifier, filter.Query) {
+			list = append(list, reply)
+		}
+	}
+	return list, nil
+}
+
+// passthroughTx runs the functions without a transaction.
+type passthroughTx struct{}
+
+func (passthroughTx) WithTx(ctx context.Context, fn func(ctx context.Context) error, _ ...interface{}) error {
+	return fn(ctx)
+}
+
+func newSavedReplyTestController(users ...*types.User) (*Controller, *memorySavedReplyStore) {
+	principalStore := userStore{users: map[string]*types.User{}}
+	for _, user := range users {
+		principalStore.users[user.UID] = user
+	}
+
+	replyStore := &memorySavedReplyStore{}
+
+	return &Controller{
+		tx:              passthroughTx{},
+		authorizer:      authz.NewMembershipAuthorizer(nil, nil, nil),
+		principalStore:  principalStore,
+		savedReplyStore: replyStore,
+	}, replyStore
+}
+
+func sessionOf(user *types.User) *auth.Session {
+	return &auth.Session{Principal: *user.ToPrincipal()}
+}
+
+func ptr[T any](v T) *T { return &v }
+
+// nolint:gocognit // it's a unit test
+func TestController_SavedReplies(t *testing.T) {
+	ctx := context.Background()
+
+	owner := &types.User{ID: 1, UID: "owner"}
+	c, replyStore := newSavedReplyTestController(owner)
+	session := sessionOf(owner)
+
+	reply, err := c.CreateSavedReply(ctx, session, owner.UID, &CreateSavedReplyInput{
+		Identifier: "lgtm",
+		Title:      "  Looks good  ",
+		Content:    "LGTM!",
+	})
+	if err != nil {
+		t.Fatalf("failed to create saved reply: %v", err)
+	}
+	if reply.PrincipalID != owner.ID || reply.Title != "Looks good" || reply.Created == 0 {
+		t.Errorf("created saved reply %+v", reply)
+	}
+
+	_, err = c.CreateSavedReply(ctx, session, owner.UID, &CreateSavedReplyInput{
+		Identifier: "LGTM",
+		Title:      "Duplicate",
+		Content:    "Duplicate",
+	})
+	if !errors.IsConflict(err) {
+		t.Errorf("create with a duplicate identifier: err = %v, want conflict", err)
+	}
+
+	_, err = c.CreateSavedReply(ctx, session, owner.UID, &CreateSavedReplyInput{
+		Identifier: "nit",
+		Title:      "Nitpick",
+		Content:    "Nit: ",
+	})
+	if err != nil {
+		t.Fatalf("failed to create saved reply: %v", err)
+	}
+
+	_, err = c.UpdateSavedReply(ctx, session, owner.UID, "nit", &UpdateSavedReplyInput{Identifier: ptr("lgtm")})
+	if !errors.IsConflict(err) {
+		t.Errorf("update to a duplicate identifier: err = %v, want conflict", err)
+	}
+
+	updated, err := c.UpdateSavedReply(ctx, session, owner.UID, "NIT", &UpdateSavedReplyInput{
+		Content: ptr("Nit (non-blocking): "),
+	})
+	if err != nil {
+		t.Fatalf("failed to update saved reply: %v", err)
+	}
+	if updated.Identifier != "nit" || updated.Title != "Nitpick" || updated.Content != "Nit (non-blocking): " {
+		t.Errorf("updated saved reply %+v", updated)
+	}
+
+	found, err := c.FindSavedReply(ctx, session, owner.UID, "nit")
+	if err != nil {
+		t.Fatalf("failed to find saved reply: %v", err)
+	}
+	if found.Content != "Nit (non-blocking): " {
+		t.Errorf("found saved reply content %q", found.Content)
+	}
+
+	list, count, err := c.ListSavedReplies(ctx, session, owner.UID,
+		&types.ListQueryFilter{Pagination: types.Pagination{Page: 1, Size: 10}})
+	if err != nil {
+		t.Fatalf("failed to list saved replies: %v", err)
+	}
+	if len(list) != 2 || count != 2 {
+		t.Errorf("listed %d saved replies with count %d, want 2", len(list), count)
+	}
+
+	if err = c.DeleteSavedReply(ctx, session, owner.UID, "lgtm"); err != nil {
+		t.Fatalf("failed to delete saved reply: %v", err)
+	}
+	if len(replyStore.replies) != 1 || replyStore.replies[0].Identifier != "nit" {
+		t.Errorf("saved replies after delete: %+v", replyStore.replies)
+	}
+
+	err = c.DeleteSavedReply(ctx, session, owner.UID, "lgtm")
+	if !errors.IsNotFound(err) {
+		t.Errorf("delete of a missing saved reply: err = %v, want not found", err)
+	}
+}
+
+// nolint:gocognit // it's a unit test
+func TestController_SavedReplies_Ownership(t *testing.T) {
+	ctx := context.Background()
+
+	owner := &types.User{ID: 1, UID: "owner"}
+	other := &types.User{ID: 2, UID: "other"}
+	admin := &types.User{ID: 3, UID: "admin", Admin: true}
+
+	c, replyStore := newSavedReplyTestController(owner, other, admin)
+
+	if _, err := c.CreateSavedReply(ctx, sessionOf(owner), owner.UID, &CreateSavedReplyInput{
+		Identifier: "lgtm",
+		Title:      "Looks good",
+		Content:    "LGTM!",
+	}); err != nil {
+		t.Fatalf("failed to create saved reply: %v", err)
+	}
+
+	operations := []struct {
+		name string
+		call func(session *auth.Session) error
+	}{
+		{
+			name: "create",
+			call: func(session *auth.Session) error {
+				_, err := c.CreateSavedReply(ctx, session, owner.UID, &CreateSavedReplyInput{
+					Identifier: "by_" + session.Principal.UID,
+					Title:      "Title",
+					Content:    "Content",
+				})
+				return err
+			},
+		},
+		{
+			name: "find",
+			call: func(session *auth.Session) error {
+				_, err := c.FindSavedReply(ctx, session, owner.UID, "lgtm")
+				return err
+			},
+		},
+		{
+			name: "list",
+			call: func(session *auth.Session) error {
+				_, _, err := c.ListSavedReplies(ctx, session, owner.UID,
+					&types.ListQueryFilter{Pagination: types.Pagination{Page: 1, Size: 10}})
+				return err
+			},
+		},
+		{
+			name: "update",
+			call: func(session *auth.Session) error {
+				_, err := c.UpdateSavedReply(ctx, session, owner.UID, "lgtm",
+					&UpdateSavedReplyInput{Content: ptr("Updated by " + session.Principal.UID)})
+				return err
+			},
+		},
+		{
+			name: "delete",
+			call: func(session *auth.Session) error {
+				// recreate the deleted reply so the following runs have something to work on.
+				defer func() {
+					if replyStore.index(owner.ID, "lgtm") < 0 {
+						_ = replyStore.Create(ctx, &types.SavedReply{PrincipalID: owner.ID, Identifier: "lgtm"})
+					}
+				}()
+				return c.DeleteSavedReply(ctx, session, owner.UID, "lgtm")
+			},
+		},
+	}
+
+	for _, op := range operations {
+		t.Run(op.name, func(t *testing.T) {
+			if err := op.call(sessionOf(other)); !errors.Is(err, apiauth.ErrNotAuthorized) {
+				t.Errorf("other user: err = %v, want %v", err, apiauth.ErrNotAuthorized)
+			}
+			if err := op.call(sessionOf(admin)); err != nil {
+				t.Errorf("admin: unexpected error: %v", err)
+			}
+			if err := op.call(sessionOf(owner)); err != nil {
+				t.Errorf("owner: unexpected error: %v", err)
+			}
+		})
+	}
+
+	for _, reply := range replyStore.replies {
+		if reply.PrincipalID != owner.ID {
+			t.Errorf("saved reply %q created for principal %d", reply.Identifier, reply.PrincipalID)
+		}
+	}
+}
+
+func TestSanitizeSavedReply(t *testing.T) {
+	tests := []struct {
+		name       string
+		identifier string
+		title      string
+		content    string
+		wantErr    bool
+	}{
+		{name: "valid", identifier: "lgtm", title: "Looks good", content: "LGTM!"},
+		{name: "invalid identifier", identifier: "lg tm", title: "Looks good", content: "LGTM!", wantErr: true},
+		{name: "blank title", identifier: "lgtm", title: "  ", content: "LGTM!", wantErr: true},
+		{
+			name:       "title too long",
+			identifier: "lgtm",
+			title:      strings.Repeat("a", savedReplyTitleMaxLength+1),
+			content:    "LGTM!",
+			wantErr:    true,
+		},
+		{name: "blank content", identifier: "lgtm", title: "Looks good", content: "\n\t", wantErr: true},
+		{
+			name:       "content too long",
+			identifier: "lgtm",
+			title:      "Looks good",
+			content:    strings.Repeat("a", savedReplyContentMaxLength+1),
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := sanitizeSavedReply(&tt.identifier, &tt.title, &tt.content)
+			if tt.wantErr && err == nil {
+				t.Error("expected an error")
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
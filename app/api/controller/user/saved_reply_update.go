@@ -0,0 +1,82 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/errors"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+type UpdateSavedReplyInput struct {
+	Identifier *string `json:"identifier"`
+	Title      *string `json:"title"`
+	Content    *string `json:"content"`
+}
+
+func (c *Controller) UpdateSavedReply(
+	ctx context.Context,
+	session *auth.Session,
+	userUID string,
+	identifier string,
+	in *UpdateSavedReplyInput,
+) (*types.SavedReply, error) {
+	user, err := c.principalStore.FindUserByUID(ctx, userUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user by uid: %w", err)
+	}
+
+	if err = apiauth.CheckUser(ctx, c.authorizer, session, user, enum.PermissionUserEdit); err != nil {
+		return nil, err
+	}
+
+	reply, err := c.savedReplyStore.FindByIdentifier(ctx, user.ID, identifier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find saved reply: %w", err)
+	}
+
+	if in.Identifier != nil {
+		reply.Identifier = *in.Identifier
+	}
+	if in.Title != nil {
+		reply.Title = *in.Title
+	}
+	if in.Content != nil {
+		reply.Content = *in.Content
+	}
+
+	if err := sanitizeSavedReply(&reply.Identifier, &reply.Title, &reply.Content); err != nil {
+		return nil, err
+	}
+
+	reply.Updated = time.Now().UnixMilli()
+
+	err = c.savedReplyStore.Update(ctx, reply)
+	if errors.Is(err, gitness_store.ErrDuplicate) {
+		return nil, errors.Conflict("A saved reply with the identifier %q already exists", reply.Identifier)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update saved reply: %w", err)
+	}
+
+	return reply, nil
+}
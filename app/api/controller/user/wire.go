@@ -36,6 +36,8 @@ func ProvideController(
 	tokenStore store.TokenStore,
 	membershipStore store.MembershipStore,
 	publicKeyStore store.PublicKeyStore,
+	notificationSettingsStore store.NotificationSettingsStore,
+	savedReplyStore store.SavedReplyStore,
 ) *Controller {
 	return NewController(
 		tx,
@@ -44,5 +46,7 @@ func ProvideController(
 		principalStore,
 		tokenStore,
 		membershipStore,
-		publicKeyStore)
+		publicKeyStore,
+		notificationSettingsStore,
+		savedReplyStore)
 }
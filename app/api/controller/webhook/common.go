@@ -15,10 +15,16 @@
 package webhook
 
 import (
+	"encoding/base64"
+	"fmt"
 	"net"
+	"net/http"
 	"net/url"
+	"regexp"
+	"strings"
 
 	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/check"
 	"github.com/harness/gitness/types/enum"
 )
@@ -28,8 +34,24 @@ const (
 	webhookMaxURLLength = 2048
 	// webhookMaxSecretLength defines the max allowed length of a webhook secret.
 	webhookMaxSecretLength = 4096
+	// webhookMaxExtraHeaders defines the max allowed number of extra headers of a webhook.
+	webhookMaxExtraHeaders = 20
+	// webhookMaxExtraHeaderValueLength defines the max allowed length of an extra header value.
+	webhookMaxExtraHeaderValueLength = 4096
 )
 
+// headerKeyRegex matches valid http header field names (see RFC 7230 token).
+var headerKeyRegex = regexp.MustCompile("^[!#$%&'*+\\-.^_`|~0-9A-Za-z]+$")
+
+// reservedExtraHeaders are headers that are set by the system and can't be provided by the user.
+var reservedExtraHeaders = map[string]struct{}{
+	"Host":              {},
+	"Content-Length":    {},
+	"Content-Type":      {},
+	"Transfer-Encoding": {},
+	"User-Agent":        {},
+}
+
 var ErrInternalWebhookOperationNotAllowed = usererror.Forbidden("changes to internal webhooks are not allowed")
 
 // CheckURL validates the url of a webhook.
@@ -84,6 +106,31 @@ func checkSecret(secret string) error {
 	return nil
 }
 
+// checkExtraHeaders validates the extra headers of a webhook.
+func checkExtraHeaders(headers []types.WebhookExtraHeader) error {
+	if len(headers) > webhookMaxExtraHeaders {
+		return check.NewValidationErrorf("A webhook can have at most %d extra headers.", webhookMaxExtraHeaders)
+	}
+
+	for _, header := range headers {
+		if !headerKeyRegex.MatchString(header.Key) {
+			return check.NewValidationErrorf("The extra header key '%s' is invalid.", header.Key)
+		}
+		if _, ok := reservedExtraHeaders[http.CanonicalHeaderKey(header.Key)]; ok {
+			return check.NewValidationErrorf("The extra header '%s' is reserved.", header.Key)
+		}
+		if len(header.Value) > webhookMaxExtraHeaderValueLength {
+			return check.NewValidationErrorf("The value of an extra header can be at most %d characters long.",
+				webhookMaxExtraHeaderValueLength)
+		}
+		if strings.ContainsAny(header.Value, "\r\n\x00") {
+			return check.NewValidationErrorf("The value of the extra header '%s' is invalid.", header.Key)
+		}
+	}
+
+	return nil
+}
+
 // CheckTriggers validates the triggers of a webhook.
 func CheckTriggers(triggers []enum.WebhookTrigger) error {
 	// ignore duplicates here, should be deduplicated later
@@ -122,3 +169,34 @@ func ConvertTriggers(vals []string) []enum.WebhookTrigger {
 	}
 	return res
 }
+
+// encryptExtraHeaders returns the extra headers with encrypted values. A header without value keeps
+// the value of the current header with the same key, as the values aren't returned by the API.
+func (c *Controller) encryptExtraHeaders(
+	headers []types.WebhookExtraHeader,
+	current []types.WebhookExtraHeader,
+) ([]types.WebhookExtraHeader, error) {
+	currentValues := make(map[string]string, len(current))
+	for _, header := range current {
+		currentValues[http.CanonicalHeaderKey(header.Key)] = header.Value
+	}
+
+	encrypted := make([]types.WebhookExtraHeader, len(headers))
+	for i, header := range headers {
+		encrypted[i].Key = header.Key
+
+		if header.Value == "" {
+			encrypted[i].Value = currentValues[http.CanonicalHeaderKey(header.Key)]
+			continue
+		}
+
+		value, err := c.encrypter.Encrypt(header.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt webhook extra header: %w", err)
+		}
+
+		encrypted[i].Value = base64.StdEncoding.EncodeToString(value)
+	}
+
+	return encrypted, nil
+}
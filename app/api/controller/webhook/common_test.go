@@ -0,0 +1,87 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/harness/gitness/encrypt"
+	"github.com/harness/gitness/types"
+)
+
+func TestController_encryptExtraHeaders(t *testing.T) {
+	encrypter, err := encrypt.New("0123456789abcdef0123456789abcdef", false)
+	if err != nil {
+		t.Fatalf("failed to create encrypter: %v", err)
+	}
+	c := &Controller{encrypter: encrypter}
+
+	current, err := c.encryptExtraHeaders([]types.WebhookExtraHeader{
+		{Key: "Authorization", Value: "Bearer token"},
+		{Key: "X-Team", Value: "ci"},
+	}, nil)
+	if err != nil {
+		t.Fatalf("failed to encrypt extra headers: %v", err)
+	}
+
+	decrypt := func(value string) string {
+		t.Helper()
+		ciphertext, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			t.Fatalf("value isn't base64 encoded: %v", err)
+		}
+		plaintext, err := encrypter.Decrypt(ciphertext)
+		if err != nil {
+			t.Fatalf("failed to decrypt value: %v", err)
+		}
+		return plaintext
+	}
+
+	if current[0].Value == "Bearer token" || decrypt(current[0].Value) != "Bearer token" {
+		t.Errorf("value of %s isn't encrypted", current[0].Key)
+	}
+
+	// headers without value keep their current value, removed headers are dropped.
+	updated, err := c.encryptExtraHeaders([]types.WebhookExtraHeader{
+		{Key: "authorization"},
+		{Key: "X-Env"},
+	}, current)
+	if err != nil {
+		t.Fatalf("failed to encrypt extra headers: %v", err)
+	}
+
+	if len(updated) != 2 {
+		t.Fatalf("expected 2 headers, got %d", len(updated))
+	}
+	if updated[0].Value != current[0].Value {
+		t.Errorf("expected the current value of %s to be kept", updated[0].Key)
+	}
+	if updated[1].Value != "" {
+		t.Errorf("expected %s to have no value", updated[1].Key)
+	}
+
+	out, err := json.Marshal(&types.Webhook{ExtraHeaders: updated})
+	if err != nil {
+		t.Fatalf("failed to marshal webhook: %v", err)
+	}
+	if strings.Contains(string(out), updated[0].Value) ||
+		!strings.Contains(string(out), `"extra_headers":[{"key":"authorization","has_value":true},`+
+			`{"key":"X-Env","has_value":false}]`) {
+		t.Errorf("unexpected webhook json %s", out)
+	}
+}
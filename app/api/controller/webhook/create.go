@@ -36,13 +36,14 @@ type CreateInput struct {
 	UID        string `json:"uid" deprecated:"true"`
 	Identifier string `json:"identifier"`
 	// TODO [CODE-1364]: Remove once UID/Identifier migration is completed.
-	DisplayName string                `json:"display_name"`
-	Description string                `json:"description"`
-	URL         string                `json:"url"`
-	Secret      string                `json:"secret"`
-	Enabled     bool                  `json:"enabled"`
-	Insecure    bool                  `json:"insecure"`
-	Triggers    []enum.WebhookTrigger `json:"triggers"`
+	DisplayName  string                     `json:"display_name"`
+	Description  string                     `json:"description"`
+	URL          string                     `json:"url"`
+	Secret       string                     `json:"secret"`
+	Enabled      bool                       `json:"enabled"`
+	Insecure     bool                       `json:"insecure"`
+	Triggers     []enum.WebhookTrigger      `json:"triggers"`
+	ExtraHeaders []types.WebhookExtraHeader `json:"extra_headers"`
 }
 
 // Create creates a new webhook.
@@ -73,6 +74,11 @@ func (c *Controller) Create(
 		return nil, fmt.Errorf("failed to encrypt webhook secret: %w", err)
 	}
 
+	extraHeaders, err := c.encryptExtraHeaders(in.ExtraHeaders, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	// create new webhook object
 	hook := &types.Webhook{
 		ID:         0, // the ID will be populated in the data layer
@@ -93,6 +99,7 @@ func (c *Controller) Create(
 		Enabled:               in.Enabled,
 		Insecure:              in.Insecure,
 		Triggers:              DeduplicateTriggers(in.Triggers),
+		ExtraHeaders:          extraHeaders,
 		LatestExecutionResult: nil,
 	}
 
@@ -155,7 +162,10 @@ func sanitizeCreateInput(in *CreateInput, allowLoopback bool, allowPrivateNetwor
 	if err := checkSecret(in.Secret); err != nil {
 		return err
 	}
-	if err := CheckTriggers(in.Triggers); err != nil { //nolint:revive
+	if err := CheckTriggers(in.Triggers); err != nil {
+		return err
+	}
+	if err := checkExtraHeaders(in.ExtraHeaders); err != nil { //nolint:revive
 		return err
 	}
 
@@ -29,13 +29,14 @@ type UpdateInput struct {
 	UID        *string `json:"uid" deprecated:"true"`
 	Identifier *string `json:"identifier"`
 	// TODO [CODE-1364]: Remove once UID/Identifier migration is completed.
-	DisplayName *string               `json:"display_name"`
-	Description *string               `json:"description"`
-	URL         *string               `json:"url"`
-	Secret      *string               `json:"secret"`
-	Enabled     *bool                 `json:"enabled"`
-	Insecure    *bool                 `json:"insecure"`
-	Triggers    []enum.WebhookTrigger `json:"triggers"`
+	DisplayName  *string                    `json:"display_name"`
+	Description  *string                    `json:"description"`
+	URL          *string                    `json:"url"`
+	Secret       *string                    `json:"secret"`
+	Enabled      *bool                      `json:"enabled"`
+	Insecure     *bool                      `json:"insecure"`
+	Triggers     []enum.WebhookTrigger      `json:"triggers"`
+	ExtraHeaders []types.WebhookExtraHeader `json:"extra_headers"`
 }
 
 // Update updates an existing webhook.
@@ -95,6 +96,13 @@ func (c *Controller) Update(
 	if in.Triggers != nil {
 		hook.Triggers = DeduplicateTriggers(in.Triggers)
 	}
+	if in.ExtraHeaders != nil {
+		extraHeaders, err := c.encryptExtraHeaders(in.ExtraHeaders, hook.ExtraHeaders)
+		if err != nil {
+			return nil, err
+		}
+		hook.ExtraHeaders = extraHeaders
+	}
 
 	if err = c.webhookStore.Update(ctx, hook); err != nil {
 		return nil, err
@@ -139,6 +147,11 @@ func sanitizeUpdateInput(in *UpdateInput, allowLoopback bool, allowPrivateNetwor
 			return err
 		}
 	}
+	if in.ExtraHeaders != nil {
+		if err := checkExtraHeaders(in.ExtraHeaders); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
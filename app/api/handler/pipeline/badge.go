@@ -0,0 +1,155 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"bytes"
+	"net/http"
+	"text/template"
+
+	"github.com/harness/gitness/app/api/controller/pipeline"
+	"github.com/harness/gitness/app/api/render"
+	"github.com/harness/gitness/app/api/request"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	badgeLabel = "build"
+
+	// badgeStyleFlatSquare renders the badge without rounded corners and gradient.
+	// By default, the flat style with rounded corners is used.
+	badgeStyleFlatSquare = "flat-square"
+
+	// badgeCharWidth is the approximated width of a single character of the badge font.
+	badgeCharWidth = 7
+	// badgePadding is the horizontal padding around the label and message text.
+	badgePadding = 10
+)
+
+var badgeTemplate = template.Must(template.New("badge").Parse(
+	`<svg xmlns="http://www.w3.org/2000/svg" width="{{.Width}}" height="20">` +
+		`{{if .Gradient}}<linearGradient id="b" x2="0" y2="100%">` +
+		`<stop offset="0" stop-color="#bbb" stop-opacity=".1"/><stop offset="1" stop-opacity=".1"/>` +
+		`</linearGradient>{{end}}` +
+		`<mask id="a"><rect width="{{.Width}}" height="20" rx="{{.Radius}}" fill="#fff"/></mask>` +
+		`<g mask="url(#a)">` +
+		`<path fill="#555" d="M0 0h{{.LabelWidth}}v20H0z"/>` +
+		`<path fill="{{.Color}}" d="M{{.LabelWidth}} 0h{{.MessageWidth}}v20H{{.LabelWidth}}z"/>` +
+		`{{if .Gradient}}<path fill="url(#b)" d="M0 0h{{.Width}}v20H0z"/>{{end}}` +
+		`</g>` +
+		`<g fill="#fff" text-anchor="middle" font-family="DejaVu Sans,Verdana,Geneva,sans-serif" font-size="11">` +
+		`<text x="{{.LabelX}}" y="15" fill="#010101" fill-opacity=".3">{{.Label}}</text>` +
+		`<text x="{{.LabelX}}" y="14">{{.Label}}</text>` +
+		`<text x="{{.MessageX}}" y="15" fill="#010101" fill-opacity=".3">{{.Message}}</text>` +
+		`<text x="{{.MessageX}}" y="14">{{.Message}}</text>` +
+		`</g></svg>`,
+))
+
+type badge struct {
+	Label        string
+	Message      string
+	Color        string
+	Width        int
+	LabelWidth   int
+	MessageWidth int
+	LabelX       int
+	MessageX     int
+	Radius       int
+	Gradient     bool
+}
+
+// HandleBadge writes an svg status badge of the latest pipeline execution.
+func HandleBadge(pipelineCtrl *pipeline.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		session, _ := request.AuthSessionFrom(ctx)
+		pipelineIdentifier, err := request.GetPipelineIdentifierFromPath(r)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+		repoRef, err := request.GetRepoRefFromPath(r)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		branch := request.GetBranchFromQuery(r)
+		style := request.GetBadgeStyleFromQuery(r)
+
+		status, err := pipelineCtrl.BadgeStatus(ctx, session, repoRef, pipelineIdentifier, branch)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		buf := &bytes.Buffer{}
+		if err = badgeTemplate.Execute(buf, newBadge(status, style)); err != nil {
+			log.Ctx(ctx).Err(err).Msg("failed to render pipeline badge")
+			render.InternalError(ctx, w)
+			return
+		}
+
+		// badges are embedded in READMEs and have to reflect the latest execution.
+		render.NoCache(w)
+		w.Header().Set("Content-Type", "image/svg+xml")
+		render.Reader(ctx, w, http.StatusOK, buf)
+	}
+}
+
+func newBadge(status enum.CIStatus, style string) badge {
+	message, color := badgeMessage(status)
+
+	labelWidth := len(badgeLabel)*badgeCharWidth + badgePadding
+	messageWidth := len(message)*badgeCharWidth + badgePadding
+
+	b := badge{
+		Label:        badgeLabel,
+		Message:      message,
+		Color:        color,
+		Width:        labelWidth + messageWidth,
+		LabelWidth:   labelWidth,
+		MessageWidth: messageWidth,
+		LabelX:       labelWidth / 2,
+		MessageX:     labelWidth + messageWidth/2,
+		Radius:       3,
+		Gradient:     true,
+	}
+
+	if style == badgeStyleFlatSquare {
+		b.Radius = 0
+		b.Gradient = false
+	}
+
+	return b
+}
+
+func badgeMessage(status enum.CIStatus) (string, string) {
+	//nolint:exhaustive // all other states are reported as unknown.
+	switch status {
+	case enum.CIStatusSuccess:
+		return "passing", "#4c1"
+	case enum.CIStatusFailure, enum.CIStatusError, enum.CIStatusKilled:
+		return "failing", "#e05d44"
+	case enum.CIStatusPending, enum.CIStatusRunning, enum.CIStatusWaitingOnDeps, enum.CIStatusBlocked:
+		return "running", "#dfb317"
+	case "":
+		return "none", "#9f9f9f"
+	default:
+		return "unknown", "#9f9f9f"
+	}
+}
@@ -62,8 +62,14 @@ func HandleDiff(pullreqCtrl *pullreq.Controller) http.HandlerFunc {
 			files = request.GetFileDiffFromQuery(r)
 		}
 
+		opts, err := request.GetDiffOptionsFromQuery(r)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
 		if strings.HasPrefix(r.Header.Get("Accept"), "text/plain") {
-			err := pullreqCtrl.RawDiff(ctx, w, session, repoRef, pullreqNumber, setSHAs, files...)
+			err := pullreqCtrl.RawDiff(ctx, w, session, repoRef, pullreqNumber, setSHAs, opts, files...)
 			if err != nil {
 				http.Error(w, err.Error(), http.StatusOK)
 			}
@@ -71,7 +77,7 @@ func HandleDiff(pullreqCtrl *pullreq.Controller) http.HandlerFunc {
 		}
 
 		_, includePatch := request.QueryParam(r, "include_patch")
-		stream, err := pullreqCtrl.Diff(ctx, session, repoRef, pullreqNumber, setSHAs, includePatch, files...)
+		stream, err := pullreqCtrl.Diff(ctx, session, repoRef, pullreqNumber, setSHAs, includePatch, opts, files...)
 		if err != nil {
 			render.TranslatedUserError(ctx, w, err)
 			return
@@ -80,3 +86,39 @@ func HandleDiff(pullreqCtrl *pullreq.Controller) http.HandlerFunc {
 		render.JSONArrayDynamic(ctx, w, stream)
 	}
 }
+
+// HandleDiffManifest returns a http.HandlerFunc that returns a page of the files changed in the pull request.
+func HandleDiffManifest(pullreqCtrl *pullreq.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		session, _ := request.AuthSessionFrom(ctx)
+
+		repoRef, err := request.GetRepoRefFromPath(r)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		pullreqNumber, err := request.GetPullReqNumberFromPath(r)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		setSHAs := func(sourceSHA, mergeBaseSHA string) {
+			w.Header().Set("X-Source-Sha", sourceSHA)
+			w.Header().Set("X-Merge-Base-Sha", mergeBaseSHA)
+		}
+
+		pagination := request.ParsePaginationFromRequest(r)
+
+		files, total, err := pullreqCtrl.DiffManifest(ctx, session, repoRef, pullreqNumber, setSHAs, pagination)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.Pagination(r, w, pagination.Page, pagination.Size, total)
+		render.JSON(w, http.StatusOK, files)
+	}
+}
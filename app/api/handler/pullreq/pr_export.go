@@ -0,0 +1,62 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/harness/gitness/app/api/controller/pullreq"
+	"github.com/harness/gitness/app/api/render"
+	"github.com/harness/gitness/app/api/request"
+)
+
+// HandleExport returns a http.HandlerFunc that downloads the pull request as a diff, a patch or a patch series.
+func HandleExport(pullreqCtrl *pullreq.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		session, _ := request.AuthSessionFrom(ctx)
+
+		repoRef, err := request.GetRepoRefFromPath(r)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		pullreqNumber, err := request.GetPullReqNumberFromPath(r)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		format := request.GetPullReqExportFormatFromQuery(r)
+
+		written := false
+		setFilename := func(filename string) {
+			written = true
+			w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s", filename))
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		}
+
+		err = pullreqCtrl.Export(ctx, w, session, repoRef, pullreqNumber, format, setFilename)
+		if err != nil && !written {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusOK)
+		}
+	}
+}
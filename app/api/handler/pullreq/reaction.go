@@ -0,0 +1,157 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/harness/gitness/app/api/controller/pullreq"
+	"github.com/harness/gitness/app/api/render"
+	"github.com/harness/gitness/app/api/request"
+)
+
+// HandleReactionList is an HTTP handler for listing reactions to a pull request description.
+func HandleReactionList(pullreqCtrl *pullreq.Controller) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		session, _ := request.AuthSessionFrom(ctx)
+
+		repoRef, err := request.GetRepoRefFromPath(r)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		pullreqNumber, err := request.GetPullReqNumberFromPath(r)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		reactions, err := pullreqCtrl.ReactionList(ctx, session, repoRef, pullreqNumber)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusOK, reactions)
+	}
+}
+
+// HandleReactionAdd is an HTTP handler for adding a reaction to a pull request description.
+func HandleReactionAdd(pullreqCtrl *pullreq.Controller) http.HandlerFunc {
+	return handleReactionAdd(pullreqCtrl, false)
+}
+
+// HandleCommentReactionAdd is an HTTP handler for adding a reaction to a pull request comment.
+func HandleCommentReactionAdd(pullreqCtrl *pullreq.Controller) http.HandlerFunc {
+	return handleReactionAdd(pullreqCtrl, true)
+}
+
+// HandleReactionDelete is an HTTP handler for removing a reaction from a pull request description.
+func HandleReactionDelete(pullreqCtrl *pullreq.Controller) http.HandlerFunc {
+	return handleReactionDelete(pullreqCtrl, false)
+}
+
+// HandleCommentReactionDelete is an HTTP handler for removing a reaction from a pull request comment.
+func HandleCommentReactionDelete(pullreqCtrl *pullreq.Controller) http.HandlerFunc {
+	return handleReactionDelete(pullreqCtrl, true)
+}
+
+func handleReactionAdd(pullreqCtrl *pullreq.Controller, onComment bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		session, _ := request.AuthSessionFrom(ctx)
+
+		repoRef, err := request.GetRepoRefFromPath(r)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		pullreqNumber, err := request.GetPullReqNumberFromPath(r)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		var commentID int64
+		if onComment {
+			commentID, err = request.GetPullReqCommentIDPath(r)
+			if err != nil {
+				render.TranslatedUserError(ctx, w, err)
+				return
+			}
+		}
+
+		in := new(pullreq.ReactionInput)
+		err = json.NewDecoder(r.Body).Decode(in)
+		if err != nil {
+			render.BadRequestf(ctx, w, "Invalid Request Body: %s.", err)
+			return
+		}
+
+		reactions, err := pullreqCtrl.ReactionAdd(ctx, session, repoRef, pullreqNumber, commentID, in)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.JSON(w, http.StatusOK, reactions)
+	}
+}
+
+func handleReactionDelete(pullreqCtrl *pullreq.Controller, onComment bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		session, _ := request.AuthSessionFrom(ctx)
+
+		repoRef, err := request.GetRepoRefFromPath(r)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		pullreqNumber, err := request.GetPullReqNumberFromPath(r)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		var commentID int64
+		if onComment {
+			commentID, err = request.GetPullReqCommentIDPath(r)
+			if err != nil {
+				render.TranslatedUserError(ctx, w, err)
+				return
+			}
+		}
+
+		reaction, err := request.GetPullReqReactionFromPath(r)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		err = pullreqCtrl.ReactionDelete(ctx, session, repoRef, pullreqNumber, commentID, reaction)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
+		render.DeleteSuccessful(w)
+	}
+}
@@ -53,8 +53,14 @@ func HandleDiff(repoCtrl *repo.Controller) http.HandlerFunc {
 			files = request.GetFileDiffFromQuery(r)
 		}
 
+		opts, err := request.GetDiffOptionsFromQuery(r)
+		if err != nil {
+			render.TranslatedUserError(ctx, w, err)
+			return
+		}
+
 		if strings.HasPrefix(r.Header.Get("Accept"), "text/plain") {
-			err := repoCtrl.RawDiff(ctx, w, session, repoRef, path, files...)
+			err := repoCtrl.RawDiff(ctx, w, session, repoRef, path, opts, files...)
 			if err != nil {
 				http.Error(w, err.Error(), http.StatusOK)
 			}
@@ -62,7 +68,7 @@ func HandleDiff(repoCtrl *repo.Controller) http.HandlerFunc {
 		}
 
 		_, includePatch := request.QueryParam(r, "include_patch")
-		stream, err := repoCtrl.Diff(ctx, session, repoRef, path, includePatch, files...)
+		stream, err := repoCtrl.Diff(ctx, session, repoRef, path, includePatch, opts, files...)
 		if err != nil {
 			render.TranslatedUserError(ctx, w, err)
 			return
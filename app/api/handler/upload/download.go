@@ -15,7 +15,10 @@
 package upload
 
 import (
+	"mime"
 	"net/http"
+	"path"
+	"strings"
 
 	"github.com/harness/gitness/app/api/controller/upload"
 	"github.com/harness/gitness/app/api/render"
@@ -46,6 +49,7 @@ func HandleDownoad(controller *upload.Controller) http.HandlerFunc {
 			return
 		}
 		if file != nil {
+			setDownloadHeaders(w, filename)
 			render.Reader(ctx, w, http.StatusOK, file)
 			err = file.Close()
 			if err != nil {
@@ -61,3 +65,38 @@ func HandleDownoad(controller *upload.Controller) http.HandlerFunc {
 		)
 	}
 }
+
+// inlineContentTypePrefixes are the content types that are safe to render in the browser.
+// Only raster images and videos are allowed, because documents like SVG can carry scripts.
+var inlineContentTypePrefixes = []string{
+	"image/png",
+	"image/jpeg",
+	"image/gif",
+	"image/webp",
+	"image/bmp",
+	"image/avif",
+	"video/",
+}
+
+// setDownloadHeaders sets the content type of the file based on its extension and prevents browsers
+// from sniffing it or running any content of it. Files that aren't raster images or videos
+// are served as attachments.
+func setDownloadHeaders(w http.ResponseWriter, filename string) {
+	contentType := mime.TypeByExtension(path.Ext(filename))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Content-Security-Policy", "sandbox")
+
+	for _, prefix := range inlineContentTypePrefixes {
+		if strings.HasPrefix(contentType, prefix) {
+			return
+		}
+	}
+
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment",
+		map[string]string{"filename": path.Base(filename)}))
+}
@@ -17,6 +17,7 @@ package openapi
 import (
 	"net/http"
 
+	"github.com/harness/gitness/app/api/controller/execution"
 	"github.com/harness/gitness/app/api/controller/pipeline"
 	"github.com/harness/gitness/app/api/controller/trigger"
 	"github.com/harness/gitness/app/api/request"
@@ -67,6 +68,11 @@ type getExecutionRequest struct {
 	executionRequest
 }
 
+type promoteExecutionRequest struct {
+	executionRequest
+	execution.PromoteInput
+}
+
 type getTriggerRequest struct {
 	triggerRequest
 }
@@ -113,6 +119,36 @@ var queryParameterBranch = openapi3.ParameterOrRef{
 	},
 }
 
+var queryParameterBadgeBranch = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name:        request.QueryParamBranch,
+		In:          openapi3.ParameterInQuery,
+		Description: ptr.String("Branch for which the status of the latest execution is shown."),
+		Required:    ptr.Bool(false),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type: ptrSchemaType(openapi3.SchemaTypeString),
+			},
+		},
+	},
+}
+
+var queryParameterBadgeStyle = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name:        request.QueryParamBadgeStyle,
+		In:          openapi3.ParameterInQuery,
+		Description: ptr.String("The style of the badge."),
+		Required:    ptr.Bool(false),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type:    ptrSchemaType(openapi3.SchemaTypeString),
+				Default: ptrptr("flat"),
+				Enum:    []interface{}{"flat", "flat-square"},
+			},
+		},
+	},
+}
+
 func pipelineOperations(reflector *openapi3.Reflector) {
 	opCreate := openapi3.Operation{}
 	opCreate.WithTags("pipeline")
@@ -172,6 +208,19 @@ func pipelineOperations(reflector *openapi3.Reflector) {
 	_ = reflector.Spec.AddOperation(http.MethodPatch,
 		"/repos/{repo_ref}/pipelines/{pipeline_identifier}", opUpdate)
 
+	opBadge := openapi3.Operation{}
+	opBadge.WithTags("pipeline")
+	opBadge.WithParameters(queryParameterBadgeBranch, queryParameterBadgeStyle)
+	opBadge.WithMapOfAnything(map[string]interface{}{"operationId": "pipelineBadge"})
+	_ = reflector.SetRequest(&opBadge, new(getPipelineRequest), http.MethodGet)
+	_ = reflector.SetStringResponse(&opBadge, http.StatusOK, "image/svg+xml")
+	_ = reflector.SetJSONResponse(&opBadge, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opBadge, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opBadge, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opBadge, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet,
+		"/repos/{repo_ref}/pipelines/{pipeline_identifier}/badge", opBadge)
+
 	executionCreate := openapi3.Operation{}
 	executionCreate.WithTags("pipeline")
 	executionCreate.WithParameters(queryParameterBranch)
@@ -209,6 +258,32 @@ func pipelineOperations(reflector *openapi3.Reflector) {
 	_ = reflector.Spec.AddOperation(http.MethodPost,
 		"/repos/{repo_ref}/pipelines/{pipeline_identifier}/executions/{execution_number}/cancel", executionCancel)
 
+	executionRerun := openapi3.Operation{}
+	executionRerun.WithTags("pipeline")
+	executionRerun.WithMapOfAnything(map[string]interface{}{"operationId": "rerunExecution"})
+	_ = reflector.SetRequest(&executionRerun, new(getExecutionRequest), http.MethodPost)
+	_ = reflector.SetJSONResponse(&executionRerun, new(types.Execution), http.StatusCreated)
+	_ = reflector.SetJSONResponse(&executionRerun, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&executionRerun, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&executionRerun, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&executionRerun, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&executionRerun, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodPost,
+		"/repos/{repo_ref}/pipelines/{pipeline_identifier}/executions/{execution_number}/rerun", executionRerun)
+
+	executionPromote := openapi3.Operation{}
+	executionPromote.WithTags("pipeline")
+	executionPromote.WithMapOfAnything(map[string]interface{}{"operationId": "promoteExecution"})
+	_ = reflector.SetRequest(&executionPromote, new(promoteExecutionRequest), http.MethodPost)
+	_ = reflector.SetJSONResponse(&executionPromote, new(types.Execution), http.StatusCreated)
+	_ = reflector.SetJSONResponse(&executionPromote, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&executionPromote, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&executionPromote, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&executionPromote, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&executionPromote, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodPost,
+		"/repos/{repo_ref}/pipelines/{pipeline_identifier}/executions/{execution_number}/promote", executionPromote)
+
 	executionDelete := openapi3.Operation{}
 	executionDelete.WithTags("pipeline")
 	executionDelete.WithMapOfAnything(map[string]interface{}{"operationId": "deleteExecution"})
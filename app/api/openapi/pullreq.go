@@ -57,6 +57,11 @@ type statePullReqRequest struct {
 	pullreq.StateInput
 }
 
+type lockPullReqRequest struct {
+	pullReqRequest
+	pullreq.LockInput
+}
+
 type listPullReqActivitiesRequest struct {
 	pullReqRequest
 }
@@ -66,6 +71,21 @@ type mergePullReq struct {
 	pullreq.MergeInput
 }
 
+type mergeQueueAddRequest struct {
+	pullReqRequest
+	pullreq.MergeQueueAddInput
+}
+
+type dependencyAddPullReqRequest struct {
+	pullReqRequest
+	pullreq.DependencyAddInput
+}
+
+type dependencyDeletePullReqRequest struct {
+	pullReqRequest
+	DependencyNumber int64 `path:"pullreq_dependency_number"`
+}
+
 type commentCreatePullReqRequest struct {
 	pullReqRequest
 	pullreq.CommentCreateInput
@@ -128,13 +148,57 @@ type fileViewDeletePullReqRequest struct {
 	Path string `path:"file_path"`
 }
 
+type reactionAddPullReqRequest struct {
+	pullReqRequest
+	pullreq.ReactionInput
+}
+
+type reactionDeletePullReqRequest struct {
+	pullReqRequest
+	Reaction enum.PullReqReaction `path:"pullreq_reaction"`
+}
+
+type commentReactionAddPullReqRequest struct {
+	pullReqCommentRequest
+	pullreq.ReactionInput
+}
+
+type commentReactionDeletePullReqRequest struct {
+	pullReqCommentRequest
+	Reaction enum.PullReqReaction `path:"pullreq_reaction"`
+}
+
+type templatesPullReqRequest struct {
+	repoRequest
+	Branch string `query:"branch" description:"Branch from which the templates are read. Defaults to the default branch."`
+}
+
 type getRawPRDiffRequest struct {
 	pullReqRequest
-	Path []string `query:"path" description:"provide path for diff operation"`
+	diffOptionsRequest
+	Path  []string `query:"path" description:"provide path for diff operation"`
+	Range []string `query:"range" description:"provide line range (start:end) of the diff for each path"`
+}
+
+type getPRCommitDiffRequest struct {
+	pullReqRequest
+	diffOptionsRequest
+	CommitSHA     string   `path:"commit_sha"`
+	FromCommitSHA string   `query:"from_commit_sha" description:"exclusive start of the commit range"`
+	Path          []string `query:"path" description:"provide path for diff operation"`
+}
+
+type postPRCommitDiffRequest struct {
+	pullReqRequest
+	diffOptionsRequest
+	gittypes.FileDiffRequests
+	CommitSHA     string `path:"commit_sha"`
+	FromCommitSHA string `query:"from_commit_sha" description:"exclusive start of the commit range"`
 }
 
 type postRawPRDiffRequest struct {
 	pullReqRequest
+	diffOptionsRequest
 	gittypes.FileDiffRequests
 }
 
@@ -161,6 +225,51 @@ var queryParameterQueryPullRequest = openapi3.ParameterOrRef{
 	},
 }
 
+var queryParameterExportFormatPullRequest = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name:        request.QueryParamExportFormat,
+		In:          openapi3.ParameterInQuery,
+		Description: ptr.String("The format of the download: a unified diff, a single patch or an mbox patch series."),
+		Required:    ptr.Bool(false),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type:    ptrSchemaType(openapi3.SchemaTypeString),
+				Default: ptrptr(enum.PullReqExportFormatDiff),
+				Enum:    enum.PullReqExportFormat("").Enum(),
+			},
+		},
+	},
+}
+
+var queryParameterMergeQueueBranch = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name:        request.QueryParamBranch,
+		In:          openapi3.ParameterInQuery,
+		Description: ptr.String("The target branch of the merge queue. Defaults to the default branch of the repository."),
+		Required:    ptr.Bool(false),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type: ptrSchemaType(openapi3.SchemaTypeString),
+			},
+		},
+	},
+}
+
+var queryParameterSearchPullRequest = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name:     request.QueryParamQuery,
+		In:       openapi3.ParameterInQuery,
+		Required: ptr.Bool(false),
+		Description: ptr.String("The search query. The text is matched against pull request titles, " +
+			"descriptions and comments. Supported qualifiers are author:<uid>, label:<key> and state:<state>."),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type: ptrSchemaType(openapi3.SchemaTypeString),
+			},
+		},
+	},
+}
+
 var queryParameterSourceRepoRefPullRequest = openapi3.ParameterOrRef{
 	Parameter: &openapi3.Parameter{
 		Name:        "source_repo_ref",
@@ -304,6 +413,52 @@ var queryParameterTypePullRequestActivity = openapi3.ParameterOrRef{
 	},
 }
 
+var queryParameterCommentStatusPullRequestActivity = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name:        request.QueryParamCommentStatus,
+		In:          openapi3.ParameterInQuery,
+		Description: ptr.String("Return only comment threads with this status."),
+		Required:    ptr.Bool(false),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type: ptrSchemaType(openapi3.SchemaTypeString),
+				Enum: enum.PullReqCommentStatus("").Enum(),
+			},
+		},
+	},
+}
+
+var queryParameterPathPullRequestActivity = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name:        request.QueryParamPath,
+		In:          openapi3.ParameterInQuery,
+		Description: ptr.String("Return only code comment threads on this file path."),
+		Required:    ptr.Bool(false),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type: ptrSchemaType(openapi3.SchemaTypeString),
+			},
+		},
+	},
+}
+
+var queryParameterAfterIDPullRequestActivity = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name: request.QueryParamAfterID,
+		In:   openapi3.ParameterInQuery,
+		Description: ptr.String("Together with after, the result should contain only entries created after " +
+			"the timestamp or created at the timestamp with a greater ID. Limited results are ordered by creation " +
+			"time and ID, so the last entry of a page provides after and after_id of the next page."),
+		Required: ptr.Bool(false),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type:    ptrSchemaType(openapi3.SchemaTypeInteger),
+				Minimum: ptr.Float64(0),
+			},
+		},
+	},
+}
+
 var queryParameterBeforePullRequestActivity = openapi3.ParameterOrRef{
 	Parameter: &openapi3.Parameter{
 		Name:        request.QueryParamBefore,
@@ -540,12 +695,36 @@ func pullReqOperations(reflector *openapi3.Reflector) {
 	_ = reflector.SetJSONResponse(&statePullReq, new(usererror.Error), http.StatusForbidden)
 	_ = reflector.Spec.AddOperation(http.MethodPost, "/repos/{repo_ref}/pullreq/{pullreq_number}/state", statePullReq)
 
+	lockPullReq := openapi3.Operation{}
+	lockPullReq.WithTags("pullreq")
+	lockPullReq.WithMapOfAnything(map[string]interface{}{"operationId": "lockPullReq"})
+	_ = reflector.SetRequest(&lockPullReq, new(lockPullReqRequest), http.MethodPost)
+	_ = reflector.SetJSONResponse(&lockPullReq, new(types.PullReq), http.StatusOK)
+	_ = reflector.SetJSONResponse(&lockPullReq, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&lockPullReq, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&lockPullReq, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&lockPullReq, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodPost, "/repos/{repo_ref}/pullreq/{pullreq_number}/lock", lockPullReq)
+
+	unlockPullReq := openapi3.Operation{}
+	unlockPullReq.WithTags("pullreq")
+	unlockPullReq.WithMapOfAnything(map[string]interface{}{"operationId": "unlockPullReq"})
+	_ = reflector.SetRequest(&unlockPullReq, new(pullReqRequest), http.MethodPost)
+	_ = reflector.SetJSONResponse(&unlockPullReq, new(types.PullReq), http.StatusOK)
+	_ = reflector.SetJSONResponse(&unlockPullReq, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&unlockPullReq, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&unlockPullReq, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&unlockPullReq, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodPost, "/repos/{repo_ref}/pullreq/{pullreq_number}/unlock", unlockPullReq)
+
 	listPullReqActivities := openapi3.Operation{}
 	listPullReqActivities.WithTags("pullreq")
 	listPullReqActivities.WithMapOfAnything(map[string]interface{}{"operationId": "listPullReqActivities"})
 	listPullReqActivities.WithParameters(
 		queryParameterKindPullRequestActivity, queryParameterTypePullRequestActivity,
-		queryParameterAfter, queryParameterBeforePullRequestActivity, QueryParameterLimit)
+		queryParameterAuthorID, queryParameterCommentStatusPullRequestActivity, queryParameterPathPullRequestActivity,
+		queryParameterAfter, queryParameterAfterIDPullRequestActivity, queryParameterBeforePullRequestActivity,
+		QueryParameterLimit)
 	_ = reflector.SetRequest(&listPullReqActivities, new(listPullReqActivitiesRequest), http.MethodGet)
 	_ = reflector.SetJSONResponse(&listPullReqActivities, new([]types.PullReqActivity), http.StatusOK)
 	_ = reflector.SetJSONResponse(&listPullReqActivities, new(usererror.Error), http.StatusBadRequest)
@@ -679,6 +858,44 @@ func pullReqOperations(reflector *openapi3.Reflector) {
 	_ = reflector.Spec.AddOperation(http.MethodPost,
 		"/repos/{repo_ref}/pullreq/{pullreq_number}/merge", mergePullReqOp)
 
+	opMergeQueueAdd := openapi3.Operation{}
+	opMergeQueueAdd.WithTags("pullreq")
+	opMergeQueueAdd.WithMapOfAnything(map[string]interface{}{"operationId": "addPullReqToMergeQueue"})
+	_ = reflector.SetRequest(&opMergeQueueAdd, new(mergeQueueAddRequest), http.MethodPost)
+	_ = reflector.SetJSONResponse(&opMergeQueueAdd, new(types.MergeQueueEntry), http.StatusCreated)
+	_ = reflector.SetJSONResponse(&opMergeQueueAdd, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opMergeQueueAdd, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opMergeQueueAdd, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opMergeQueueAdd, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.SetJSONResponse(&opMergeQueueAdd, new(usererror.Error), http.StatusConflict)
+	_ = reflector.SetJSONResponse(&opMergeQueueAdd, new(types.MergeViolations), http.StatusUnprocessableEntity)
+	_ = reflector.Spec.AddOperation(http.MethodPost,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/merge-queue", opMergeQueueAdd)
+
+	opMergeQueueRemove := openapi3.Operation{}
+	opMergeQueueRemove.WithTags("pullreq")
+	opMergeQueueRemove.WithMapOfAnything(map[string]interface{}{"operationId": "removePullReqFromMergeQueue"})
+	_ = reflector.SetRequest(&opMergeQueueRemove, new(pullReqRequest), http.MethodDelete)
+	_ = reflector.SetJSONResponse(&opMergeQueueRemove, nil, http.StatusNoContent)
+	_ = reflector.SetJSONResponse(&opMergeQueueRemove, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opMergeQueueRemove, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opMergeQueueRemove, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opMergeQueueRemove, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodDelete,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/merge-queue", opMergeQueueRemove)
+
+	opMergeQueueList := openapi3.Operation{}
+	opMergeQueueList.WithTags("pullreq")
+	opMergeQueueList.WithMapOfAnything(map[string]interface{}{"operationId": "listMergeQueue"})
+	opMergeQueueList.WithParameters(queryParameterMergeQueueBranch)
+	_ = reflector.SetRequest(&opMergeQueueList, new(repoRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&opMergeQueueList, []types.MergeQueueEntry{}, http.StatusOK)
+	_ = reflector.SetJSONResponse(&opMergeQueueList, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opMergeQueueList, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opMergeQueueList, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opMergeQueueList, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/repos/{repo_ref}/pullreq/merge-queue", opMergeQueueList)
+
 	opListCommits := openapi3.Operation{}
 	opListCommits.WithTags("pullreq")
 	opListCommits.WithMapOfAnything(map[string]interface{}{"operationId": "listPullReqCommits"})
@@ -732,6 +949,128 @@ func pullReqOperations(reflector *openapi3.Reflector) {
 	_ = reflector.SetJSONResponse(&opDeleteBranch, new(types.RulesViolations), http.StatusUnprocessableEntity)
 	_ = reflector.Spec.AddOperation(http.MethodDelete, "/repos/{repo_ref}/pullreq/{pullreq_number}/branch", opDeleteBranch)
 
+	opCherryPick := openapi3.Operation{}
+	opCherryPick.WithTags("pullreq")
+	opCherryPick.WithMapOfAnything(map[string]interface{}{"operationId": "cherryPickPullReq"})
+	_ = reflector.SetRequest(&opCherryPick, struct {
+		pullReqRequest
+		pullreq.CherryPickInput
+	}{}, http.MethodPost)
+	_ = reflector.SetJSONResponse(&opCherryPick, new(types.CherryPickOutput), http.StatusCreated)
+	_ = reflector.SetJSONResponse(&opCherryPick, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opCherryPick, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opCherryPick, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opCherryPick, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opCherryPick, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.SetJSONResponse(&opCherryPick, new(usererror.Error), http.StatusConflict)
+	_ = reflector.SetJSONResponse(&opCherryPick, new(types.RulesViolations), http.StatusUnprocessableEntity)
+	_ = reflector.Spec.AddOperation(http.MethodPost,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/cherry-pick", opCherryPick)
+
+	opRevert := openapi3.Operation{}
+	opRevert.WithTags("pullreq")
+	opRevert.WithMapOfAnything(map[string]interface{}{"operationId": "revertPullReq"})
+	_ = reflector.SetRequest(&opRevert, struct {
+		pullReqRequest
+		pullreq.RevertInput
+	}{}, http.MethodPost)
+	_ = reflector.SetJSONResponse(&opRevert, new(types.RevertOutput), http.StatusCreated)
+	_ = reflector.SetJSONResponse(&opRevert, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opRevert, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opRevert, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opRevert, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opRevert, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.SetJSONResponse(&opRevert, new(usererror.Error), http.StatusConflict)
+	_ = reflector.SetJSONResponse(&opRevert, new(types.RulesViolations), http.StatusUnprocessableEntity)
+	_ = reflector.Spec.AddOperation(http.MethodPost,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/revert", opRevert)
+
+	opExport := openapi3.Operation{}
+	opExport.WithTags("pullreq")
+	opExport.WithMapOfAnything(map[string]interface{}{"operationId": "exportPullReq"})
+	opExport.WithParameters(queryParameterExportFormatPullRequest)
+	_ = reflector.SetRequest(&opExport, new(pullReqRequest), http.MethodGet)
+	_ = reflector.SetStringResponse(&opExport, http.StatusOK, "text/plain")
+	_ = reflector.SetJSONResponse(&opExport, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opExport, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opExport, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opExport, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opExport, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/repos/{repo_ref}/pullreq/{pullreq_number}/export", opExport)
+
+	opEditList := openapi3.Operation{}
+	opEditList.WithTags("pullreq")
+	opEditList.WithMapOfAnything(map[string]interface{}{"operationId": "listPullReqEdits"})
+	_ = reflector.SetRequest(&opEditList, new(pullReqRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&opEditList, []types.PullReqEdit{}, http.StatusOK)
+	_ = reflector.SetJSONResponse(&opEditList, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opEditList, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opEditList, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opEditList, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/repos/{repo_ref}/pullreq/{pullreq_number}/edits", opEditList)
+
+	opParticipantList := openapi3.Operation{}
+	opParticipantList.WithTags("pullreq")
+	opParticipantList.WithMapOfAnything(map[string]interface{}{"operationId": "listPullReqParticipants"})
+	_ = reflector.SetRequest(&opParticipantList, new(pullReqRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&opParticipantList, []types.PullReqParticipant{}, http.StatusOK)
+	_ = reflector.SetJSONResponse(&opParticipantList, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opParticipantList, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opParticipantList, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opParticipantList, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/participants", opParticipantList)
+
+	opFileStats := openapi3.Operation{}
+	opFileStats.WithTags("pullreq")
+	opFileStats.WithMapOfAnything(map[string]interface{}{"operationId": "fileStatsPullReq"})
+	_ = reflector.SetRequest(&opFileStats, new(pullReqRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&opFileStats, new(types.PullReqFileStats), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opFileStats, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opFileStats, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opFileStats, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opFileStats, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/file-stats", opFileStats)
+
+	opDependencyList := openapi3.Operation{}
+	opDependencyList.WithTags("pullreq")
+	opDependencyList.WithMapOfAnything(map[string]interface{}{"operationId": "listPullReqDependencies"})
+	_ = reflector.SetRequest(&opDependencyList, new(pullReqRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&opDependencyList, new(types.PullReqDependencies), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opDependencyList, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opDependencyList, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opDependencyList, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opDependencyList, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/dependencies", opDependencyList)
+
+	opDependencyAdd := openapi3.Operation{}
+	opDependencyAdd.WithTags("pullreq")
+	opDependencyAdd.WithMapOfAnything(map[string]interface{}{"operationId": "addPullReqDependency"})
+	_ = reflector.SetRequest(&opDependencyAdd, new(dependencyAddPullReqRequest), http.MethodPost)
+	_ = reflector.SetJSONResponse(&opDependencyAdd, new(types.PullReqDependencies), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opDependencyAdd, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opDependencyAdd, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opDependencyAdd, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opDependencyAdd, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opDependencyAdd, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.SetJSONResponse(&opDependencyAdd, new(usererror.Error), http.StatusConflict)
+	_ = reflector.Spec.AddOperation(http.MethodPost,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/dependencies", opDependencyAdd)
+
+	opDependencyDelete := openapi3.Operation{}
+	opDependencyDelete.WithTags("pullreq")
+	opDependencyDelete.WithMapOfAnything(map[string]interface{}{"operationId": "deletePullReqDependency"})
+	_ = reflector.SetRequest(&opDependencyDelete, new(dependencyDeletePullReqRequest), http.MethodDelete)
+	_ = reflector.SetJSONResponse(&opDependencyDelete, nil, http.StatusNoContent)
+	_ = reflector.SetJSONResponse(&opDependencyDelete, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opDependencyDelete, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opDependencyDelete, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opDependencyDelete, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodDelete,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/dependencies/{pullreq_dependency_number}", opDependencyDelete)
+
 	fileViewAdd := openapi3.Operation{}
 	fileViewAdd.WithTags("pullreq")
 	fileViewAdd.WithMapOfAnything(map[string]interface{}{"operationId": "fileViewAddPullReq"})
@@ -768,6 +1107,84 @@ func pullReqOperations(reflector *openapi3.Reflector) {
 	_ = reflector.Spec.AddOperation(http.MethodDelete,
 		"/repos/{repo_ref}/pullreq/{pullreq_number}/file-views/{file_path}", fileViewDelete)
 
+	reactionList := openapi3.Operation{}
+	reactionList.WithTags("pullreq")
+	reactionList.WithMapOfAnything(map[string]interface{}{"operationId": "reactionListPullReq"})
+	_ = reflector.SetRequest(&reactionList, new(pullReqRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&reactionList, new([]types.PullReqReactionSummary), http.StatusOK)
+	_ = reflector.SetJSONResponse(&reactionList, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&reactionList, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&reactionList, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&reactionList, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&reactionList, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/reactions", reactionList)
+
+	reactionAdd := openapi3.Operation{}
+	reactionAdd.WithTags("pullreq")
+	reactionAdd.WithMapOfAnything(map[string]interface{}{"operationId": "reactionAddPullReq"})
+	_ = reflector.SetRequest(&reactionAdd, new(reactionAddPullReqRequest), http.MethodPut)
+	_ = reflector.SetJSONResponse(&reactionAdd, new([]types.PullReqReactionSummary), http.StatusOK)
+	_ = reflector.SetJSONResponse(&reactionAdd, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&reactionAdd, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&reactionAdd, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&reactionAdd, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&reactionAdd, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodPut,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/reactions", reactionAdd)
+
+	reactionDelete := openapi3.Operation{}
+	reactionDelete.WithTags("pullreq")
+	reactionDelete.WithMapOfAnything(map[string]interface{}{"operationId": "reactionDeletePullReq"})
+	_ = reflector.SetRequest(&reactionDelete, new(reactionDeletePullReqRequest), http.MethodDelete)
+	_ = reflector.SetJSONResponse(&reactionDelete, nil, http.StatusNoContent)
+	_ = reflector.SetJSONResponse(&reactionDelete, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&reactionDelete, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&reactionDelete, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&reactionDelete, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&reactionDelete, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodDelete,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/reactions/{pullreq_reaction}", reactionDelete)
+
+	commentReactionAdd := openapi3.Operation{}
+	commentReactionAdd.WithTags("pullreq")
+	commentReactionAdd.WithMapOfAnything(map[string]interface{}{"operationId": "commentReactionAddPullReq"})
+	_ = reflector.SetRequest(&commentReactionAdd, new(commentReactionAddPullReqRequest), http.MethodPut)
+	_ = reflector.SetJSONResponse(&commentReactionAdd, new([]types.PullReqReactionSummary), http.StatusOK)
+	_ = reflector.SetJSONResponse(&commentReactionAdd, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&commentReactionAdd, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&commentReactionAdd, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&commentReactionAdd, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&commentReactionAdd, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodPut,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/comments/{pullreq_comment_id}/reactions", commentReactionAdd)
+
+	commentReactionDelete := openapi3.Operation{}
+	commentReactionDelete.WithTags("pullreq")
+	commentReactionDelete.WithMapOfAnything(map[string]interface{}{"operationId": "commentReactionDeletePullReq"})
+	_ = reflector.SetRequest(&commentReactionDelete, new(commentReactionDeletePullReqRequest), http.MethodDelete)
+	_ = reflector.SetJSONResponse(&commentReactionDelete, nil, http.StatusNoContent)
+	_ = reflector.SetJSONResponse(&commentReactionDelete, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&commentReactionDelete, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&commentReactionDelete, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&commentReactionDelete, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&commentReactionDelete, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodDelete,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/comments/{pullreq_comment_id}/reactions/{pullreq_reaction}", commentReactionDelete)
+
+	commentRevisionList := openapi3.Operation{}
+	commentRevisionList.WithTags("pullreq")
+	commentRevisionList.WithMapOfAnything(map[string]interface{}{"operationId": "commentRevisionListPullReq"})
+	_ = reflector.SetRequest(&commentRevisionList, new(pullReqCommentRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&commentRevisionList, []types.PullReqCommentRevision{}, http.StatusOK)
+	_ = reflector.SetJSONResponse(&commentRevisionList, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&commentRevisionList, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&commentRevisionList, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&commentRevisionList, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&commentRevisionList, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/comments/{pullreq_comment_id}/revisions", commentRevisionList)
+
 	codeOwners := openapi3.Operation{}
 	codeOwners.WithTags("pullreq")
 	codeOwners.WithMapOfAnything(map[string]interface{}{"operationId": "codeownersPullReq"})
@@ -782,6 +1199,19 @@ func pullReqOperations(reflector *openapi3.Reflector) {
 	_ = reflector.Spec.AddOperation(http.MethodGet,
 		"/repos/{repo_ref}/pullreq/{pullreq_number}/codeowners", codeOwners)
 
+	templates := openapi3.Operation{}
+	templates.WithTags("pullreq")
+	templates.WithMapOfAnything(map[string]interface{}{"operationId": "templatesPullReq"})
+	_ = reflector.SetRequest(&templates, new(templatesPullReqRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&templates, new([]types.PullReqTemplate), http.StatusOK)
+	_ = reflector.SetJSONResponse(&templates, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&templates, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&templates, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&templates, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&templates, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet,
+		"/repos/{repo_ref}/pullreq/templates", templates)
+
 	opDiff := openapi3.Operation{}
 	opDiff.WithTags("pullreq")
 	opDiff.WithMapOfAnything(map[string]interface{}{"operationId": "diffPullReq"})
@@ -806,6 +1236,47 @@ func pullReqOperations(reflector *openapi3.Reflector) {
 	panicOnErr(reflector.SetJSONResponse(&opPostDiff, new(usererror.Error), http.StatusNotFound))
 	panicOnErr(reflector.Spec.AddOperation(http.MethodPost, "/repos/{repo_ref}/pullreq/{pullreq_number}/diff", opPostDiff))
 
+	opCommitDiff := openapi3.Operation{}
+	opCommitDiff.WithTags("pullreq")
+	opCommitDiff.WithMapOfAnything(map[string]interface{}{"operationId": "commitDiffPullReq"})
+	panicOnErr(reflector.SetRequest(&opCommitDiff, new(getPRCommitDiffRequest), http.MethodGet))
+	panicOnErr(reflector.SetStringResponse(&opCommitDiff, http.StatusOK, "text/plain"))
+	panicOnErr(reflector.SetJSONResponse(&opCommitDiff, new([]git.FileDiff), http.StatusOK))
+	panicOnErr(reflector.SetJSONResponse(&opCommitDiff, new(usererror.Error), http.StatusBadRequest))
+	panicOnErr(reflector.SetJSONResponse(&opCommitDiff, new(usererror.Error), http.StatusInternalServerError))
+	panicOnErr(reflector.SetJSONResponse(&opCommitDiff, new(usererror.Error), http.StatusUnauthorized))
+	panicOnErr(reflector.SetJSONResponse(&opCommitDiff, new(usererror.Error), http.StatusForbidden))
+	panicOnErr(reflector.SetJSONResponse(&opCommitDiff, new(usererror.Error), http.StatusNotFound))
+	panicOnErr(reflector.Spec.AddOperation(http.MethodGet,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/commits/{commit_sha}/diff", opCommitDiff))
+
+	opPostCommitDiff := openapi3.Operation{}
+	opPostCommitDiff.WithTags("pullreq")
+	opPostCommitDiff.WithMapOfAnything(map[string]interface{}{"operationId": "commitDiffPullReqPost"})
+	panicOnErr(reflector.SetRequest(&opPostCommitDiff, new(postPRCommitDiffRequest), http.MethodPost))
+	panicOnErr(reflector.SetStringResponse(&opPostCommitDiff, http.StatusOK, "text/plain"))
+	panicOnErr(reflector.SetJSONResponse(&opPostCommitDiff, new([]git.FileDiff), http.StatusOK))
+	panicOnErr(reflector.SetJSONResponse(&opPostCommitDiff, new(usererror.Error), http.StatusBadRequest))
+	panicOnErr(reflector.SetJSONResponse(&opPostCommitDiff, new(usererror.Error), http.StatusInternalServerError))
+	panicOnErr(reflector.SetJSONResponse(&opPostCommitDiff, new(usererror.Error), http.StatusUnauthorized))
+	panicOnErr(reflector.SetJSONResponse(&opPostCommitDiff, new(usererror.Error), http.StatusForbidden))
+	panicOnErr(reflector.SetJSONResponse(&opPostCommitDiff, new(usererror.Error), http.StatusNotFound))
+	panicOnErr(reflector.Spec.AddOperation(http.MethodPost,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/commits/{commit_sha}/diff", opPostCommitDiff))
+
+	opDiffManifest := openapi3.Operation{}
+	opDiffManifest.WithTags("pullreq")
+	opDiffManifest.WithMapOfAnything(map[string]interface{}{"operationId": "diffManifestPullReq"})
+	opDiffManifest.WithParameters(QueryParameterPage, QueryParameterLimit)
+	panicOnErr(reflector.SetRequest(&opDiffManifest, new(pullReqRequest), http.MethodGet))
+	panicOnErr(reflector.SetJSONResponse(&opDiffManifest, new([]types.CommitFileStats), http.StatusOK))
+	panicOnErr(reflector.SetJSONResponse(&opDiffManifest, new(usererror.Error), http.StatusInternalServerError))
+	panicOnErr(reflector.SetJSONResponse(&opDiffManifest, new(usererror.Error), http.StatusUnauthorized))
+	panicOnErr(reflector.SetJSONResponse(&opDiffManifest, new(usererror.Error), http.StatusForbidden))
+	panicOnErr(reflector.SetJSONResponse(&opDiffManifest, new(usererror.Error), http.StatusNotFound))
+	panicOnErr(reflector.Spec.AddOperation(http.MethodGet,
+		"/repos/{repo_ref}/pullreq/{pullreq_number}/diff-manifest", opDiffManifest))
+
 	opChecks := openapi3.Operation{}
 	opChecks.WithTags("pullreq")
 	opChecks.WithMapOfAnything(map[string]interface{}{"operationId": "checksPullReq"})
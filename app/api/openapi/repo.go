@@ -159,14 +159,21 @@ type deleteTagRequest struct {
 	TagName string `path:"tag_name"`
 }
 
+type diffOptionsRequest struct {
+	IgnoreWhitespace string `query:"ignore_whitespace" enum:"all,change,eol" description:"ignore whitespace changes"`
+	ContextLines     int    `query:"context_lines" description:"number of context lines around each change (defaults to 3)"`
+}
+
 type getRawDiffRequest struct {
 	repoRequest
+	diffOptionsRequest
 	Range string   `path:"range" example:"main..dev"`
 	Path  []string `query:"path" description:"provide path for diff operation"`
 }
 
 type postRawDiffRequest struct {
 	repoRequest
+	diffOptionsRequest
 	gittypes.FileDiffRequests
 	Range string `path:"range" example:"main..dev"`
 }
@@ -220,6 +227,11 @@ type generalSettingsRequest struct {
 	reposettings.GeneralSettings
 }
 
+type reviewerSettingsRequest struct {
+	repoRequest
+	reposettings.ReviewerSettings
+}
+
 type archiveRequest struct {
 	repoRequest
 	GitRef string `path:"git_ref" required:"true"`
@@ -297,6 +309,34 @@ var queryParameterUntil = openapi3.ParameterOrRef{
 	},
 }
 
+var queryParameterAnalyticsSince = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name:        request.QueryParamSince,
+		In:          openapi3.ParameterInQuery,
+		Description: ptr.String("Epoch (in milliseconds) of the start of the time range. Defaults to 30 days ago."),
+		Required:    ptr.Bool(false),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type: ptrSchemaType(openapi3.SchemaTypeInteger),
+			},
+		},
+	},
+}
+
+var queryParameterAnalyticsUntil = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name:        request.QueryParamUntil,
+		In:          openapi3.ParameterInQuery,
+		Description: ptr.String("Epoch (in milliseconds) of the end of the time range. Defaults to now."),
+		Required:    ptr.Bool(false),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type: ptrSchemaType(openapi3.SchemaTypeInteger),
+			},
+		},
+	},
+}
+
 var queryParameterIncludeCommit = openapi3.ParameterOrRef{
 	Parameter: &openapi3.Parameter{
 		Name:        request.QueryParamIncludeCommit,
@@ -1207,6 +1247,35 @@ func repoOperations(reflector *openapi3.Reflector) {
 	_ = reflector.Spec.AddOperation(
 		http.MethodGet, "/repos/{repo_ref}/settings/general", opSettingsGeneralFind)
 
+	opSettingsReviewerUpdate := openapi3.Operation{}
+	opSettingsReviewerUpdate.WithTags("repository")
+	opSettingsReviewerUpdate.WithMapOfAnything(
+		map[string]interface{}{"operationId": "updateReviewerSettings"})
+	_ = reflector.SetRequest(
+		&opSettingsReviewerUpdate, new(reviewerSettingsRequest), http.MethodPatch)
+	_ = reflector.SetJSONResponse(&opSettingsReviewerUpdate, new(reposettings.ReviewerSettings), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opSettingsReviewerUpdate, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opSettingsReviewerUpdate, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opSettingsReviewerUpdate, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opSettingsReviewerUpdate, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opSettingsReviewerUpdate, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(
+		http.MethodPatch, "/repos/{repo_ref}/settings/reviewers", opSettingsReviewerUpdate)
+
+	opSettingsReviewerFind := openapi3.Operation{}
+	opSettingsReviewerFind.WithTags("repository")
+	opSettingsReviewerFind.WithMapOfAnything(
+		map[string]interface{}{"operationId": "findReviewerSettings"})
+	_ = reflector.SetRequest(&opSettingsReviewerFind, new(repoRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&opSettingsReviewerFind, new(reposettings.ReviewerSettings), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opSettingsReviewerFind, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opSettingsReviewerFind, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opSettingsReviewerFind, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opSettingsReviewerFind, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opSettingsReviewerFind, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(
+		http.MethodGet, "/repos/{repo_ref}/settings/reviewers", opSettingsReviewerFind)
+
 	opArchive := openapi3.Operation{}
 	opArchive.WithTags("repository")
 	opArchive.WithMapOfAnything(map[string]interface{}{"operationId": "archive"})
@@ -1241,6 +1310,20 @@ func repoOperations(reflector *openapi3.Reflector) {
 	_ = reflector.SetJSONResponse(&opSummary, new(usererror.Error), http.StatusNotFound)
 	_ = reflector.Spec.AddOperation(http.MethodGet, "/repos/{repo_ref}/summary", opSummary)
 
+	opReviewAnalytics := openapi3.Operation{}
+	opReviewAnalytics.WithTags("repository")
+	opReviewAnalytics.WithMapOfAnything(
+		map[string]interface{}{"operationId": "reviewAnalytics"})
+	opReviewAnalytics.WithParameters(queryParameterAnalyticsSince, queryParameterAnalyticsUntil)
+	_ = reflector.SetRequest(&opReviewAnalytics, new(repoRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&opReviewAnalytics, new(types.ReviewAnalytics), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opReviewAnalytics, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opReviewAnalytics, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opReviewAnalytics, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opReviewAnalytics, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opReviewAnalytics, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/repos/{repo_ref}/analytics/reviews", opReviewAnalytics)
+
 	opDefineLabel := openapi3.Operation{}
 	opDefineLabel.WithTags("repository")
 	opDefineLabel.WithMapOfAnything(
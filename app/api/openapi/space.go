@@ -607,4 +607,33 @@ func spaceOperations(reflector *openapi3.Reflector) {
 	_ = reflector.SetJSONResponse(&listPullReq, new(usererror.Error), http.StatusUnauthorized)
 	_ = reflector.SetJSONResponse(&listPullReq, new(usererror.Error), http.StatusForbidden)
 	_ = reflector.Spec.AddOperation(http.MethodGet, "/spaces/{repo_ref}/pullreq", listPullReq)
+
+	searchPullReq := openapi3.Operation{}
+	searchPullReq.WithTags("space")
+	searchPullReq.WithMapOfAnything(map[string]interface{}{"operationId": "searchSpacePullReq"})
+	searchPullReq.WithParameters(
+		queryParameterSearchPullRequest, queryParameterUpdatedLt,
+		queryParameterIncludeDescription, queryParameterIncludeSubspaces,
+		QueryParameterLimit)
+	_ = reflector.SetRequest(&searchPullReq, new(listPullReqRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&searchPullReq, new([]types.PullReq), http.StatusOK)
+	_ = reflector.SetJSONResponse(&searchPullReq, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&searchPullReq, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&searchPullReq, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&searchPullReq, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/spaces/{repo_ref}/pullreq/search", searchPullReq)
+
+	opReviewAnalytics := openapi3.Operation{}
+	opReviewAnalytics.WithTags("space")
+	opReviewAnalytics.WithMapOfAnything(map[string]interface{}{"operationId": "reviewAnalyticsSpace"})
+	opReviewAnalytics.WithParameters(queryParameterAnalyticsSince, queryParameterAnalyticsUntil,
+		queryParameterIncludeSubspaces)
+	_ = reflector.SetRequest(&opReviewAnalytics, new(spaceRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&opReviewAnalytics, new(types.ReviewAnalytics), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opReviewAnalytics, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opReviewAnalytics, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.SetJSONResponse(&opReviewAnalytics, new(usererror.Error), http.StatusUnauthorized)
+	_ = reflector.SetJSONResponse(&opReviewAnalytics, new(usererror.Error), http.StatusForbidden)
+	_ = reflector.SetJSONResponse(&opReviewAnalytics, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/spaces/{space_ref}/analytics/reviews", opReviewAnalytics)
 }
@@ -31,6 +31,15 @@ type tokensRequest struct {
 	Identifier string `path:"token_identifier"`
 }
 
+type savedReplyRequest struct {
+	Identifier string `path:"saved_reply_identifier"`
+}
+
+type updateSavedReplyRequest struct {
+	savedReplyRequest
+	user.UpdateSavedReplyInput
+}
+
 var queryParameterMembershipSpaces = openapi3.ParameterOrRef{
 	Parameter: &openapi3.Parameter{
 		Name:        request.QueryParamQuery,
@@ -45,6 +54,20 @@ var queryParameterMembershipSpaces = openapi3.ParameterOrRef{
 	},
 }
 
+var queryParameterQuerySavedReplies = openapi3.ParameterOrRef{
+	Parameter: &openapi3.Parameter{
+		Name:        request.QueryParamQuery,
+		In:          openapi3.ParameterInQuery,
+		Description: ptr.String("The substring by which the saved replies are filtered by identifier or title."),
+		Required:    ptr.Bool(false),
+		Schema: &openapi3.SchemaOrRef{
+			Schema: &openapi3.Schema{
+				Type: ptrSchemaType(openapi3.SchemaTypeString),
+			},
+		},
+	},
+}
+
 var queryParameterSortMembershipSpaces = openapi3.ParameterOrRef{
 	Parameter: &openapi3.Parameter{
 		Name:        request.QueryParamSort,
@@ -110,6 +133,24 @@ func buildUser(reflector *openapi3.Reflector) {
 	_ = reflector.SetJSONResponse(&opUpdate, new(usererror.Error), http.StatusInternalServerError)
 	_ = reflector.Spec.AddOperation(http.MethodPatch, "/user", opUpdate)
 
+	opFindNotificationSettings := openapi3.Operation{}
+	opFindNotificationSettings.WithTags("user")
+	opFindNotificationSettings.WithMapOfAnything(map[string]interface{}{"operationId": "getNotificationSettings"})
+	_ = reflector.SetRequest(&opFindNotificationSettings, nil, http.MethodGet)
+	_ = reflector.SetJSONResponse(&opFindNotificationSettings, new(types.NotificationSettings), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opFindNotificationSettings, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/user/notification-settings", opFindNotificationSettings)
+
+	opUpdateNotificationSettings := openapi3.Operation{}
+	opUpdateNotificationSettings.WithTags("user")
+	opUpdateNotificationSettings.WithMapOfAnything(
+		map[string]interface{}{"operationId": "updateNotificationSettings"})
+	_ = reflector.SetRequest(&opUpdateNotificationSettings, new(types.NotificationSettingsUpdate), http.MethodPatch)
+	_ = reflector.SetJSONResponse(&opUpdateNotificationSettings, new(types.NotificationSettings), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opUpdateNotificationSettings, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opUpdateNotificationSettings, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.Spec.AddOperation(http.MethodPatch, "/user/notification-settings", opUpdateNotificationSettings)
+
 	opMemberSpaces := openapi3.Operation{}
 	opMemberSpaces.WithTags("user")
 	opMemberSpaces.WithMapOfAnything(map[string]interface{}{"operationId": "membershipSpaces"})
@@ -153,6 +194,56 @@ func buildUser(reflector *openapi3.Reflector) {
 	_ = reflector.SetJSONResponse(&opKeyList, new(usererror.Error), http.StatusInternalServerError)
 	_ = reflector.Spec.AddOperation(http.MethodGet, "/user/keys", opKeyList)
 
+	opSavedReplyCreate := openapi3.Operation{}
+	opSavedReplyCreate.WithTags("user")
+	opSavedReplyCreate.WithMapOfAnything(map[string]interface{}{"operationId": "createSavedReply"})
+	_ = reflector.SetRequest(&opSavedReplyCreate, new(user.CreateSavedReplyInput), http.MethodPost)
+	_ = reflector.SetJSONResponse(&opSavedReplyCreate, new(types.SavedReply), http.StatusCreated)
+	_ = reflector.SetJSONResponse(&opSavedReplyCreate, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opSavedReplyCreate, new(usererror.Error), http.StatusConflict)
+	_ = reflector.SetJSONResponse(&opSavedReplyCreate, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.Spec.AddOperation(http.MethodPost, "/user/saved-replies", opSavedReplyCreate)
+
+	opSavedReplyFind := openapi3.Operation{}
+	opSavedReplyFind.WithTags("user")
+	opSavedReplyFind.WithMapOfAnything(map[string]interface{}{"operationId": "findSavedReply"})
+	_ = reflector.SetRequest(&opSavedReplyFind, new(savedReplyRequest), http.MethodGet)
+	_ = reflector.SetJSONResponse(&opSavedReplyFind, new(types.SavedReply), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opSavedReplyFind, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.SetJSONResponse(&opSavedReplyFind, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/user/saved-replies/{saved_reply_identifier}", opSavedReplyFind)
+
+	opSavedReplyUpdate := openapi3.Operation{}
+	opSavedReplyUpdate.WithTags("user")
+	opSavedReplyUpdate.WithMapOfAnything(map[string]interface{}{"operationId": "updateSavedReply"})
+	_ = reflector.SetRequest(&opSavedReplyUpdate, new(updateSavedReplyRequest), http.MethodPatch)
+	_ = reflector.SetJSONResponse(&opSavedReplyUpdate, new(types.SavedReply), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opSavedReplyUpdate, new(usererror.Error), http.StatusBadRequest)
+	_ = reflector.SetJSONResponse(&opSavedReplyUpdate, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.SetJSONResponse(&opSavedReplyUpdate, new(usererror.Error), http.StatusConflict)
+	_ = reflector.SetJSONResponse(&opSavedReplyUpdate, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.Spec.AddOperation(http.MethodPatch,
+		"/user/saved-replies/{saved_reply_identifier}", opSavedReplyUpdate)
+
+	opSavedReplyDelete := openapi3.Operation{}
+	opSavedReplyDelete.WithTags("user")
+	opSavedReplyDelete.WithMapOfAnything(map[string]interface{}{"operationId": "deleteSavedReply"})
+	_ = reflector.SetRequest(&opSavedReplyDelete, new(savedReplyRequest), http.MethodDelete)
+	_ = reflector.SetJSONResponse(&opSavedReplyDelete, nil, http.StatusNoContent)
+	_ = reflector.SetJSONResponse(&opSavedReplyDelete, new(usererror.Error), http.StatusNotFound)
+	_ = reflector.SetJSONResponse(&opSavedReplyDelete, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.Spec.AddOperation(http.MethodDelete,
+		"/user/saved-replies/{saved_reply_identifier}", opSavedReplyDelete)
+
+	opSavedReplyList := openapi3.Operation{}
+	opSavedReplyList.WithTags("user")
+	opSavedReplyList.WithMapOfAnything(map[string]interface{}{"operationId": "listSavedReplies"})
+	opSavedReplyList.WithParameters(QueryParameterPage, QueryParameterLimit, queryParameterQuerySavedReplies)
+	_ = reflector.SetRequest(&opSavedReplyList, struct{}{}, http.MethodGet)
+	_ = reflector.SetJSONResponse(&opSavedReplyList, new([]types.SavedReply), http.StatusOK)
+	_ = reflector.SetJSONResponse(&opSavedReplyList, new(usererror.Error), http.StatusInternalServerError)
+	_ = reflector.Spec.AddOperation(http.MethodGet, "/user/saved-replies", opSavedReplyList)
+
 	opListTokens := openapi3.Operation{}
 	opListTokens.WithTags("user")
 	opListTokens.WithMapOfAnything(map[string]interface{}{"operationId": "listTokens"})
@@ -22,6 +22,7 @@ import (
 
 	"github.com/harness/gitness/app/api/usererror"
 	gittypes "github.com/harness/gitness/git/api"
+	gitenum "github.com/harness/gitness/git/enum"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
 )
@@ -44,6 +45,9 @@ const (
 	QueryParamInternal           = "internal"
 	QueryParamService            = "service"
 	QueryParamCommitSHA          = "commit_sha"
+	QueryParamIgnoreWhitespace   = "ignore_whitespace"
+	QueryParamContextLines       = "context_lines"
+	QueryParamFromCommitSHA      = "from_commit_sha"
 )
 
 func GetGitRefFromQueryOrDefault(r *http.Request, deflt string) string {
@@ -176,6 +180,29 @@ func GetFileDiffFromQuery(r *http.Request) (files gittypes.FileDiffRequests) {
 	return
 }
 
+// GetDiffOptionsFromQuery extracts the diff generation options from the query.
+func GetDiffOptionsFromQuery(r *http.Request) (gittypes.DiffOptions, error) {
+	// context lines are optional, zero context lines is a valid value (e.g. for the equivalent of git diff -U0).
+	var contextLines *int
+	if s, ok := QueryParam(r, QueryParamContextLines); ok {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			return gittypes.DiffOptions{}, usererror.BadRequestf(
+				"Parameter '%s' must be a non-negative integer.", QueryParamContextLines)
+		}
+		contextLines = &n
+	}
+
+	return gittypes.DiffOptions{
+		IgnoreWhitespace: gitenum.DiffIgnoreWhitespace(QueryParamOrDefault(r, QueryParamIgnoreWhitespace, "")),
+		ContextLines:     contextLines,
+	}, nil
+}
+
+func GetFromCommitSHAFromQuery(r *http.Request) string {
+	return QueryParamOrDefault(r, QueryParamFromCommitSHA, "")
+}
+
 func GetCommitSHAFromQueryOrDefault(r *http.Request) string {
 	return QueryParamOrDefault(r, QueryParamCommitSHA, "")
 }
@@ -26,6 +26,7 @@ const (
 	PathParamTriggerIdentifier  = "trigger_identifier"
 	QueryParamLatest            = "latest"
 	QueryParamBranch            = "branch"
+	QueryParamBadgeStyle        = "style"
 )
 
 func GetPipelineIdentifierFromPath(r *http.Request) (string, error) {
@@ -36,6 +37,10 @@ func GetBranchFromQuery(r *http.Request) string {
 	return QueryParamOrDefault(r, QueryParamBranch, "")
 }
 
+func GetBadgeStyleFromQuery(r *http.Request) string {
+	return QueryParamOrDefault(r, QueryParamBadgeStyle, "")
+}
+
 func GetExecutionNumberFromPath(r *http.Request) (int64, error) {
 	return PathParamAsPositiveInt64(r, PathParamExecutionNumber)
 }
@@ -28,6 +28,9 @@ const (
 	PathParamPullReqCommentID = "pullreq_comment_id"
 	PathParamReviewerID       = "pullreq_reviewer_id"
 	PathParamUserGroupID      = "user_group_id"
+	PathParamPullReqReaction  = "pullreq_reaction"
+
+	PathParamPullReqDependencyNumber = "pullreq_dependency_number"
 
 	QueryParamAuthorID           = "author_id"
 	QueryParamCommenterID        = "commenter_id"
@@ -35,6 +38,9 @@ const (
 	QueryParamReviewDecision     = "review_decision"
 	QueryParamMentionedID        = "mentioned_id"
 	QueryParamIncludeDescription = "include_description"
+	QueryParamCommentStatus      = "comment_status"
+	QueryParamAfterID            = "after_id"
+	QueryParamExportFormat       = "format"
 )
 
 func GetPullReqNumberFromPath(r *http.Request) (int64, error) {
@@ -48,10 +54,33 @@ func GetUserGroupIDFromPath(r *http.Request) (int64, error) {
 	return PathParamAsPositiveInt64(r, PathParamUserGroupID)
 }
 
+func GetPullReqDependencyNumberFromPath(r *http.Request) (int64, error) {
+	return PathParamAsPositiveInt64(r, PathParamPullReqDependencyNumber)
+}
+
 func GetPullReqCommentIDPath(r *http.Request) (int64, error) {
 	return PathParamAsPositiveInt64(r, PathParamPullReqCommentID)
 }
 
+func GetPullReqReactionFromPath(r *http.Request) (enum.PullReqReaction, error) {
+	s, err := PathParamOrError(r, PathParamPullReqReaction)
+	if err != nil {
+		return "", err
+	}
+
+	reaction, ok := enum.PullReqReaction(s).Sanitize()
+	if !ok {
+		return "", errors.InvalidArgument("Invalid value provided for reaction")
+	}
+
+	return reaction, nil
+}
+
+// GetPullReqExportFormatFromQuery extracts the pull request export format from the url.
+func GetPullReqExportFormatFromQuery(r *http.Request) enum.PullReqExportFormat {
+	return enum.PullReqExportFormat(r.URL.Query().Get(QueryParamExportFormat))
+}
+
 // ParseSortPullReq extracts the pull request sort parameter from the url.
 func ParseSortPullReq(r *http.Request) enum.PullReqSort {
 	result, _ := enum.PullReqSort(r.URL.Query().Get(QueryParamSort)).Sanitize()
@@ -187,6 +216,11 @@ func ParsePullReqActivityFilter(r *http.Request) (*types.PullReqActivityFilter,
 	if err != nil {
 		return nil, err
 	}
+	// after ID is optional, skipped if set to 0
+	afterID, err := QueryParamAsPositiveInt64OrDefault(r, QueryParamAfterID, 0)
+	if err != nil {
+		return nil, err
+	}
 	// before is optional, skipped if set to 0
 	before, err := QueryParamAsPositiveInt64OrDefault(r, QueryParamBefore, 0)
 	if err != nil {
@@ -197,12 +231,30 @@ func ParsePullReqActivityFilter(r *http.Request) (*types.PullReqActivityFilter,
 	if err != nil {
 		return nil, err
 	}
+	// author is optional, skipped if set to 0
+	authorID, err := QueryParamAsPositiveInt64OrDefault(r, QueryParamAuthorID, 0)
+	if err != nil {
+		return nil, err
+	}
+	// comment status is optional, skipped if empty
+	var commentStatus enum.PullReqCommentStatus
+	if s := QueryParamOrDefault(r, QueryParamCommentStatus, ""); s != "" {
+		var ok bool
+		if commentStatus, ok = enum.PullReqCommentStatus(s).Sanitize(); !ok {
+			return nil, errors.InvalidArgument("Invalid value for the comment_status query parameter.")
+		}
+	}
+
 	return &types.PullReqActivityFilter{
-		After:  after,
-		Before: before,
-		Limit:  int(limit),
-		Types:  parsePullReqActivityTypes(r),
-		Kinds:  parsePullReqActivityKinds(r),
+		After:         after,
+		AfterID:       afterID,
+		Before:        before,
+		Limit:         int(limit),
+		Types:         parsePullReqActivityTypes(r),
+		Kinds:         parsePullReqActivityKinds(r),
+		AuthorID:      authorID,
+		CommentStatus: commentStatus,
+		Path:          QueryParamOrDefault(r, QueryParamPath, ""),
 	}, nil
 }
 
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"slices"
 
 	"github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/livelog"
@@ -101,9 +102,15 @@ func (e *embedded) Detail(ctx context.Context, stage *drone.Stage) (*client.Cont
 		return nil, err
 	}
 
+	repo := ConvertToDroneRepo(details.Repo, details.RepoIsPublic)
+	if e.config.CI.ExecutionTimeout > 0 {
+		repo.Timeout = toDroneTimeout(e.config.CI.ExecutionTimeout)
+	}
+	repo.Trusted = e.isTrusted(details.Repo)
+
 	return &client.Context{
 		Build:   ConvertToDroneBuild(details.Execution),
-		Repo:    ConvertToDroneRepo(details.Repo, details.RepoIsPublic),
+		Repo:    repo,
 		Stage:   ConvertToDroneStage(details.Stage),
 		Secrets: ConvertToDroneSecrets(details.Secrets),
 		Config:  ConvertToDroneFile(details.Config),
@@ -115,6 +122,15 @@ func (e *embedded) Detail(ctx context.Context, stage *drone.Stage) (*client.Cont
 	}, nil
 }
 
+// isTrusted returns true in case pipelines of the repo are allowed to run privileged containers,
+// mount host volumes and use extra capabilities.
+func (e *embedded) isTrusted(repo *types.Repository) bool {
+	if e.config.CI.TrustAllRepos {
+		return true
+	}
+	return slices.Contains(e.config.CI.TrustedRepos, repo.Path)
+}
+
 // Update updates the build stage.
 func (e *embedded) Update(ctx context.Context, stage *drone.Stage) error {
 	var err error
@@ -26,6 +26,9 @@ import (
 	"github.com/drone/runner-go/client"
 )
 
+// defaultExecutionTimeout is used in case no execution timeout is configured.
+const defaultExecutionTimeout = 10 * time.Hour
+
 func ConvertToDroneStage(stage *types.Stage) *drone.Stage {
 	return &drone.Stage{
 		ID:        stage.ID,
@@ -210,7 +213,7 @@ func ConvertToDroneBuild(execution *types.Execution) *drone.Build {
 func ConvertToDroneRepo(repo *types.Repository, repoIsPublic bool) *drone.Repo {
 	return &drone.Repo{
 		ID:        repo.ID,
-		Trusted:   true, // as builds are running on user machines, the repo is marked trusted.
+		Trusted:   true, // as builds are running on user machines, the repo is marked trusted by default.
 		UID:       repo.Identifier,
 		UserID:    repo.CreatedBy,
 		Namespace: repo.Path,
@@ -222,12 +225,18 @@ func ConvertToDroneRepo(repo *types.Repository, repoIsPublic bool) *drone.Repo {
 		Updated:   repo.Updated,
 		Version:   repo.Version,
 		Branch:    repo.DefaultBranch,
-		// TODO: We can get this from configuration once we start populating it.
 		// If this is not set drone runner cancels the build.
-		Timeout: int64((10 * time.Hour).Seconds()),
+		// The embedded client overrides it with the configured execution timeout.
+		Timeout: toDroneTimeout(defaultExecutionTimeout),
 	}
 }
 
+// toDroneTimeout converts the duration into a drone repo timeout.
+// Drone expects the timeout in minutes.
+func toDroneTimeout(d time.Duration) int64 {
+	return int64(d / time.Minute)
+}
+
 func ConvertToDroneFile(file *file.File) *client.File {
 	return &client.File{
 		Data: file.Data,
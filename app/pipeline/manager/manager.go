@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/harness/gitness/app/bootstrap"
@@ -145,6 +146,7 @@ type Manager struct {
 	Repos     store.RepoStore
 	Scheduler scheduler.Scheduler
 	Secrets   store.SecretStore
+	Spaces    store.SpaceStore
 	// Status  store.StatusService
 	Stages store.StageStore
 	Steps  store.StepStore
@@ -171,6 +173,7 @@ func New(
 	repoStore store.RepoStore,
 	scheduler scheduler.Scheduler,
 	secretStore store.SecretStore,
+	spaceStore store.SpaceStore,
 	stageStore store.StageStore,
 	stepStore store.StepStore,
 	userStore store.PrincipalStore,
@@ -191,6 +194,7 @@ func New(
 		Repos:            repoStore,
 		Scheduler:        scheduler,
 		Secrets:          secretStore,
+		Spaces:           spaceStore,
 		Stages:           stageStore,
 		Steps:            stepStore,
 		Users:            userStore,
@@ -326,9 +330,7 @@ func (m *Manager) Details(ctx context.Context, stageID int64) (*ExecutionContext
 		Str("repo", repo.GetGitUID()).
 		Logger()
 
-	// TODO: Currently we fetch all the secrets from the same space.
-	// This logic can be updated when needed.
-	secrets, err := m.Secrets.ListAll(noContext, repo.ParentID)
+	secrets, err := m.listSecrets(noContext, repo.ParentID)
 	if err != nil {
 		log.Warn().Err(err).Msg("manager: cannot list secrets")
 		return nil, err
@@ -379,6 +381,41 @@ func (m *Manager) Details(ctx context.Context, stageID int64) (*ExecutionContext
 	}, nil
 }
 
+// listSecrets returns the secrets of the space and all its ancestors.
+// Secrets defined closer to the repository take precedence over secrets with the same identifier
+// defined in an ancestor space.
+func (m *Manager) listSecrets(ctx context.Context, spaceID int64) ([]*types.Secret, error) {
+	ancestors, err := m.Spaces.GetAncestorsData(ctx, spaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get space ancestors: %w", err)
+	}
+
+	parentIDs := make(map[int64]int64, len(ancestors))
+	for _, ancestor := range ancestors {
+		parentIDs[ancestor.ID] = ancestor.ParentID
+	}
+
+	var secrets []*types.Secret
+	seen := make(map[string]struct{})
+	for id := spaceID; id != 0; id = parentIDs[id] {
+		spaceSecrets, err := m.Secrets.ListAll(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list secrets of space %d: %w", id, err)
+		}
+
+		for _, secret := range spaceSecrets {
+			key := strings.ToLower(secret.Identifier)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			secrets = append(secrets, secret)
+		}
+	}
+
+	return secrets, nil
+}
+
 func (m *Manager) createNetrc(repo *types.Repository) (*Netrc, error) {
 	pipelinePrincipal := bootstrap.NewPipelineServiceSession().Principal
 	jwt, err := jwt.GenerateWithMembership(
@@ -469,6 +506,7 @@ func (m *Manager) BeforeStage(_ context.Context, stage *types.Stage) error {
 		Steps:       m.Steps,
 		Stages:      m.Stages,
 		Users:       m.Users,
+		Reporter:    m.reporter,
 	}
 
 	return s.do(noContext, stage)
@@ -51,6 +51,7 @@ func ProvideExecutionManager(
 	repoStore store.RepoStore,
 	scheduler scheduler.Scheduler,
 	secretStore store.SecretStore,
+	spaceStore store.SpaceStore,
 	stageStore store.StageStore,
 	stepStore store.StepStore,
 	userStore store.PrincipalStore,
@@ -58,7 +59,7 @@ func ProvideExecutionManager(
 	reporter *events.Reporter,
 ) ExecutionManager {
 	return New(config, executionStore, pipelineStore, urlProvider, sseStreamer, fileService, converterService,
-		logStore, logStream, checkStore, repoStore, scheduler, secretStore,
+		logStore, logStream, checkStore, repoStore, scheduler, secretStore, spaceStore,
 		stageStore, stepStore, userStore, publicAccess, *reporter)
 }
 
@@ -28,7 +28,6 @@ import (
 	compiler2 "github.com/drone-runners/drone-runner-docker/engine2/compiler"
 	engine2 "github.com/drone-runners/drone-runner-docker/engine2/engine"
 	runtime2 "github.com/drone-runners/drone-runner-docker/engine2/runtime"
-	"github.com/drone/drone-go/drone"
 	runnerclient "github.com/drone/runner-go/client"
 	"github.com/drone/runner-go/environ/provider"
 	"github.com/drone/runner-go/pipeline/reporter/history"
@@ -77,13 +76,33 @@ func NewExecutionRunner(
 		extraHosts = []string{"host.docker.internal:host-gateway"}
 	}
 
+	// secrets declared in the yaml are either encrypted in place or fetched from the external provider.
+	secretProvider := secret.Combine(
+		secret.Encrypted(),
+		secret.External(
+			config.CI.SecretProvider.Endpoint,
+			config.CI.SecretProvider.Token,
+			config.CI.SecretProvider.SkipVerify,
+		),
+	)
+
 	compiler := &compiler.Compiler{
-		Environ:    provider.Static(map[string]string{}),
-		Registry:   registry.Static([]*drone.Registry{}),
-		Secret:     secret.Encrypted(),
-		ExtraHosts: extraHosts,
-		Privileged: Privileged,
-		Networks:   config.CI.ContainerNetworks,
+		Environ:        provider.Static(map[string]string{}),
+		Registry:       registry.File(config.CI.RegistryConfig),
+		Secret:         secretProvider,
+		ExtraHosts:     extraHosts,
+		Privileged:     Privileged,
+		Networks:       config.CI.ContainerNetworks,
+		NetrcCloneOnly: config.CI.NetrcCloneOnly,
+		Resources: compiler.Resources{
+			Memory:     config.CI.Resources.Memory,
+			MemorySwap: config.CI.Resources.MemorySwap,
+			CPUQuota:   config.CI.Resources.CPUQuota,
+			CPUPeriod:  config.CI.Resources.CPUPeriod,
+			CPUShares:  config.CI.Resources.CPUShares,
+			CPUSet:     config.CI.Resources.CPUSet,
+			ShmSize:    config.CI.Resources.ShmSize,
+		},
 	}
 
 	remote := remote.New(client)
@@ -114,12 +133,22 @@ func NewExecutionRunner(
 	exec2 := runtime2.NewExecer(tracer, remote, upload, engine2, int64(config.CI.ParallelWorkers))
 
 	compiler2 := &compiler2.CompilerImpl{
-		Environ:    provider.Static(map[string]string{}),
-		Registry:   registry.Static([]*drone.Registry{}),
-		Secret:     secret.Encrypted(),
-		ExtraHosts: extraHosts,
-		Privileged: Privileged,
-		Networks:   config.CI.ContainerNetworks,
+		Environ:        provider.Static(map[string]string{}),
+		Registry:       registry.File(config.CI.RegistryConfig),
+		Secret:         secretProvider,
+		ExtraHosts:     extraHosts,
+		Privileged:     Privileged,
+		Networks:       config.CI.ContainerNetworks,
+		NetrcCloneOnly: config.CI.NetrcCloneOnly,
+		Resources: compiler2.Resources{
+			Memory:     config.CI.Resources.Memory,
+			MemorySwap: config.CI.Resources.MemorySwap,
+			CPUQuota:   config.CI.Resources.CPUQuota,
+			CPUPeriod:  config.CI.Resources.CPUPeriod,
+			CPUShares:  config.CI.Resources.CPUShares,
+			CPUSet:     config.CI.Resources.CPUSet,
+			ShmSize:    config.CI.Resources.ShmSize,
+		},
 	}
 
 	runner := &runtime2.Runner{
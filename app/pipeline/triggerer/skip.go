@@ -41,3 +41,7 @@ func skipRepo(document *yaml.Pipeline, repo string) bool {
 func skipCron(document *yaml.Pipeline, cron string) bool {
 	return !document.Trigger.Cron.Match(cron)
 }
+
+func skipTarget(document *yaml.Pipeline, env string) bool {
+	return !document.Trigger.Target.Match(env)
+}
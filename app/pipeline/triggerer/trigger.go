@@ -69,6 +69,7 @@ type Hook struct {
 	AuthorAvatar string             `json:"author_avatar"`
 	Debug        bool               `json:"debug"`
 	Cron         string             `json:"cron"`
+	Deployment   string             `json:"deployment"`
 	Sender       string             `json:"sender"`
 	Params       map[string]string  `json:"params"`
 }
@@ -81,6 +82,7 @@ type Triggerer interface {
 }
 
 type triggerer struct {
+	config           *types.Config
 	executionStore   store.ExecutionStore
 	checkStore       store.CheckStore
 	stageStore       store.StageStore
@@ -97,6 +99,7 @@ type triggerer struct {
 }
 
 func New(
+	config *types.Config,
 	executionStore store.ExecutionStore,
 	checkStore store.CheckStore,
 	stageStore store.StageStore,
@@ -112,6 +115,7 @@ func New(
 	publicAccess publicaccess.Service,
 ) Triggerer {
 	return &triggerer{
+		config:           config,
 		executionStore:   executionStore,
 		checkStore:       checkStore,
 		stageStore:       stageStore,
@@ -150,7 +154,7 @@ func (t *triggerer) Trigger(
 		}
 	}()
 
-	event := base.Action.GetTriggerEvent()
+	event := triggerEvent(base)
 
 	repo, err := t.repoStore.Find(ctx, pipeline.RepoID)
 	if err != nil {
@@ -197,6 +201,7 @@ func (t *triggerer) Trigger(
 		Debug:        base.Debug,
 		Sender:       base.Sender,
 		Cron:         base.Cron,
+		Deploy:       base.Deployment,
 		Created:      now,
 		Updated:      now,
 	}
@@ -218,19 +223,19 @@ func (t *triggerer) Trigger(
 		file, err = t.converterService.Convert(ctx, args)
 		if err != nil {
 			log.Warn().Err(err).Msg("trigger: cannot convert from template")
-			return t.createExecutionWithError(ctx, pipeline, base, err.Error())
+			return t.createExecutionWithError(ctx, pipeline, base, event, err.Error())
 		}
 
 		manifest, err := yaml.ParseString(string(file.Data))
 		if err != nil {
 			log.Warn().Err(err).Msg("trigger: cannot parse yaml")
-			return t.createExecutionWithError(ctx, pipeline, base, err.Error())
+			return t.createExecutionWithError(ctx, pipeline, base, event, err.Error())
 		}
 
 		err = linter.Manifest(manifest, true)
 		if err != nil {
 			log.Warn().Err(err).Msg("trigger: yaml linting error")
-			return t.createExecutionWithError(ctx, pipeline, base, err.Error())
+			return t.createExecutionWithError(ctx, pipeline, base, event, err.Error())
 		}
 
 		var matched []*yaml.Pipeline
@@ -264,6 +269,8 @@ func (t *triggerer) Trigger(
 				log.Info().Str("pipeline", name).Msg("trigger: skipping pipeline, does not match repo")
 			case skipCron(pipeline, base.Cron):
 				log.Info().Str("pipeline", name).Msg("trigger: skipping pipeline, does not match cron job")
+			case skipTarget(pipeline, base.Deployment):
+				log.Info().Str("pipeline", name).Msg("trigger: skipping pipeline, does not match deploy target")
 			default:
 				matched = append(matched, pipeline)
 				node.Skip = false
@@ -271,7 +278,7 @@ func (t *triggerer) Trigger(
 		}
 
 		if dag.DetectCycles() {
-			return t.createExecutionWithError(ctx, pipeline, base, "Error: Dependency cycle detected in Pipeline")
+			return t.createExecutionWithError(ctx, pipeline, base, event, "Error: Dependency cycle detected in Pipeline")
 		}
 
 		if len(matched) == 0 {
@@ -347,6 +354,11 @@ func (t *triggerer) Trigger(
 		}
 	}
 
+	// the system wide concurrency limit per repository is applied to all stages.
+	for _, stage := range stages {
+		stage.LimitRepo = t.config.CI.RepoConcurrencyLimit
+	}
+
 	// Increment pipeline number using optimistic locking.
 	pipeline, err = t.pipelineStore.IncrementSeqNum(ctx, pipeline)
 	if err != nil {
@@ -384,6 +396,22 @@ func (t *triggerer) Trigger(
 	return execution, nil
 }
 
+// triggerEvent returns the event of the execution created for the hook.
+func triggerEvent(base *Hook) enum.TriggerEvent {
+	if base.Deployment != "" {
+		// promotions target a deployment environment and are exposed as promote events
+		// to allow pipelines to filter on them (e.g. trigger: target: [production]).
+		return enum.TriggerEventPromote
+	}
+	if base.Cron != "" {
+		// scheduled executions carry the name of the cron job and are exposed as cron events
+		// to allow pipelines to filter on them (e.g. trigger: event: [cron]).
+		return enum.TriggerEventCron
+	}
+
+	return base.Action.GetTriggerEvent()
+}
+
 func trunc(s string, i int) string {
 	runes := []rune(s)
 	if len(runes) > i {
@@ -535,6 +563,7 @@ func (t *triggerer) createExecutionWithError(
 	ctx context.Context,
 	pipeline *types.Pipeline,
 	base *Hook,
+	event enum.TriggerEvent,
 	message string,
 ) (*types.Execution, error) {
 	log := log.With().
@@ -557,7 +586,7 @@ func (t *triggerer) createExecutionWithError(
 		Parent:       base.Parent,
 		Status:       enum.CIStatusError,
 		Error:        message,
-		Event:        base.Action.GetTriggerEvent(),
+		Event:        event,
 		Action:       base.Action,
 		Link:         base.Link,
 		Title:        base.Title,
@@ -575,6 +604,8 @@ func (t *triggerer) createExecutionWithError(
 		AuthorAvatar: base.AuthorAvatar,
 		Debug:        base.Debug,
 		Sender:       base.Sender,
+		Cron:         base.Cron,
+		Deploy:       base.Deployment,
 		Created:      now,
 		Updated:      now,
 		Started:      now,
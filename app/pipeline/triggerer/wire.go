@@ -22,6 +22,7 @@ import (
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
 
 	"github.com/google/wire"
 )
@@ -33,6 +34,7 @@ var WireSet = wire.NewSet(
 
 // ProvideTriggerer provides a triggerer which can execute builds.
 func ProvideTriggerer(
+	config *types.Config,
 	executionStore store.ExecutionStore,
 	checkStore store.CheckStore,
 	stageStore store.StageStore,
@@ -47,7 +49,7 @@ func ProvideTriggerer(
 	pluginStore store.PluginStore,
 	publicAccess publicaccess.Service,
 ) Triggerer {
-	return New(executionStore, checkStore, stageStore, pipelineStore,
+	return New(config, executionStore, checkStore, stageStore, pipelineStore,
 		tx, repoStore, urlProvider, scheduler, fileService, converterService,
 		templateStore, pluginStore, publicAccess)
 }
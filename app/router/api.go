@@ -279,6 +279,8 @@ func setupSpaces(
 			r.Get("/export-progress", handlerspace.HandleExportProgress(spaceCtrl))
 			r.Post("/public-access", handlerspace.HandleUpdatePublicAccess(spaceCtrl))
 			r.Get("/pullreq", handlerspace.HandleListPullReqs(spaceCtrl))
+			r.Get("/pullreq/search", handlerspace.HandleSearchPullReqs(spaceCtrl))
+			r.Get("/analytics/reviews", handlerspace.HandleReviewAnalytics(spaceCtrl))
 
 			r.Route("/members", func(r chi.Router) {
 				r.Get("/", handlerspace.HandleMembershipList(spaceCtrl))
@@ -346,9 +348,12 @@ func setupRepos(r chi.Router,
 				r.Patch("/security", handlerreposettings.HandleSecurityUpdate(repoSettingsCtrl))
 				r.Get("/general", handlerreposettings.HandleGeneralFind(repoSettingsCtrl))
 				r.Patch("/general", handlerreposettings.HandleGeneralUpdate(repoSettingsCtrl))
+				r.Get("/reviewers", handlerreposettings.HandleReviewerFind(repoSettingsCtrl))
+				r.Patch("/reviewers", handlerreposettings.HandleReviewerUpdate(repoSettingsCtrl))
 			})
 
 			r.Get("/summary", handlerrepo.HandleSummary(repoCtrl))
+			r.Get("/analytics/reviews", handlerrepo.HandleReviewAnalytics(repoCtrl))
 
 			r.Post("/move", handlerrepo.HandleMove(repoCtrl))
 			r.Get("/service-accounts", handlerrepo.HandleListServiceAccounts(repoCtrl))
@@ -486,6 +491,7 @@ func setupPipelines(
 			r.Get("/", handlerpipeline.HandleFind(pipelineCtrl))
 			r.Patch("/", handlerpipeline.HandleUpdate(pipelineCtrl))
 			r.Delete("/", handlerpipeline.HandleDelete(pipelineCtrl))
+			r.Get("/badge", handlerpipeline.HandleBadge(pipelineCtrl))
 			setupExecutions(r, executionCtrl, logCtrl)
 			setupTriggers(r, triggerCtrl)
 		})
@@ -564,6 +570,8 @@ func setupExecutions(
 		r.Route(fmt.Sprintf("/{%s}", request.PathParamExecutionNumber), func(r chi.Router) {
 			r.Get("/", handlerexecution.HandleFind(executionCtrl))
 			r.Post("/cancel", handlerexecution.HandleCancel(executionCtrl))
+			r.Post("/rerun", handlerexecution.HandleRerun(executionCtrl))
+			r.Post("/promote", handlerexecution.HandlePromote(executionCtrl))
 			r.Delete("/", handlerexecution.HandleDelete(executionCtrl))
 			r.Get(
 				fmt.Sprintf("/logs/{%s}/{%s}",
@@ -613,11 +621,15 @@ func SetupPullReq(r chi.Router, pullreqCtrl *pullreq.Controller) {
 	r.Route("/pullreq", func(r chi.Router) {
 		r.Post("/", handlerpullreq.HandleCreate(pullreqCtrl))
 		r.Get("/", handlerpullreq.HandleList(pullreqCtrl))
+		r.Get("/templates", handlerpullreq.HandleTemplates(pullreqCtrl))
+		r.Get("/merge-queue", handlerpullreq.HandleMergeQueueList(pullreqCtrl))
 
 		r.Route(fmt.Sprintf("/{%s}", request.PathParamPullReqNumber), func(r chi.Router) {
 			r.Get("/", handlerpullreq.HandleFind(pullreqCtrl))
 			r.Patch("/", handlerpullreq.HandleUpdate(pullreqCtrl))
 			r.Post("/state", handlerpullreq.HandleState(pullreqCtrl))
+			r.Post("/lock", handlerpullreq.HandleLock(pullreqCtrl))
+			r.Post("/unlock", handlerpullreq.HandleUnlock(pullreqCtrl))
 			r.Get("/activities", handlerpullreq.HandleListActivities(pullreqCtrl))
 			r.Route("/comments", func(r chi.Router) {
 				r.Post("/", handlerpullreq.HandleCommentCreate(pullreqCtrl))
@@ -626,8 +638,20 @@ func SetupPullReq(r chi.Router, pullreqCtrl *pullreq.Controller) {
 					r.Patch("/", handlerpullreq.HandleCommentUpdate(pullreqCtrl))
 					r.Delete("/", handlerpullreq.HandleCommentDelete(pullreqCtrl))
 					r.Put("/status", handlerpullreq.HandleCommentStatus(pullreqCtrl))
+					r.Get("/revisions", handlerpullreq.HandleCommentRevisionList(pullreqCtrl))
+					r.Route("/reactions", func(r chi.Router) {
+						r.Put("/", handlerpullreq.HandleCommentReactionAdd(pullreqCtrl))
+						r.Delete(fmt.Sprintf("/{%s}", request.PathParamPullReqReaction),
+							handlerpullreq.HandleCommentReactionDelete(pullreqCtrl))
+					})
 				})
 			})
+			r.Route("/reactions", func(r chi.Router) {
+				r.Get("/", handlerpullreq.HandleReactionList(pullreqCtrl))
+				r.Put("/", handlerpullreq.HandleReactionAdd(pullreqCtrl))
+				r.Delete(fmt.Sprintf("/{%s}", request.PathParamPullReqReaction),
+					handlerpullreq.HandleReactionDelete(pullreqCtrl))
+			})
 			r.Route("/reviewers", func(r chi.Router) {
 				r.Get("/", handlerpullreq.HandleReviewerList(pullreqCtrl))
 				r.Put("/", handlerpullreq.HandleReviewerAdd(pullreqCtrl))
@@ -648,12 +672,32 @@ func SetupPullReq(r chi.Router, pullreqCtrl *pullreq.Controller) {
 				r.Post("/", handlerpullreq.HandleReviewSubmit(pullreqCtrl))
 			})
 			r.Post("/merge", handlerpullreq.HandleMerge(pullreqCtrl))
-			r.Get("/commits", handlerpullreq.HandleCommits(pullreqCtrl))
+			r.Route("/merge-queue", func(r chi.Router) {
+				r.Post("/", handlerpullreq.HandleMergeQueueAdd(pullreqCtrl))
+				r.Delete("/", handlerpullreq.HandleMergeQueueRemove(pullreqCtrl))
+			})
+			r.Route("/commits", func(r chi.Router) {
+				r.Get("/", handlerpullreq.HandleCommits(pullreqCtrl))
+				r.Get(fmt.Sprintf("/{%s}/diff", request.PathParamCommitSHA), handlerpullreq.HandleCommitDiff(pullreqCtrl))
+				r.Post(fmt.Sprintf("/{%s}/diff", request.PathParamCommitSHA), handlerpullreq.HandleCommitDiff(pullreqCtrl))
+			})
 			r.Get("/metadata", handlerpullreq.HandleMetadata(pullreqCtrl))
 			r.Route("/branch", func(r chi.Router) {
 				r.Post("/", handlerpullreq.HandleRestoreBranch(pullreqCtrl))
 				r.Delete("/", handlerpullreq.HandleDeleteBranch(pullreqCtrl))
 			})
+			r.Post("/cherry-pick", handlerpullreq.HandleCherryPick(pullreqCtrl))
+			r.Post("/revert", handlerpullreq.HandleRevert(pullreqCtrl))
+			r.Get("/export", handlerpullreq.HandleExport(pullreqCtrl))
+			r.Get("/edits", handlerpullreq.HandleEditList(pullreqCtrl))
+			r.Get("/participants", handlerpullreq.HandleParticipantList(pullreqCtrl))
+			r.Get("/file-stats", handlerpullreq.HandleFileStats(pullreqCtrl))
+			r.Route("/dependencies", func(r chi.Router) {
+				r.Get("/", handlerpullreq.HandleDependencyList(pullreqCtrl))
+				r.Post("/", handlerpullreq.HandleDependencyAdd(pullreqCtrl))
+				r.Delete(fmt.Sprintf("/{%s}", request.PathParamPullReqDependencyNumber),
+					handlerpullreq.HandleDependencyDelete(pullreqCtrl))
+			})
 
 			r.Route("/file-views", func(r chi.Router) {
 				r.Put("/", handlerpullreq.HandleFileViewAdd(pullreqCtrl))
@@ -663,6 +707,7 @@ func SetupPullReq(r chi.Router, pullreqCtrl *pullreq.Controller) {
 			r.Get("/codeowners", handlerpullreq.HandleCodeOwner(pullreqCtrl))
 			r.Get("/diff", handlerpullreq.HandleDiff(pullreqCtrl))
 			r.Post("/diff", handlerpullreq.HandleDiff(pullreqCtrl))
+			r.Get("/diff-manifest", handlerpullreq.HandleDiffManifest(pullreqCtrl))
 			r.Get("/checks", handlerpullreq.HandleCheckList(pullreqCtrl))
 
 			setupPullReqLabels(r, pullreqCtrl)
@@ -732,6 +777,8 @@ func setupUser(r chi.Router, userCtrl *user.Controller) {
 		r.Get("/", handleruser.HandleFind(userCtrl))
 		r.Patch("/", handleruser.HandleUpdate(userCtrl))
 		r.Get("/memberships", handleruser.HandleMembershipSpaces(userCtrl))
+		r.Get("/notification-settings", handleruser.HandleFindNotificationSettings(userCtrl))
+		r.Patch("/notification-settings", handleruser.HandleUpdateNotificationSettings(userCtrl))
 
 		// PAT
 		r.Route("/tokens", func(r chi.Router) {
@@ -761,6 +808,18 @@ func setupUser(r chi.Router, userCtrl *user.Controller) {
 			r.Delete(fmt.Sprintf("/{%s}", request.PathParamPublicKeyIdentifier),
 				handleruser.HandleDeletePublicKey(userCtrl))
 		})
+
+		// Saved replies
+		r.Route("/saved-replies", func(r chi.Router) {
+			r.Get("/", handleruser.HandleListSavedReplies(userCtrl))
+			r.Post("/", handleruser.HandleCreateSavedReply(userCtrl))
+
+			r.Route(fmt.Sprintf("/{%s}", request.PathParamSavedReplyIdentifier), func(r chi.Router) {
+				r.Get("/", handleruser.HandleFindSavedReply(userCtrl))
+				r.Patch("/", handleruser.HandleUpdateSavedReply(userCtrl))
+				r.Delete("/", handleruser.HandleDeleteSavedReply(userCtrl))
+			})
+		})
 	})
 }
 
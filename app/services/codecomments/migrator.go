@@ -138,7 +138,7 @@ func (migrator *Migrator) migrate(
 
 			codeComments = fileMap[file.FileHeader.OldName]
 
-			// Handle file renames
+			// Handle file renames: code comments follow the renamed file, even if they get outdated.
 			if file.FileHeader.OldName != file.FileHeader.NewName {
 				if len(codeComments) == 0 {
 					// If the code comments are not found using the old name of the file, try with the new name.
@@ -179,6 +179,7 @@ func (migrator *Migrator) migrate(
 					outdated, moveDelta := processCodeComment(ccStart, ccEnd, hunk)
 					if outdated {
 						cc.CodeCommentFields = initialValuesMap[cc.ID] // revert the CC to the original values
+						cc.Path = file.FileHeader.NewName
 						cc.Outdated = true
 						continue
 					}
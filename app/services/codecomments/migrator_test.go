@@ -332,8 +332,48 @@ func TestMigrator(t *testing.T) {
 	}
 }
 
+func TestMigrator_Rename(t *testing.T) {
+	const (
+		oldName   = "blah"
+		newName   = "blah-renamed"
+		shaSrcOld = "old"
+		shaSrcNew = "new"
+	)
+
+	m := &Migrator{
+		hunkHeaderFetcher: testHunkHeaderFetcher{
+			fileName: oldName,
+			newName:  newName,
+			headers:  []git.HunkHeader{{OldLine: 50, OldSpan: 1, NewLine: 50, NewSpan: 1}},
+		},
+	}
+
+	comments := []*types.CodeComment{
+		{ID: 1, CodeCommentFields: types.CodeCommentFields{
+			SourceSHA: shaSrcOld, Path: oldName, LineNew: 30, SpanNew: 1}},
+		{ID: 2, CodeCommentFields: types.CodeCommentFields{
+			SourceSHA: shaSrcOld, Path: oldName, LineNew: 50, SpanNew: 1}},
+	}
+
+	m.MigrateNew(context.Background(), "not-important", shaSrcNew, comments)
+
+	for _, cc := range comments {
+		if cc.Path != newName {
+			t.Errorf("comment=%d, path, want=%s got=%s", cc.ID, newName, cc.Path)
+		}
+	}
+
+	if comments[0].Outdated || comments[0].SourceSHA != shaSrcNew {
+		t.Errorf("comment=1, expected to be migrated to the new source SHA")
+	}
+	if !comments[1].Outdated || comments[1].SourceSHA != shaSrcOld {
+		t.Errorf("comment=2, expected to be outdated and keep the old source SHA")
+	}
+}
+
 type testHunkHeaderFetcher struct {
 	fileName string
+	newName  string // optional, the file is renamed if set
 	headers  []git.HunkHeader
 }
 
@@ -341,12 +381,17 @@ func (f testHunkHeaderFetcher) GetDiffHunkHeaders(
 	_ context.Context,
 	_ git.GetDiffHunkHeadersParams,
 ) (git.GetDiffHunkHeadersOutput, error) {
+	newName := f.fileName
+	if f.newName != "" {
+		newName = f.newName
+	}
+
 	return git.GetDiffHunkHeadersOutput{
 		Files: []git.DiffFileHunkHeaders{
 			{
 				FileHeader: git.DiffFileHeader{
 					OldName:    f.fileName,
-					NewName:    f.fileName,
+					NewName:    newName,
 					Extensions: nil,
 				},
 				HunkHeaders: f.headers,
@@ -0,0 +1,463 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergequeue
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/app/bootstrap"
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
+	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/errors"
+	"github.com/harness/gitness/git"
+	gitenum "github.com/harness/gitness/git/enum"
+	"github.com/harness/gitness/git/sha"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/gotidy/ptr"
+	"github.com/rs/zerolog/log"
+)
+
+// the max time we give the processing of a single merge queue to complete.
+const processTimeout = 3 * time.Minute
+
+type checksOutcome int
+
+const (
+	checksPending checksOutcome = iota
+	checksSucceeded
+	checksFailed
+)
+
+// processQueue walks the merge queue of the target branch in order.
+// Every entry is tested on top of the entry ahead of it (or the target branch for the first entry)
+// using a speculative merge commit. The first entry is merged by fast-forwarding the target branch
+// to its speculative merge commit once the required status checks of the commit pass.
+// Entries that can't be merged anymore are removed from the queue.
+//
+//nolint:gocognit // refactor if needed.
+func (s *Service) processQueue(ctx context.Context, repoID int64, targetBranch string) error {
+	// merging of pull requests is done under the same lock.
+	unlock, err := s.locker.LockPR(ctx, repoID, 0, processTimeout+30*time.Second)
+	if err != nil {
+		return fmt.Errorf("failed to lock repository for merge queue processing: %w", err)
+	}
+	defer unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, processTimeout)
+	defer cancel()
+
+	entries, err := s.mergeQueueStore.List(ctx, repoID, targetBranch)
+	if err != nil {
+		return fmt.Errorf("failed to list merge queue entries: %w", err)
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	repo, err := s.repoStore.Find(ctx, repoID)
+	if err != nil {
+		return fmt.Errorf("failed to find repository: %w", err)
+	}
+
+	writeParams, err := s.createSystemRPCWriteParams(ctx, repo)
+	if err != nil {
+		return fmt.Errorf("failed to create RPC write params: %w", err)
+	}
+
+	enabled, err := IsEnabled(ctx, s.settings, repo.ID, targetBranch)
+	if err != nil {
+		return err
+	}
+
+	var targetSHA sha.SHA
+
+	targetRef, err := s.git.GetRef(ctx, git.GetRefParams{
+		ReadParams: git.ReadParams{RepoUID: repo.GitUID},
+		Name:       targetBranch,
+		Type:       gitenum.RefTypeBranch,
+	})
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to get target branch commit SHA: %w", err)
+	}
+
+	targetSHA = targetRef.SHA
+	baseSHA := targetSHA
+
+	systemPrincipalID := bootstrap.NewSystemServiceSession().Principal.ID
+
+	for _, entry := range entries {
+		pr, err := s.pullreqStore.Find(ctx, entry.PullReqID)
+		if err != nil {
+			return fmt.Errorf("failed to find pull request of merge queue entry: %w", err)
+		}
+
+		var reason string
+
+		switch {
+		case pr.State != enum.PullReqStateOpen:
+			reason = "The pull request is no longer open."
+		case !enabled:
+			reason = "The merge queue has been disabled for the target branch."
+		case targetSHA.IsEmpty():
+			reason = "The target branch doesn't exist."
+		case pr.TargetBranch != entry.TargetBranch:
+			reason = "The target branch of the pull request has changed."
+		case pr.SourceSHA != entry.SourceSHA:
+			reason = "New commits have been pushed to the source branch."
+		case pr.IsDraft:
+			reason = "The pull request has been marked as draft."
+		}
+
+		if reason == "" && (entry.State == enum.MergeQueueEntryStateQueued || entry.BaseSHA != baseSHA.String()) {
+			reason, err = s.createMergeCommit(ctx, repo, writeParams, pr, entry, baseSHA)
+			if err != nil {
+				return err
+			}
+		}
+
+		if reason == "" {
+			var outcome checksOutcome
+
+			outcome, err = s.checksOutcome(ctx, repo, pr, entry)
+			if err != nil {
+				return err
+			}
+
+			switch outcome {
+			case checksFailed:
+				reason = "Status checks failed for the speculative merge commit."
+			case checksSucceeded:
+				// only the entry at the head of the queue, that is based on the target branch, can be merged.
+				if baseSHA.Equal(targetSHA) {
+					if err = s.merge(ctx, repo, writeParams, pr, entry, targetSHA); err != nil {
+						return err
+					}
+
+					targetSHA = sha.Must(entry.MergeSHA)
+				}
+			case checksPending:
+			}
+		}
+
+		if reason != "" {
+			err = s.removeEntry(ctx, repo, writeParams, pr, entry, systemPrincipalID,
+				enum.MergeQueueActionFailed, reason)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		baseSHA = sha.Must(entry.MergeSHA)
+	}
+
+	return nil
+}
+
+// createMergeCommit creates the speculative merge commit for the merge queue entry on top of the base commit.
+// If the merge commit can't be created, the function returns the reason for it.
+func (s *Service) createMergeCommit(
+	ctx context.Context,
+	repo *types.Repository,
+	writeParams git.WriteParams,
+	pr *types.PullReq,
+	entry *types.MergeQueueEntry,
+	baseSHA sha.SHA,
+) (string, error) {
+	mergedBy, err := s.principalStore.Find(ctx, entry.CreatedBy)
+	if err != nil {
+		return "", fmt.Errorf("failed to find principal that added the pull request to the merge queue: %w", err)
+	}
+
+	systemPrincipal := bootstrap.NewSystemServiceSession().Principal
+
+	// commit details: author, committer and message - the same as for the regular pull request merge.
+
+	var author, committer *git.Identity
+	var title string
+
+	switch entry.Method {
+	case enum.MergeMethodMerge:
+		author = identityFromPrincipal(mergedBy)
+		committer = identityFromPrincipal(&systemPrincipal)
+		title = fmt.Sprintf("Merge branch '%s' of %s (#%d)", pr.SourceBranch, repo.Path, pr.Number)
+	case enum.MergeMethodSquash:
+		author = &git.Identity{Name: pr.Author.DisplayName, Email: pr.Author.Email}
+		committer = identityFromPrincipal(&systemPrincipal)
+		title = fmt.Sprintf("%s (#%d)", pr.Title, pr.Number)
+	case enum.MergeMethodRebase:
+		committer = identityFromPrincipal(mergedBy)
+	case enum.MergeMethodFastForward:
+		return "The fast-forward merge method is not supported by the merge queue.", nil
+	}
+
+	now := time.Now()
+	mergeOutput, err := s.git.Merge(ctx, &git.MergeParams{
+		WriteParams:     writeParams,
+		BaseBranch:      baseSHA.String(),
+		HeadRepoUID:     repo.GitUID,
+		HeadBranch:      pr.SourceBranch,
+		Title:           title,
+		Committer:       committer,
+		CommitterDate:   &now,
+		Author:          author,
+		AuthorDate:      &now,
+		RefType:         gitenum.RefTypeRaw,
+		RefName:         RefName(pr.Number),
+		HeadExpectedSHA: sha.Must(entry.SourceSHA),
+		Method:          gitenum.MergeMethod(entry.Method),
+	})
+	if errors.IsPreconditionFailed(err) {
+		return "New commits have been pushed to the source branch.", nil
+	}
+	if errors.IsInvalidArgument(err) || errors.IsConflict(err) {
+		return errors.Message(err), nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to create speculative merge commit: %w", err)
+	}
+
+	if mergeOutput.MergeSHA.IsEmpty() || len(mergeOutput.ConflictFiles) > 0 {
+		return fmt.Sprintf("Merge conflicts with the target branch or pull requests ahead in the queue: %v",
+			mergeOutput.ConflictFiles), nil
+	}
+
+	entry.State = enum.MergeQueueEntryStateChecking
+	entry.BaseSHA = baseSHA.String()
+	entry.MergeBaseSHA = mergeOutput.MergeBaseSHA.String()
+	entry.MergeSHA = mergeOutput.MergeSHA.String()
+
+	if err = s.mergeQueueStore.Update(ctx, entry); err != nil {
+		return "", fmt.Errorf("failed to update merge queue entry: %w", err)
+	}
+
+	return "", nil
+}
+
+// checksOutcome evaluates the status checks, required by the protection rules, of the speculative merge commit.
+func (s *Service) checksOutcome(
+	ctx context.Context,
+	repo *types.Repository,
+	pr *types.PullReq,
+	entry *types.MergeQueueEntry,
+) (checksOutcome, error) {
+	principal, err := s.principalStore.Find(ctx, entry.CreatedBy)
+	if err != nil {
+		return checksPending, fmt.Errorf("failed to find principal: %w", err)
+	}
+
+	protectionRules, err := s.protectionManager.ForRepository(ctx, repo.ID)
+	if err != nil {
+		return checksPending, fmt.Errorf("failed to fetch protection rules for the repository: %w", err)
+	}
+
+	reqChecks, err := protectionRules.RequiredChecks(ctx, protection.RequiredChecksInput{
+		ResolveUserGroupID: s.userGroupService.ListUserIDsByGroupIDs,
+		Actor:              principal,
+		IsRepoOwner:        false,
+		Repo:               repo,
+		PullReq:            pr,
+	})
+	if err != nil {
+		return checksPending, fmt.Errorf("failed to get identifiers of required status checks: %w", err)
+	}
+
+	if len(reqChecks.RequiredIdentifiers) == 0 {
+		return checksSucceeded, nil
+	}
+
+	results, err := s.checkStore.ListResults(ctx, repo.ID, entry.MergeSHA)
+	if err != nil {
+		return checksPending, fmt.Errorf("failed to list status checks of the speculative merge commit: %w", err)
+	}
+
+	statuses := make(map[string]enum.CheckStatus, len(results))
+	for _, result := range results {
+		statuses[result.Identifier] = result.Status
+	}
+
+	outcome := checksSucceeded
+
+	for identifier := range reqChecks.RequiredIdentifiers {
+		status, ok := statuses[identifier]
+		switch {
+		case !ok || !status.IsCompleted():
+			outcome = checksPending
+		case status != enum.CheckStatusSuccess:
+			return checksFailed, nil
+		}
+	}
+
+	return outcome, nil
+}
+
+// merge fast-forwards the target branch to the speculative merge commit and marks the pull request as merged.
+func (s *Service) merge(
+	ctx context.Context,
+	repo *types.Repository,
+	writeParams git.WriteParams,
+	pr *types.PullReq,
+	entry *types.MergeQueueEntry,
+	targetSHA sha.SHA,
+) error {
+	err := s.git.UpdateRef(ctx, git.UpdateRefParams{
+		WriteParams: writeParams,
+		Type:        gitenum.RefTypeBranch,
+		Name:        entry.TargetBranch,
+		OldValue:    targetSHA,
+		NewValue:    sha.Must(entry.MergeSHA),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update the target branch to the speculative merge commit: %w", err)
+	}
+
+	now := time.Now().UnixMilli()
+	mergedBy := entry.CreatedBy
+
+	pr, err = s.pullreqStore.UpdateOptLock(ctx, pr, func(pr *types.PullReq) error {
+		pr.State = enum.PullReqStateMerged
+		pr.Merged = &now
+		pr.MergedBy = &mergedBy
+		pr.MergeMethod = &entry.Method
+		pr.MergeTargetSHA = ptr.String(targetSHA.String())
+		pr.MergeBaseSHA = entry.MergeBaseSHA
+		pr.MergeSHA = ptr.String(entry.MergeSHA)
+		pr.MarkAsMerged()
+
+		pr.ActivitySeq++
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update pull request: %w", err)
+	}
+
+	if err = s.mergeQueueStore.Delete(ctx, entry.ID); err != nil {
+		return fmt.Errorf("failed to delete merge queue entry: %w", err)
+	}
+
+	s.deleteRef(ctx, writeParams, pr.Number)
+
+	activityPayload := &types.PullRequestActivityPayloadMerge{
+		MergeMethod: entry.Method,
+		MergeSHA:    entry.MergeSHA,
+		TargetSHA:   targetSHA.String(),
+		SourceSHA:   entry.SourceSHA,
+	}
+	if _, errAct := s.activityStore.CreateWithPayload(ctx, pr, mergedBy, activityPayload, nil); errAct != nil {
+		// non-critical error
+		log.Ctx(ctx).Err(errAct).Msgf("failed to write pull req merge activity")
+	}
+
+	s.eventReporter.Merged(ctx, &pullreqevents.MergedPayload{
+		Base: pullreqevents.Base{
+			PullReqID:    pr.ID,
+			SourceRepoID: pr.SourceRepoID,
+			TargetRepoID: pr.TargetRepoID,
+			Number:       pr.Number,
+			PrincipalID:  mergedBy,
+		},
+		MergeMethod: entry.Method,
+		MergeSHA:    entry.MergeSHA,
+		TargetSHA:   targetSHA.String(),
+		SourceSHA:   entry.SourceSHA,
+	})
+
+	if err = s.sseStreamer.Publish(ctx, repo.ParentID, enum.SSETypePullRequestUpdated, pr); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("failed to publish PR changed event")
+	}
+
+	return nil
+}
+
+// removeEntry removes the entry from the merge queue and, if the pull request is still open,
+// writes the pull request activity with the reason for it.
+func (s *Service) removeEntry(
+	ctx context.Context,
+	repo *types.Repository,
+	writeParams git.WriteParams,
+	pr *types.PullReq,
+	entry *types.MergeQueueEntry,
+	principalID int64,
+	action enum.MergeQueueAction,
+	reason string,
+) error {
+	if err := s.mergeQueueStore.Delete(ctx, entry.ID); err != nil {
+		return fmt.Errorf("failed to delete merge queue entry: %w", err)
+	}
+
+	s.deleteRef(ctx, writeParams, pr.Number)
+
+	if pr.State != enum.PullReqStateOpen {
+		return nil
+	}
+
+	s.writeActivity(ctx, repo, pr, principalID, &types.PullRequestActivityPayloadMergeQueue{
+		Action:       action,
+		TargetBranch: entry.TargetBranch,
+		Reason:       reason,
+	})
+
+	return nil
+}
+
+func (s *Service) writeActivity(
+	ctx context.Context,
+	repo *types.Repository,
+	pr *types.PullReq,
+	principalID int64,
+	payload *types.PullRequestActivityPayloadMergeQueue,
+) {
+	pr, err := s.pullreqStore.UpdateActivitySeq(ctx, pr)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed to increment pull request activity sequence")
+		return
+	}
+
+	if _, err = s.activityStore.CreateWithPayload(ctx, pr, principalID, payload, nil); err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed to write pull request merge queue activity")
+		return
+	}
+
+	if err = s.sseStreamer.Publish(ctx, repo.ParentID, enum.SSETypePullRequestUpdated, pr); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("failed to publish PR changed event")
+	}
+}
+
+func (s *Service) deleteRef(ctx context.Context, writeParams git.WriteParams, prNum int64) {
+	err := s.git.UpdateRef(ctx, git.UpdateRefParams{
+		WriteParams: writeParams,
+		Name:        RefName(prNum),
+		Type:        gitenum.RefTypeRaw,
+		NewValue:    sha.None, // when NewValue is empty will delete the ref.
+		OldValue:    sha.None, // we don't care about the old value
+	})
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("failed to delete merge queue ref")
+	}
+}
+
+func identityFromPrincipal(p *types.Principal) *git.Identity {
+	return &git.Identity{
+		Name:  p.DisplayName,
+		Email: p.Email,
+	}
+}
@@ -0,0 +1,695 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergequeue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/app/api/controller/service"
+	"github.com/harness/gitness/app/bootstrap"
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
+	"github.com/harness/gitness/app/services/locker"
+	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/app/services/settings"
+	"github.com/harness/gitness/app/services/usergroup"
+	"github.com/harness/gitness/app/sse"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/app/url"
+	"github.com/harness/gitness/errors"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/git"
+	gitenum "github.com/harness/gitness/git/enum"
+	"github.com/harness/gitness/git/sha"
+	"github.com/harness/gitness/lock"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	testRepoID       = 1
+	testTargetBranch = "main"
+	testCheck        = "ci"
+)
+
+func TestProcessQueue_Order(t *testing.T) {
+	env := newTestEnv(t, true)
+	target := env.git.target
+
+	env.enqueue(1)
+	env.enqueue(2)
+	env.enqueue(3)
+
+	env.process()
+
+	// every entry is tested on top of the entry ahead of it, nothing is merged while the checks are pending.
+	m1, m2, m3 := env.entry(1).MergeSHA, env.entry(2).MergeSHA, env.entry(3).MergeSHA
+	env.expectMerges(
+		mergeCall{prNum: 1, base: target.String()},
+		mergeCall{prNum: 2, base: m1},
+		mergeCall{prNum: 3, base: m2},
+	)
+	env.expectBranchUpdates()
+
+	// entries behind the head of the queue aren't merged even if their checks pass.
+	env.checks.set(m2, enum.CheckStatusSuccess)
+	env.checks.set(m3, enum.CheckStatusSuccess)
+	env.process()
+
+	env.expectMerges(
+		mergeCall{prNum: 1, base: target.String()},
+		mergeCall{prNum: 2, base: m1},
+		mergeCall{prNum: 3, base: m2},
+	)
+	env.expectBranchUpdates()
+	env.expectQueue(1, 2, 3)
+
+	// once the head of the queue passes, the whole queue is merged in order.
+	env.checks.set(m1, enum.CheckStatusSuccess)
+	env.process()
+
+	env.expectBranchUpdates(
+		branchUpdate{old: target.String(), new: m1},
+		branchUpdate{old: m1, new: m2},
+		branchUpdate{old: m2, new: m3},
+	)
+	env.expectQueue()
+
+	for _, prNum := range []int64{1, 2, 3} {
+		if pr := env.prs.prs[prNum]; pr.State != enum.PullReqStateMerged {
+			t.Errorf("pull request %d: want state %s got %s", prNum, enum.PullReqStateMerged, pr.State)
+		}
+	}
+}
+
+func TestProcessQueue_HeadOfQueueFailure(t *testing.T) {
+	env := newTestEnv(t, true)
+	target := env.git.target
+
+	env.enqueue(1)
+	env.enqueue(2)
+
+	env.process()
+
+	m1, m2 := env.entry(1).MergeSHA, env.entry(2).MergeSHA
+	env.checks.set(m1, enum.CheckStatusFailure)
+	env.checks.set(m2, enum.CheckStatusSuccess)
+
+	env.process()
+
+	env.expectRemoved(1, "Status checks failed for the speculative merge commit.")
+
+	// the speculative merge commit of the second entry contained the failed pull request,
+	// so it's recreated on top of the target branch and tested again.
+	m3 := env.entry(2).MergeSHA
+	if m3 == m2 {
+		t.Fatalf("expected the speculative merge commit of the second entry to be recreated")
+	}
+	env.expectMerges(
+		mergeCall{prNum: 1, base: target.String()},
+		mergeCall{prNum: 2, base: m1},
+		mergeCall{prNum: 2, base: target.String()},
+	)
+	env.expectBranchUpdates()
+	env.expectQueue(2)
+
+	env.checks.set(m3, enum.CheckStatusSuccess)
+	env.process()
+
+	env.expectBranchUpdates(branchUpdate{old: target.String(), new: m3})
+	env.expectQueue()
+}
+
+func TestProcessQueue_RemovalReasons(t *testing.T) {
+	tests := []struct {
+		name         string
+		requireCheck bool
+		prepare      func(env *testEnv, pr *types.PullReq, entry *types.MergeQueueEntry)
+		reason       string
+		noActivity   bool
+	}{
+		{
+			name: "closed",
+			prepare: func(_ *testEnv, pr *types.PullReq, _ *types.MergeQueueEntry) {
+				pr.State = enum.PullReqStateClosed
+			},
+			noActivity: true,
+		},
+		{
+			name: "disabled",
+			prepare: func(env *testEnv, _ *types.PullReq, _ *types.MergeQueueEntry) {
+				env.settings.branches = []string{"develop"}
+			},
+			reason: "The merge queue has been disabled for the target branch.",
+		},
+		{
+			name: "target-branch-deleted",
+			prepare: func(env *testEnv, _ *types.PullReq, _ *types.MergeQueueEntry) {
+				env.git.target = sha.None
+			},
+			reason: "The target branch doesn't exist.",
+		},
+		{
+			name: "target-branch-changed",
+			prepare: func(_ *testEnv, pr *types.PullReq, _ *types.MergeQueueEntry) {
+				pr.TargetBranch = "develop"
+			},
+			reason: "The target branch of the pull request has changed.",
+		},
+		{
+			name: "source-branch-updated",
+			prepare: func(_ *testEnv, pr *types.PullReq, _ *types.MergeQueueEntry) {
+				pr.SourceSHA = testSHA(999).String()
+			},
+			reason: "New commits have been pushed to the source branch.",
+		},
+		{
+			name: "source-branch-updated-during-merge",
+			prepare: func(env *testEnv, _ *types.PullReq, _ *types.MergeQueueEntry) {
+				env.git.mergeErr = errors.PreconditionFailed("head branch has changed")
+			},
+			reason: "New commits have been pushed to the source branch.",
+		},
+		{
+			name: "draft",
+			prepare: func(_ *testEnv, pr *types.PullReq, _ *types.MergeQueueEntry) {
+				pr.IsDraft = true
+			},
+			reason: "The pull request has been marked as draft.",
+		},
+		{
+			name: "fast-forward",
+			prepare: func(_ *testEnv, _ *types.PullReq, entry *types.MergeQueueEntry) {
+				entry.Method = enum.MergeMethodFastForward
+			},
+			reason: "The fast-forward merge method is not supported by the merge queue.",
+		},
+		{
+			name: "conflicts",
+			prepare: func(env *testEnv, _ *types.PullReq, _ *types.MergeQueueEntry) {
+				env.git.conflicts = []string{"README.md"}
+			},
+			reason: "Merge conflicts with the target branch or pull requests ahead in the queue: [README.md]",
+		},
+		{
+			name:         "checks-failed",
+			requireCheck: true,
+			prepare: func(env *testEnv, _ *types.PullReq, _ *types.MergeQueueEntry) {
+				env.checks.failAll = true
+			},
+			reason: "Status checks failed for the speculative merge commit.",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			env := newTestEnv(t, test.requireCheck)
+			env.enqueue(1)
+			test.prepare(env, env.prs.prs[1], env.queue.entries[0])
+
+			env.process()
+
+			env.expectQueue()
+			env.expectBranchUpdates()
+
+			if !slices.Contains(env.git.deletedRefs, RefName(1)) {
+				t.Errorf("expected the merge queue ref to be deleted")
+			}
+
+			if test.noActivity {
+				if n := len(env.activities.payloads[1]); n != 0 {
+					t.Errorf("expected no activity, got %d", n)
+				}
+				return
+			}
+
+			env.expectRemoved(1, test.reason)
+		})
+	}
+}
+
+type testEnv struct {
+	t          *testing.T
+	service    *Service
+	queue      *mergeQueueStoreFake
+	prs        *pullreqStoreFake
+	activities *activityStoreFake
+	checks     *checkStoreFake
+	settings   *settingsStoreFake
+	git        *gitFake
+}
+
+func newTestEnv(t *testing.T, requireCheck bool) *testEnv {
+	principalStore := principalStoreFake{}
+
+	config := &types.Config{}
+	config.Principal.System.UID = "gitness"
+	err := bootstrap.SystemService(context.Background(), config, service.NewController(nil, nil, principalStore))
+	if err != nil {
+		t.Fatalf("failed to set up system service: %s", err)
+	}
+
+	eventSystem, err := events.NewSystem(
+		func(string, string) (events.StreamConsumer, error) { return nil, nil },
+		streamProducerFake{},
+	)
+	if err != nil {
+		t.Fatalf("failed to create event system: %s", err)
+	}
+
+	eventReporter, err := pullreqevents.NewReporter(eventSystem)
+	if err != nil {
+		t.Fatalf("failed to create event reporter: %s", err)
+	}
+
+	var rules []types.RuleInfoInternal
+	if requireCheck {
+		rules = append(rules, types.RuleInfoInternal{
+			RuleInfo: types.RuleInfo{
+				ID:         1,
+				Identifier: "checks",
+				Type:       protection.TypeBranch,
+				State:      enum.RuleStateActive,
+			},
+			Pattern:    (&protection.Pattern{Default: true}).JSON(),
+			Definition: json.RawMessage(`{"pullreq":{"status_checks":{"require_identifiers":["` + testCheck + `"]}}}`),
+		})
+	}
+
+	protectionManager, err := protection.ProvideManager(ruleStoreFake{rules: rules})
+	if err != nil {
+		t.Fatalf("failed to create protection manager: %s", err)
+	}
+
+	env := &testEnv{
+		t:          t,
+		queue:      &mergeQueueStoreFake{},
+		prs:        &pullreqStoreFake{prs: map[int64]*types.PullReq{}},
+		activities: &activityStoreFake{payloads: map[int64][]types.PullReqActivityPayload{}},
+		checks:     &checkStoreFake{results: map[string]enum.CheckStatus{}},
+		settings:   &settingsStoreFake{branches: []string{testTargetBranch}},
+		git:        &gitFake{target: testSHA(1000)},
+	}
+
+	env.service = &Service{
+		locker:            locker.NewLocker(lock.NewInMemory(lock.Config{Expiry: time.Minute, Tries: 1})),
+		urlProvider:       urlProviderFake{},
+		git:               env.git,
+		mergeQueueStore:   env.queue,
+		pullreqStore:      env.prs,
+		activityStore:     env.activities,
+		repoStore:         repoStoreFake{},
+		principalStore:    principalStore,
+		checkStore:        env.checks,
+		protectionManager: protectionManager,
+		settings:          settings.NewService(env.settings),
+		userGroupService:  userGroupServiceFake{},
+		eventReporter:     eventReporter,
+		sseStreamer:       streamerFake{},
+	}
+
+	return env
+}
+
+// enqueue adds a new open pull request to the end of the merge queue.
+func (env *testEnv) enqueue(prNum int64) {
+	sourceSHA := testSHA(prNum).String()
+
+	env.prs.prs[prNum] = &types.PullReq{
+		ID:           prNum,
+		Number:       prNum,
+		State:        enum.PullReqStateOpen,
+		SourceRepoID: testRepoID,
+		SourceBranch: fmt.Sprintf("feature-%d", prNum),
+		SourceSHA:    sourceSHA,
+		TargetRepoID: testRepoID,
+		TargetBranch: testTargetBranch,
+	}
+
+	env.queue.entries = append(env.queue.entries, &types.MergeQueueEntry{
+		ID:            prNum,
+		RepoID:        testRepoID,
+		PullReqID:     prNum,
+		PullReqNumber: prNum,
+		TargetBranch:  testTargetBranch,
+		State:         enum.MergeQueueEntryStateQueued,
+		Method:        enum.MergeMethodMerge,
+		SourceSHA:     sourceSHA,
+		CreatedBy:     2,
+	})
+}
+
+func (env *testEnv) process() {
+	env.t.Helper()
+
+	if err := env.service.processQueue(context.Background(), testRepoID, testTargetBranch); err != nil {
+		env.t.Fatalf("failed to process merge queue: %s", err)
+	}
+}
+
+func (env *testEnv) entry(prNum int64) *types.MergeQueueEntry {
+	env.t.Helper()
+
+	for _, entry := range env.queue.entries {
+		if entry.PullReqID == prNum {
+			return entry
+		}
+	}
+
+	env.t.Fatalf("pull request %d is not in the merge queue", prNum)
+	return nil
+}
+
+func (env *testEnv) expectQueue(prNums ...int64) {
+	env.t.Helper()
+
+	got := make([]int64, len(env.queue.entries))
+	for i, entry := range env.queue.entries {
+		got[i] = entry.PullReqID
+	}
+
+	if !slices.Equal(got, prNums) {
+		env.t.Errorf("merge queue: want=%v got=%v", prNums, got)
+	}
+}
+
+func (env *testEnv) expectMerges(want ...mergeCall) {
+	env.t.Helper()
+
+	if !slices.Equal(env.git.merges, want) {
+		env.t.Errorf("speculative merges: want=%v got=%v", want, env.git.merges)
+	}
+}
+
+func (env *testEnv) expectBranchUpdates(want ...branchUpdate) {
+	env.t.Helper()
+
+	if !slices.Equal(env.git.branchUpdates, want) {
+		env.t.Errorf("target branch updates: want=%v got=%v", want, env.git.branchUpdates)
+	}
+}
+
+func (env *testEnv) expectRemoved(prNum int64, reason string) {
+	env.t.Helper()
+
+	if slices.ContainsFunc(env.queue.entries, func(e *types.MergeQueueEntry) bool { return e.PullReqID == prNum }) {
+		env.t.Errorf("pull request %d should have been removed from the merge queue", prNum)
+	}
+
+	payloads := env.activities.payloads[prNum]
+	if len(payloads) != 1 {
+		env.t.Fatalf("pull request %d: expected one activity, got %d", prNum, len(payloads))
+	}
+
+	payload, ok := payloads[0].(*types.PullRequestActivityPayloadMergeQueue)
+	if !ok {
+		env.t.Fatalf("pull request %d: unexpected activity payload %T", prNum, payloads[0])
+	}
+
+	if payload.Action != enum.MergeQueueActionFailed {
+		env.t.Errorf("pull request %d: want action=%s got=%s", prNum, enum.MergeQueueActionFailed, payload.Action)
+	}
+	if payload.Reason != reason {
+		env.t.Errorf("pull request %d: want reason=%q got=%q", prNum, reason, payload.Reason)
+	}
+}
+
+func testSHA(n int64) sha.SHA {
+	return sha.Must(fmt.Sprintf("%040x", n))
+}
+
+type mergeCall struct {
+	prNum int64
+	base  string
+}
+
+type branchUpdate struct {
+	old string
+	new string
+}
+
+type gitFake struct {
+	git.Interface
+	target        sha.SHA
+	conflicts     []string
+	mergeErr      error
+	nextSHA       int64
+	merges        []mergeCall
+	branchUpdates []branchUpdate
+	deletedRefs   []string
+}
+
+func (g *gitFake) GetRef(_ context.Context, _ git.GetRefParams) (git.GetRefResponse, error) {
+	if g.target.IsEmpty() {
+		return git.GetRefResponse{}, errors.NotFound("branch not found")
+	}
+	return git.GetRefResponse{SHA: g.target}, nil
+}
+
+func (g *gitFake) Merge(_ context.Context, in *git.MergeParams) (git.MergeOutput, error) {
+	if g.mergeErr != nil {
+		return git.MergeOutput{}, g.mergeErr
+	}
+	if len(g.conflicts) > 0 {
+		return git.MergeOutput{ConflictFiles: g.conflicts}, nil
+	}
+
+	var prNum int64
+	if _, err := fmt.Sscanf(in.RefName, refPrefix+"%d", &prNum); err != nil {
+		return git.MergeOutput{}, err
+	}
+
+	g.merges = append(g.merges, mergeCall{prNum: prNum, base: in.BaseBranch})
+	g.nextSHA++
+
+	return git.MergeOutput{
+		MergeBaseSHA: sha.Must(in.BaseBranch),
+		MergeSHA:     testSHA(2000 + g.nextSHA),
+	}, nil
+}
+
+func (g *gitFake) UpdateRef(_ context.Context, params git.UpdateRefParams) error {
+	if params.Type != gitenum.RefTypeBranch {
+		g.deletedRefs = append(g.deletedRefs, params.Name)
+		return nil
+	}
+
+	if !params.OldValue.Equal(g.target) {
+		return errors.PreconditionFailed("target branch has changed")
+	}
+
+	g.branchUpdates = append(g.branchUpdates, branchUpdate{old: params.OldValue.String(), new: params.NewValue.String()})
+	g.target = params.NewValue
+
+	return nil
+}
+
+type mergeQueueStoreFake struct {
+	store.MergeQueueStore
+	entries []*types.MergeQueueEntry
+}
+
+func (s *mergeQueueStoreFake) List(context.Context, int64, string) ([]*types.MergeQueueEntry, error) {
+	entries := make([]*types.MergeQueueEntry, len(s.entries))
+	for i, entry := range s.entries {
+		e := *entry
+		entries[i] = &e
+	}
+	return entries, nil
+}
+
+func (s *mergeQueueStoreFake) Update(_ context.Context, entry *types.MergeQueueEntry) error {
+	for i := range s.entries {
+		if s.entries[i].ID == entry.ID {
+			e := *entry
+			s.entries[i] = &e
+			return nil
+		}
+	}
+	return gitness_store.ErrResourceNotFound
+}
+
+func (s *mergeQueueStoreFake) Delete(_ context.Context, id int64) error {
+	s.entries = slices.DeleteFunc(s.entries, func(e *types.MergeQueueEntry) bool { return e.ID == id })
+	return nil
+}
+
+type pullreqStoreFake struct {
+	store.PullReqStore
+	prs map[int64]*types.PullReq
+}
+
+func (s *pullreqStoreFake) Find(_ context.Context, id int64) (*types.PullReq, error) {
+	pr, ok := s.prs[id]
+	if !ok {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+	cp := *pr
+	return &cp, nil
+}
+
+func (s *pullreqStoreFake) UpdateOptLock(
+	_ context.Context,
+	pr *types.PullReq,
+	mutateFn func(pr *types.PullReq) error,
+) (*types.PullReq, error) {
+	cp := *s.prs[pr.ID]
+	if err := mutateFn(&cp); err != nil {
+		return nil, err
+	}
+	s.prs[pr.ID] = &cp
+	updated := cp
+	return &updated, nil
+}
+
+func (s *pullreqStoreFake) UpdateActivitySeq(ctx context.Context, pr *types.PullReq) (*types.PullReq, error) {
+	return s.UpdateOptLock(ctx, pr, func(pr *types.PullReq) error {
+		pr.ActivitySeq++
+		return nil
+	})
+}
+
+type activityStoreFake struct {
+	store.PullReqActivityStore
+	payloads map[int64][]types.PullReqActivityPayload
+}
+
+func (s *activityStoreFake) CreateWithPayload(
+	_ context.Context,
+	pr *types.PullReq,
+	_ int64,
+	payload types.PullReqActivityPayload,
+	_ *types.PullReqActivityMetadata,
+) (*types.PullReqActivity, error) {
+	s.payloads[pr.ID] = append(s.payloads[pr.ID], payload)
+	return &types.PullReqActivity{}, nil
+}
+
+type checkStoreFake struct {
+	store.CheckStore
+	results map[string]enum.CheckStatus
+	failAll bool
+}
+
+func (s *checkStoreFake) set(commitSHA string, status enum.CheckStatus) {
+	s.results[commitSHA] = status
+}
+
+func (s *checkStoreFake) ListResults(_ context.Context, _ int64, commitSHA string) ([]types.CheckResult, error) {
+	if s.failAll {
+		return []types.CheckResult{{Identifier: testCheck, Status: enum.CheckStatusFailure}}, nil
+	}
+
+	status, ok := s.results[commitSHA]
+	if !ok {
+		return nil, nil
+	}
+
+	return []types.CheckResult{{Identifier: testCheck, Status: status}}, nil
+}
+
+type settingsStoreFake struct {
+	store.SettingsStore
+	branches []string
+}
+
+func (s *settingsStoreFake) Find(
+	_ context.Context,
+	_ enum.SettingsScope,
+	_ int64,
+	key string,
+) (json.RawMessage, error) {
+	if key != string(settings.KeyMergeQueueBranches) {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+	return json.Marshal(s.branches)
+}
+
+type repoStoreFake struct {
+	store.RepoStore
+}
+
+func (repoStoreFake) Find(context.Context, int64) (*types.Repository, error) {
+	return &types.Repository{
+		ID:            testRepoID,
+		ParentID:      1,
+		Identifier:    "repo",
+		Path:          "space/repo",
+		GitUID:        "repo-uid",
+		DefaultBranch: testTargetBranch,
+	}, nil
+}
+
+type principalStoreFake struct {
+	store.PrincipalStore
+}
+
+func (principalStoreFake) Find(_ context.Context, id int64) (*types.Principal, error) {
+	return &types.Principal{
+		ID:          id,
+		UID:         fmt.Sprintf("user-%d", id),
+		Email:       fmt.Sprintf("user-%d@example.com", id),
+		DisplayName: fmt.Sprintf("User %d", id),
+		Type:        enum.PrincipalTypeUser,
+	}, nil
+}
+
+func (principalStoreFake) FindServiceByUID(_ context.Context, uid string) (*types.Service, error) {
+	return &types.Service{ID: 1, UID: uid, Admin: true}, nil
+}
+
+type ruleStoreFake struct {
+	store.RuleStore
+	rules []types.RuleInfoInternal
+}
+
+func (s ruleStoreFake) ListAllRepoRules(context.Context, int64) ([]types.RuleInfoInternal, error) {
+	return s.rules, nil
+}
+
+type userGroupServiceFake struct {
+	usergroup.SearchService
+}
+
+func (userGroupServiceFake) ListUserIDsByGroupIDs(context.Context, []int64) ([]int64, error) {
+	return nil, nil
+}
+
+type urlProviderFake struct {
+	url.Provider
+}
+
+func (urlProviderFake) GetInternalAPIURL(context.Context) string {
+	return "http://localhost:3000"
+}
+
+type streamerFake struct {
+	sse.Streamer
+}
+
+func (streamerFake) Publish(context.Context, int64, enum.SSEType, any) error {
+	return nil
+}
+
+type streamProducerFake struct{}
+
+func (streamProducerFake) Send(context.Context, string, map[string]interface{}) (string, error) {
+	return "0-1", nil
+}
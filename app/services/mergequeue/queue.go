@@ -0,0 +1,123 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergequeue
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/errors"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// Add adds the pull request to the end of the merge queue of its target branch.
+func (s *Service) Add(
+	ctx context.Context,
+	principal *types.Principal,
+	repo *types.Repository,
+	pr *types.PullReq,
+	method enum.MergeMethod,
+) (*types.MergeQueueEntry, error) {
+	unlock, err := s.locker.LockPR(ctx, repo.ID, 0, processTimeout+30*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock repository for merge queue update: %w", err)
+	}
+	defer unlock()
+
+	_, err = s.mergeQueueStore.FindByPullReqID(ctx, pr.ID)
+	if err == nil {
+		return nil, errors.Conflict("Pull request is already in the merge queue.")
+	}
+	if !errors.Is(err, store.ErrResourceNotFound) {
+		return nil, fmt.Errorf("failed to find merge queue entry: %w", err)
+	}
+
+	now := time.Now().UnixMilli()
+	entry := &types.MergeQueueEntry{
+		RepoID:        repo.ID,
+		PullReqID:     pr.ID,
+		PullReqNumber: pr.Number,
+		TargetBranch:  pr.TargetBranch,
+		State:         enum.MergeQueueEntryStateQueued,
+		Method:        method,
+		SourceSHA:     pr.SourceSHA,
+		CreatedBy:     principal.ID,
+		Created:       now,
+		Updated:       now,
+	}
+
+	if err = s.mergeQueueStore.Create(ctx, entry); err != nil {
+		return nil, fmt.Errorf("failed to create merge queue entry: %w", err)
+	}
+
+	s.writeActivity(ctx, repo, pr, principal.ID, &types.PullRequestActivityPayloadMergeQueue{
+		Action:       enum.MergeQueueActionAdded,
+		TargetBranch: entry.TargetBranch,
+	})
+
+	return entry, nil
+}
+
+// Remove removes the pull request from the merge queue.
+func (s *Service) Remove(
+	ctx context.Context,
+	principal *types.Principal,
+	repo *types.Repository,
+	pr *types.PullReq,
+) error {
+	unlock, err := s.locker.LockPR(ctx, repo.ID, 0, processTimeout+30*time.Second)
+	if err != nil {
+		return fmt.Errorf("failed to lock repository for merge queue update: %w", err)
+	}
+	defer unlock()
+
+	entry, err := s.mergeQueueStore.FindByPullReqID(ctx, pr.ID)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return errors.NotFound("Pull request is not in the merge queue.")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find merge queue entry: %w", err)
+	}
+
+	writeParams, err := s.createSystemRPCWriteParams(ctx, repo)
+	if err != nil {
+		return fmt.Errorf("failed to create RPC write params: %w", err)
+	}
+
+	return s.removeEntry(ctx, repo, writeParams, pr, entry, principal.ID, enum.MergeQueueActionRemoved, "")
+}
+
+// List returns the entries of the merge queue of the target branch in the order they will be merged.
+func (s *Service) List(ctx context.Context, repoID int64, targetBranch string) ([]*types.MergeQueueEntry, error) {
+	entries, err := s.mergeQueueStore.List(ctx, repoID, targetBranch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list merge queue entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+// Find returns the merge queue entry of the pull request.
+func (s *Service) Find(ctx context.Context, prID int64) (*types.MergeQueueEntry, error) {
+	entry, err := s.mergeQueueStore.FindByPullReqID(ctx, prID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find merge queue entry: %w", err)
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,145 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergequeue
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/harness/gitness/app/bootstrap"
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
+	"github.com/harness/gitness/app/githook"
+	"github.com/harness/gitness/app/services/locker"
+	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/app/services/settings"
+	"github.com/harness/gitness/app/services/usergroup"
+	"github.com/harness/gitness/app/sse"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/app/url"
+	"github.com/harness/gitness/git"
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	jobType = "gitness:mergequeue"
+
+	// refPrefix is the prefix of the git references pointing to the speculative merge commits.
+	// CI systems are expected to build these commits and report status checks for them.
+	refPrefix = "refs/merge-queue/"
+)
+
+// Service processes the merge queues: It creates speculative merge commits for the queued pull requests
+// and merges the pull requests, in order, once the status checks of their speculative merge commits pass.
+type Service struct {
+	cron              string
+	maxDur            time.Duration
+	scheduler         *job.Scheduler
+	locker            *locker.Locker
+	urlProvider       url.Provider
+	git               git.Interface
+	mergeQueueStore   store.MergeQueueStore
+	pullreqStore      store.PullReqStore
+	activityStore     store.PullReqActivityStore
+	repoStore         store.RepoStore
+	principalStore    store.PrincipalStore
+	checkStore        store.CheckStore
+	protectionManager *protection.Manager
+	settings          *settings.Service
+	userGroupService  usergroup.SearchService
+	eventReporter     *pullreqevents.Reporter
+	sseStreamer       sse.Streamer
+}
+
+var _ job.Handler = (*Service)(nil)
+
+// Register schedules the recurring job that processes all merge queues.
+func (s *Service) Register(ctx context.Context) error {
+	err := s.scheduler.AddRecurring(ctx, jobType, jobType, s.cron, s.maxDur)
+	if err != nil {
+		return fmt.Errorf("failed to register recurring job for merge queue: %w", err)
+	}
+
+	return nil
+}
+
+// Handle processes all merge queues that have at least one entry.
+func (s *Service) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	queues, err := s.mergeQueueStore.ListQueues(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list merge queues: %w", err)
+	}
+
+	for _, queue := range queues {
+		if err := s.processQueue(ctx, queue.RepoID, queue.TargetBranch); err != nil {
+			log.Ctx(ctx).Warn().Err(err).
+				Int64("repo_id", queue.RepoID).
+				Str("target_branch", queue.TargetBranch).
+				Msg("failed to process merge queue")
+		}
+	}
+
+	return fmt.Sprintf("processed %d merge queues", len(queues)), nil
+}
+
+// IsEnabled returns true if the merge queue is enabled for the branch of the repository.
+func IsEnabled(ctx context.Context, settingsService *settings.Service, repoID int64, branch string) (bool, error) {
+	branches, err := settings.RepoGet(ctx, settingsService, repoID,
+		settings.KeyMergeQueueBranches, settings.DefaultMergeQueueBranches)
+	if err != nil {
+		return false, fmt.Errorf("failed to get merge queue branches setting: %w", err)
+	}
+
+	return slices.Contains(branches, branch), nil
+}
+
+// RefName returns the name of the git reference that points to the speculative merge commit of the pull request.
+func RefName(prNum int64) string {
+	return refPrefix + strconv.FormatInt(prNum, 10)
+}
+
+func (s *Service) createSystemRPCWriteParams(
+	ctx context.Context,
+	repo *types.Repository,
+) (git.WriteParams, error) {
+	principal := bootstrap.NewSystemServiceSession().Principal
+
+	// generate envars (add everything githook CLI needs for execution)
+	envVars, err := githook.GenerateEnvironmentVariables(
+		ctx,
+		s.urlProvider.GetInternalAPIURL(ctx),
+		repo.ID,
+		principal.ID,
+		false,
+		true,
+	)
+	if err != nil {
+		return git.WriteParams{}, fmt.Errorf("failed to generate git hook environment variables: %w", err)
+	}
+
+	return git.WriteParams{
+		Actor: git.Identity{
+			Name:  principal.DisplayName,
+			Email: principal.Email,
+		},
+		RepoUID: repo.GitUID,
+		EnvVars: envVars,
+	}, nil
+}
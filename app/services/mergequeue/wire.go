@@ -0,0 +1,81 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergequeue
+
+import (
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
+	"github.com/harness/gitness/app/services/locker"
+	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/app/services/settings"
+	"github.com/harness/gitness/app/services/usergroup"
+	"github.com/harness/gitness/app/sse"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/app/url"
+	"github.com/harness/gitness/git"
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/types"
+
+	"github.com/google/wire"
+)
+
+var WireSet = wire.NewSet(
+	ProvideService,
+)
+
+func ProvideService(
+	config *types.Config,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	locker *locker.Locker,
+	urlProvider url.Provider,
+	git git.Interface,
+	mergeQueueStore store.MergeQueueStore,
+	pullreqStore store.PullReqStore,
+	activityStore store.PullReqActivityStore,
+	repoStore store.RepoStore,
+	principalStore store.PrincipalStore,
+	checkStore store.CheckStore,
+	protectionManager *protection.Manager,
+	settings *settings.Service,
+	userGroupService usergroup.SearchService,
+	eventReporter *pullreqevents.Reporter,
+	sseStreamer sse.Streamer,
+) (*Service, error) {
+	service := &Service{
+		cron:              config.MergeQueue.CRON,
+		maxDur:            config.MergeQueue.MaxDuration,
+		scheduler:         scheduler,
+		locker:            locker,
+		urlProvider:       urlProvider,
+		git:               git,
+		mergeQueueStore:   mergeQueueStore,
+		pullreqStore:      pullreqStore,
+		activityStore:     activityStore,
+		repoStore:         repoStore,
+		principalStore:    principalStore,
+		checkStore:        checkStore,
+		protectionManager: protectionManager,
+		settings:          settings,
+		userGroupService:  userGroupService,
+		eventReporter:     eventReporter,
+		sseStreamer:       sseStreamer,
+	}
+
+	if err := executor.Register(jobType, service); err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
@@ -156,7 +156,12 @@ func mapToBranchRules(
 		},
 
 		PullReq: protection.DefPullReq{
-			Approvals:    protection.DefApprovals(rule.PullReq.Approvals),
+			Approvals: protection.DefApprovals{
+				RequireCodeOwners:      rule.PullReq.Approvals.RequireCodeOwners,
+				RequireMinimumCount:    rule.PullReq.Approvals.RequireMinimumCount,
+				RequireLatestCommit:    rule.PullReq.Approvals.RequireLatestCommit,
+				RequireNoChangeRequest: rule.PullReq.Approvals.RequireNoChangeRequest,
+			},
 			Comments:     protection.DefComments(rule.PullReq.Comments),
 			StatusChecks: protection.DefStatusChecks(rule.PullReq.StatusChecks),
 			Merge: protection.DefMerge{
@@ -38,6 +38,11 @@ type Client interface {
 		recipients []*types.PrincipalInfo,
 		payload *CommentPayload,
 	) error
+	SendPullReqMentions(
+		ctx context.Context,
+		recipients []*types.PrincipalInfo,
+		payload *PullReqMentionedPayload,
+	) error
 	SendReviewerAdded(
 		ctx context.Context,
 		recipients []*types.PrincipalInfo,
@@ -58,4 +63,14 @@ type Client interface {
 		recipients []*types.PrincipalInfo,
 		payload *PullReqStateChangedPayload,
 	) error
+	SendReviewerReminder(
+		ctx context.Context,
+		recipients []*types.PrincipalInfo,
+		payload *ReviewerReminderPayload,
+	) error
+	SendPipelineExecuted(
+		ctx context.Context,
+		recipients []*types.PrincipalInfo,
+		payload *PipelineExecutedPayload,
+	) error
 }
@@ -32,6 +32,10 @@ const (
 	TemplatePullReqBranchUpdated = "pullreq_branch_updated.html"
 	TemplateNameReviewSubmitted  = "review_submitted.html"
 	TemplatePullReqStateChanged  = "pullreq_state_changed.html"
+	TemplatePullReqMentions      = "pullreq_mentions.html"
+	TemplateReviewerReminder     = "reviewer_reminder.html"
+	TemplatePipelineExecuted     = "pipeline_executed.html"
+	TemplatePipelineExecutedText = "pipeline_executed.txt"
 )
 
 type MailClient struct {
@@ -99,6 +103,25 @@ func (m MailClient) SendCommentParticipants(
 	return m.Mailer.Send(ctx, *email)
 }
 
+func (m MailClient) SendPullReqMentions(
+	ctx context.Context,
+	recipients []*types.PrincipalInfo,
+	payload *PullReqMentionedPayload,
+) error {
+	email, err := GenerateEmailFromPayload(
+		TemplatePullReqMentions,
+		recipients,
+		payload.Base,
+		payload,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to generate mail requests after processing %s event: %w",
+			pullreqevents.MentionedEvent, err)
+	}
+
+	return m.Mailer.Send(ctx, *email)
+}
+
 func (m MailClient) SendReviewerAdded(
 	ctx context.Context,
 	recipients []*types.PrincipalInfo,
@@ -169,6 +192,46 @@ func (m MailClient) SendPullReqStateChanged(
 	return m.Mailer.Send(ctx, *email)
 }
 
+func (m MailClient) SendReviewerReminder(
+	ctx context.Context,
+	recipients []*types.PrincipalInfo,
+	payload *ReviewerReminderPayload,
+) error {
+	email, err := GenerateEmailFromPayload(TemplateReviewerReminder, recipients, payload.Base, payload)
+	if err != nil {
+		return fmt.Errorf("failed to generate mail requests for reviewer reminder: %w", err)
+	}
+
+	return m.Mailer.Send(ctx, *email)
+}
+
+func (m MailClient) SendPipelineExecuted(
+	ctx context.Context,
+	recipients []*types.PrincipalInfo,
+	payload *PipelineExecutedPayload,
+) error {
+	body, err := GetHTMLBody(TemplatePipelineExecuted, payload)
+	if err != nil {
+		return fmt.Errorf("failed to generate html body for pipeline execution: %w", err)
+	}
+
+	plainBody, err := GetTextBody(TemplatePipelineExecutedText, payload)
+	if err != nil {
+		return fmt.Errorf("failed to generate plaintext body for pipeline execution: %w", err)
+	}
+
+	email := mailer.Payload{
+		ToRecipients: RetrieveEmailsFromPrincipals(recipients),
+		Subject: fmt.Sprintf(subjectPipelineExecuted, payload.Repo.Identifier, payload.Pipeline.Identifier,
+			payload.Execution.Number, payload.Execution.Status),
+		Body:      string(body),
+		PlainBody: string(plainBody),
+		RepoRef:   payload.Repo.Path,
+	}
+
+	return m.Mailer.Send(ctx, email)
+}
+
 func GetSubjectPullRequest(
 	repoIdentifier string,
 	prNum int64,
@@ -188,6 +251,17 @@ func GetHTMLBody(templateName string, data interface{}) ([]byte, error) {
 	return tmplOutput.Bytes(), nil
 }
 
+func GetTextBody(templateName string, data interface{}) ([]byte, error) {
+	tmpl := textTemplates[templateName]
+	tmplOutput := bytes.Buffer{}
+	err := tmpl.Execute(&tmplOutput, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute template %s", templateName)
+	}
+
+	return tmplOutput.Bytes(), nil
+}
+
 func GenerateEmailFromPayload(
 	templateName string,
 	recipients []*types.PrincipalInfo,
@@ -17,10 +17,25 @@ package mailer
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
 
 	gomail "gopkg.in/mail.v2"
 )
 
+// TLSMode defines how the connection to the SMTP server is secured.
+type TLSMode string
+
+const (
+	// TLSModeAuto uses implicit TLS on port 465 and opportunistic STARTTLS otherwise.
+	TLSModeAuto TLSMode = ""
+	// TLSModeImplicit always connects using implicit TLS.
+	TLSModeImplicit TLSMode = "tls"
+	// TLSModeStartTLS requires the server to support STARTTLS.
+	TLSModeStartTLS TLSMode = "starttls"
+	// TLSModeNone never upgrades the connection using STARTTLS.
+	TLSModeNone TLSMode = "none"
+)
+
 type GoMailClient struct {
 	dialer   *gomail.Dialer
 	fromMail string
@@ -33,14 +48,29 @@ func NewMailClient(
 	fromMail string,
 	password string,
 	insecure bool,
-) GoMailClient {
+	tlsMode TLSMode,
+) (GoMailClient, error) {
 	d := gomail.NewDialer(host, port, username, password)
 	d.TLSConfig = &tls.Config{InsecureSkipVerify: insecure} // #nosec G402 (insecure TLS configuration)
 
+	switch tlsMode {
+	case TLSModeAuto:
+	case TLSModeImplicit:
+		d.SSL = true
+	case TLSModeStartTLS:
+		d.SSL = false
+		d.StartTLSPolicy = gomail.MandatoryStartTLS
+	case TLSModeNone:
+		d.SSL = false
+		d.StartTLSPolicy = gomail.NoStartTLS
+	default:
+		return GoMailClient{}, fmt.Errorf("unknown smtp tls mode %q", tlsMode)
+	}
+
 	return GoMailClient{
 		dialer:   d,
 		fromMail: fromMail,
-	}
+	}, nil
 }
 
 func (c GoMailClient) Send(_ context.Context, mailPayload Payload) error {
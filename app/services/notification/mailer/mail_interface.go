@@ -21,7 +21,8 @@ import (
 )
 
 const (
-	mailContentType = "text/html"
+	mailContentType      = "text/html"
+	mailPlainContentType = "text/plain"
 )
 
 type Mailer interface {
@@ -35,6 +36,8 @@ type Payload struct {
 	Body         string
 	ContentType  string
 	RepoRef      string
+	// PlainBody is an optional plaintext alternative of the body.
+	PlainBody string
 }
 
 func ToGoMail(dto Payload) *gomail.Message {
@@ -42,6 +45,19 @@ func ToGoMail(dto Payload) *gomail.Message {
 	mail.SetHeader("To", dto.ToRecipients...)
 	mail.SetHeader("Cc", dto.CCRecipients...)
 	mail.SetHeader("Subject", dto.Subject)
-	mail.SetBody(mailContentType, dto.Body)
+
+	contentType := dto.ContentType
+	if contentType == "" {
+		contentType = mailContentType
+	}
+
+	if dto.PlainBody == "" {
+		mail.SetBody(contentType, dto.Body)
+		return mail
+	}
+
+	// the plaintext part goes first as clients prefer the last alternative they can render.
+	mail.SetBody(mailPlainContentType, dto.PlainBody)
+	mail.AddAlternative(contentType, dto.Body)
 	return mail
 }
@@ -24,7 +24,7 @@ var WireSet = wire.NewSet(
 	ProvideMailClient,
 )
 
-func ProvideMailClient(config *types.Config) Mailer {
+func ProvideMailClient(config *types.Config) (Mailer, error) {
 	return NewMailClient(
 		config.SMTP.Host,
 		config.SMTP.Port,
@@ -32,5 +32,6 @@ func ProvideMailClient(config *types.Config) Mailer {
 		config.SMTP.FromMail,
 		config.SMTP.Password,
 		config.SMTP.Insecure, // #nosec G402 (insecure skipVerify configuration)
+		TLSMode(config.SMTP.TLSMode),
 	)
 }
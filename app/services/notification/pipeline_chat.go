@@ -0,0 +1,69 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+const (
+	chatColorSuccess = "#2eb886"
+	chatColorFailure = "#d00000"
+)
+
+// chatAttachment is a slack compatible message attachment.
+// Slack, Mattermost and Rocket.Chat share the format, though not all fields are supported by all of them.
+type chatAttachment struct {
+	Fallback  string      `json:"fallback,omitempty"`
+	Color     string      `json:"color"`
+	Title     string      `json:"title"`
+	TitleLink string      `json:"title_link"`
+	Text      string      `json:"text,omitempty"`
+	Fields    []chatField `json:"fields,omitempty"`
+}
+
+type chatField struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+	Short bool   `json:"short"`
+}
+
+// newChatAttachment builds the slack compatible attachment of a pipeline message.
+func newChatAttachment(msg pipelineMessage) chatAttachment {
+	color := chatColorSuccess
+	if msg.Failed() {
+		color = chatColorFailure
+	}
+
+	attachment := chatAttachment{
+		Fallback:  msg.Summary() + " " + msg.URL,
+		Color:     color,
+		Title:     msg.Summary(),
+		TitleLink: msg.URL,
+	}
+
+	if msg.CustomBody != "" {
+		attachment.Text = msg.CustomBody
+		return attachment
+	}
+
+	attachment.Fields = make([]chatField, 0, len(msg.Fields()))
+	for _, field := range msg.Fields() {
+		attachment.Fields = append(attachment.Fields, chatField{
+			Title: field[0],
+			Value: field[1],
+			// long values are shown on their own line.
+			Short: field[0] != "Commit" && field[0] != "Error",
+		})
+	}
+
+	return attachment
+}
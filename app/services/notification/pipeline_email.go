@@ -0,0 +1,142 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/auth/authz"
+	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// emailPipelineSender emails the result of pipeline executions to the commit author and the configured
+// recipients. Only registered users with access to the repository of the execution are notified.
+type emailPipelineSender struct {
+	config             PipelineConfig
+	notificationClient Client
+	principalStore     store.PrincipalStore
+	authorizer         authz.Authorizer
+}
+
+func newEmailPipelineSender(
+	config PipelineConfig,
+	notificationClient Client,
+	principalStore store.PrincipalStore,
+	authorizer authz.Authorizer,
+) *emailPipelineSender {
+	return &emailPipelineSender{
+		config:             config,
+		notificationClient: notificationClient,
+		principalStore:     principalStore,
+		authorizer:         authorizer,
+	}
+}
+
+func (s *emailPipelineSender) Name() string {
+	return "email"
+}
+
+func (s *emailPipelineSender) Send(ctx context.Context, payload *PipelineExecutedPayload) error {
+	if !containsStatus(s.config.Statuses, payload.Execution.Status) {
+		return nil
+	}
+
+	recipients, err := s.recipients(ctx, payload.Repo, payload.Execution)
+	if err != nil {
+		return err
+	}
+
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	return s.notificationClient.SendPipelineExecuted(ctx, recipients, payload)
+}
+
+// recipients returns the deduplicated list of users that are notified about the execution.
+// The git author email isn't verified, so it's only notified if it belongs to a user, as the recipients.
+func (s *emailPipelineSender) recipients(
+	ctx context.Context,
+	repo *types.Repository,
+	execution *types.Execution,
+) ([]*types.PrincipalInfo, error) {
+	seen := make(map[int64]struct{})
+	recipients := make([]*types.PrincipalInfo, 0, len(s.config.Recipients)+1)
+
+	add := func(email string) error {
+		email = strings.TrimSpace(email)
+		if email == "" {
+			return nil
+		}
+
+		principal, err := s.principalStore.FindByEmail(ctx, email)
+		if errors.Is(err, gitness_store.ErrResourceNotFound) {
+			log.Ctx(ctx).Debug().Msgf("skipping pipeline email to %q, it doesn't belong to a user", email)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to find principal by email: %w", err)
+		}
+
+		if _, ok := seen[principal.ID]; ok {
+			return nil
+		}
+		seen[principal.ID] = struct{}{}
+
+		if principal.Blocked || principal.Type != enum.PrincipalTypeUser {
+			return nil
+		}
+
+		err = apiauth.CheckRepo(ctx, s.authorizer, &auth.Session{
+			Principal: *principal,
+			Metadata:  nil,
+		}, repo, enum.PermissionRepoView)
+		if errors.Is(err, apiauth.ErrNotAuthorized) {
+			log.Ctx(ctx).Debug().Msgf("skipping pipeline email to %s, no access to the repository", principal.UID)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to check repository access of %s: %w", principal.UID, err)
+		}
+
+		recipients = append(recipients, principal.ToPrincipalInfo())
+
+		return nil
+	}
+
+	if s.config.NotifyAuthor {
+		if err := add(execution.AuthorEmail); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, email := range s.config.Recipients {
+		if err := add(email); err != nil {
+			return nil, err
+		}
+	}
+
+	return recipients, nil
+}
@@ -0,0 +1,139 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/harness/gitness/app/auth"
+	"github.com/harness/gitness/app/auth/authz"
+	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// emailPrincipalStore finds the principals by email, all other methods aren't implemented.
+type emailPrincipalStore struct {
+	store.PrincipalStore
+	principals []*types.Principal
+}
+
+func (s *emailPrincipalStore) FindByEmail(_ context.Context, email string) (*types.Principal, error) {
+	for _, principal := range s.principals {
+		if strings.EqualFold(principal.Email, email) {
+			return principal, nil
+		}
+	}
+	return nil, gitness_store.ErrResourceNotFound
+}
+
+// principalAuthorizer permits the actions of the allowed principals.
+type principalAuthorizer struct {
+	authz.Authorizer
+	allowed map[int64]bool
+}
+
+func (a *principalAuthorizer) Check(
+	_ context.Context,
+	session *auth.Session,
+	_ *types.Scope,
+	_ *types.Resource,
+	_ enum.Permission,
+) (bool, error) {
+	return a.allowed[session.Principal.ID], nil
+}
+
+// recordingClient records the recipients of the pipeline emails.
+type recordingClient struct {
+	Client
+	recipients []*types.PrincipalInfo
+}
+
+func (c *recordingClient) SendPipelineExecuted(
+	_ context.Context,
+	recipients []*types.PrincipalInfo,
+	_ *PipelineExecutedPayload,
+) error {
+	c.recipients = append(c.recipients, recipients...)
+	return nil
+}
+
+func TestEmailPipelineSender_Send(t *testing.T) {
+	principals := []*types.Principal{
+		{ID: 1, UID: "author", Email: "author@example.com", Type: enum.PrincipalTypeUser},
+		{ID: 2, UID: "reader", Email: "reader@example.com", Type: enum.PrincipalTypeUser},
+		{ID: 3, UID: "outsider", Email: "outsider@example.com", Type: enum.PrincipalTypeUser},
+		{ID: 4, UID: "blocked", Email: "blocked@example.com", Type: enum.PrincipalTypeUser, Blocked: true},
+	}
+
+	tests := []struct {
+		name        string
+		authorEmail string
+		recipients  []string
+		want        []string
+	}{
+		{
+			name:        "author and recipients with access",
+			authorEmail: "Author@example.com",
+			recipients:  []string{"reader@example.com", "author@example.com"},
+			want:        []string{"author", "reader"},
+		},
+		{
+			name:        "unregistered author",
+			authorEmail: "someone@example.com",
+			recipients:  []string{"reader@example.com"},
+			want:        []string{"reader"},
+		},
+		{
+			name:        "recipients without access",
+			authorEmail: "outsider@example.com",
+			recipients:  []string{"blocked@example.com", "list@example.com"},
+			want:        nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := &recordingClient{}
+			sender := newEmailPipelineSender(PipelineConfig{
+				Statuses:     []enum.CIStatus{enum.CIStatusFailure},
+				NotifyAuthor: true,
+				Recipients:   test.recipients,
+			}, client,
+				&emailPrincipalStore{principals: principals},
+				&principalAuthorizer{allowed: map[int64]bool{1: true, 2: true, 4: true}})
+
+			err := sender.Send(context.Background(), &PipelineExecutedPayload{
+				Repo:      &types.Repository{Path: "space/repo"},
+				Pipeline:  &types.Pipeline{Identifier: "build"},
+				Execution: &types.Execution{Status: enum.CIStatusFailure, AuthorEmail: test.authorEmail},
+			})
+			if err != nil {
+				t.Fatalf("Send() failed: %v", err)
+			}
+
+			got := make([]string, len(client.recipients))
+			for i, recipient := range client.recipients {
+				got[i] = recipient.UID
+			}
+			if strings.Join(got, ",") != strings.Join(test.want, ",") {
+				t.Errorf("recipients = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
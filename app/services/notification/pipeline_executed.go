@@ -0,0 +1,404 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/app/auth/authz"
+	pipelineevents "github.com/harness/gitness/app/events/pipeline"
+	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/app/url"
+	"github.com/harness/gitness/events"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/stream"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	pipelineEventReaderGroupName = "gitness:notification:pipeline"
+	subjectPipelineExecuted      = "[%s] Pipeline %s #%d: %s"
+)
+
+type PipelineConfig struct {
+	EventReaderName string
+	Concurrency     int
+	MaxRetries      int
+
+	// Statuses are the execution statuses for which an email is sent.
+	Statuses []enum.CIStatus
+	// NotifyAuthor controls whether the commit author is notified, if the author email belongs to a user.
+	NotifyAuthor bool
+	// Recipients are the email addresses of additional users notified about the executions
+	// of the repositories they have access to.
+	// NOTE: All other channels are server-wide and can't check access, they're only enabled
+	// for the repositories matching the Repos of their filter.
+	Recipients []string
+	// Filter limits the executions for which an email is sent.
+	Filter PipelineFilterConfig
+
+	Telegram   TelegramConfig
+	PagerDuty  PagerDutyConfig
+	Ntfy       NtfyConfig
+	Gotify     GotifyConfig
+	IRC        IRCConfig
+	Mattermost MattermostConfig
+	RocketChat RocketChatConfig
+	Pushover   PushoverConfig
+	SNS        SNSConfig
+	Twilio     TwilioConfig
+	Slack      SlackConfig
+
+	Template PipelineTemplateConfig
+}
+
+type PipelineExecutedPayload struct {
+	Repo      *types.Repository
+	Pipeline  *types.Pipeline
+	Execution *types.Execution
+	// Previous is the previous completed execution of the pipeline for the same ref, nil if there is none.
+	Previous     *types.Execution
+	Stages       []*types.Stage
+	Duration     time.Duration
+	ExecutionURL string
+
+	// Title and Body are the rendered custom templates of the message, empty if no template is configured.
+	Title string
+	Body  string
+}
+
+// pipelineSender sends the result of a pipeline execution to a single notification channel.
+type pipelineSender interface {
+	// Name returns the name of the notification channel.
+	Name() string
+	// Send sends the notification, the sender decides whether the execution is relevant for the channel.
+	Send(ctx context.Context, payload *PipelineExecutedPayload) error
+}
+
+// pipelineStartedSender is implemented by senders that also notify about started executions.
+type pipelineStartedSender interface {
+	pipelineSender
+	// SendStarted sends the notification about the start of the execution.
+	SendStarted(ctx context.Context, payload *PipelineExecutedPayload) error
+	// Discard is called instead of Send for executions that are filtered out once finished,
+	// to allow the sender to clean up the notification about the start of the execution.
+	Discard(ctx context.Context, payload *PipelineExecutedPayload) error
+}
+
+// PipelineNotifier sends the result of pipeline executions to all configured notification channels.
+type PipelineNotifier struct {
+	senders        []pipelineSender
+	startedSenders []pipelineStartedSender
+	templates      *pipelineTemplates
+	repoStore      store.RepoStore
+	pipelineStore  store.PipelineStore
+	executionStore store.ExecutionStore
+	stageStore     store.StageStore
+	urlProvider    url.Provider
+}
+
+func NewPipelineNotifier(
+	ctx context.Context,
+	config PipelineConfig,
+	notificationClient Client,
+	pipelineReaderFactory *events.ReaderFactory[*pipelineevents.Reader],
+	principalStore store.PrincipalStore,
+	authorizer authz.Authorizer,
+	protectionManager *protection.Manager,
+	repoStore store.RepoStore,
+	pipelineStore store.PipelineStore,
+	executionStore store.ExecutionStore,
+	stageStore store.StageStore,
+	slackMessageStore store.SlackMessageStore,
+	urlProvider url.Provider,
+) (*PipelineNotifier, error) {
+	senders, err := newPipelineSenders(ctx, config, notificationClient,
+		principalStore, authorizer, protectionManager, slackMessageStore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pipeline notification senders: %w", err)
+	}
+
+	templates, err := newPipelineTemplates(config.Template)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pipeline notification templates: %w", err)
+	}
+
+	var startedSenders []pipelineStartedSender
+	for _, sender := range senders {
+		if startedSender, ok := sender.(pipelineStartedSender); ok {
+			startedSenders = append(startedSenders, startedSender)
+		}
+	}
+
+	notifier := &PipelineNotifier{
+		senders:        senders,
+		startedSenders: startedSenders,
+		templates:      templates,
+		repoStore:      repoStore,
+		pipelineStore:  pipelineStore,
+		executionStore: executionStore,
+		stageStore:     stageStore,
+		urlProvider:    urlProvider,
+	}
+
+	if len(notifier.senders) == 0 {
+		log.Ctx(ctx).Info().Msg("pipeline notifications are disabled")
+		return notifier, nil
+	}
+
+	_, err = pipelineReaderFactory.Launch(
+		ctx,
+		pipelineEventReaderGroupName,
+		config.EventReaderName,
+		func(r *pipelineevents.Reader) error {
+			r.Configure(
+				stream.WithConcurrency(config.Concurrency),
+				stream.WithHandlerOptions(
+					stream.WithMaxRetries(config.MaxRetries),
+				))
+
+			_ = r.RegisterExecuted(notifier.notifyExecuted)
+			if len(notifier.startedSenders) > 0 {
+				_ = r.RegisterStarted(notifier.notifyStarted)
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to launch event reader for %s: %w", pipelineEventReaderGroupName, err)
+	}
+
+	return notifier, nil
+}
+
+// newPipelineSenders returns the senders of all notification channels that are configured.
+func newPipelineSenders(
+	ctx context.Context,
+	config PipelineConfig,
+	notificationClient Client,
+	principalStore store.PrincipalStore,
+	authorizer authz.Authorizer,
+	protectionManager *protection.Manager,
+	slackMessageStore store.SlackMessageStore,
+) ([]pipelineSender, error) {
+	var senders []pipelineSender
+
+	if len(config.Statuses) > 0 {
+		senders = append(senders, withPipelineFilter(
+			newEmailPipelineSender(config, notificationClient, principalStore, authorizer), config.Filter))
+	}
+
+	// all other channels are server-wide and can't check access to the repositories,
+	// they're only enabled for the repositories an admin opted in.
+	addChannel := func(sender pipelineSender, filter PipelineFilterConfig) {
+		if len(filter.Repos) == 0 {
+			log.Ctx(ctx).Warn().Str("channel", sender.Name()).
+				Msg("pipeline notification channel is disabled as no repositories are opted in")
+			return
+		}
+
+		senders = append(senders, withPipelineFilter(sender, filter))
+	}
+
+	if config.Telegram.BotToken != "" && config.Telegram.ChatID != "" {
+		addChannel(newTelegramPipelineSender(config.Telegram), config.Telegram.Filter)
+	}
+
+	if config.PagerDuty.RoutingKey != "" {
+		addChannel(newPagerDutyPipelineSender(config.PagerDuty, protectionManager), config.PagerDuty.Filter)
+	}
+
+	if config.Ntfy.URL != "" && config.Ntfy.Topic != "" {
+		addChannel(newNtfyPipelineSender(config.Ntfy), config.Ntfy.Filter)
+	}
+
+	if config.Gotify.URL != "" && config.Gotify.Token != "" {
+		addChannel(newGotifyPipelineSender(config.Gotify), config.Gotify.Filter)
+	}
+
+	if config.IRC.Server != "" && len(config.IRC.Channels) > 0 {
+		addChannel(newIRCPipelineSender(config.IRC), config.IRC.Filter)
+	}
+
+	if config.Mattermost.WebhookURL != "" {
+		addChannel(newMattermostPipelineSender(config.Mattermost), config.Mattermost.Filter)
+	}
+
+	if config.RocketChat.WebhookURL != "" {
+		addChannel(newRocketChatPipelineSender(config.RocketChat), config.RocketChat.Filter)
+	}
+
+	if config.Pushover.AppToken != "" && len(config.Pushover.UserKeys) > 0 {
+		addChannel(newPushoverPipelineSender(config.Pushover), config.Pushover.Filter)
+	}
+
+	if config.SNS.TopicARN != "" {
+		sender, err := newSNSPipelineSender(config.SNS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create sns sender: %w", err)
+		}
+		addChannel(sender, config.SNS.Filter)
+	}
+
+	if config.Twilio.AccountSID != "" && len(config.Twilio.To) > 0 {
+		addChannel(newTwilioPipelineSender(config.Twilio), config.Twilio.Filter)
+	}
+
+	switch {
+	case config.Slack.BotToken != "" && config.Slack.Channel != "":
+		addChannel(newSlackBotPipelineSender(config.Slack, slackMessageStore), config.Slack.Filter)
+	case config.Slack.WebhookURL != "":
+		addChannel(newSlackWebhookPipelineSender(config.Slack), config.Slack.Filter)
+	}
+
+	return senders, nil
+}
+
+func (n *PipelineNotifier) notifyExecuted(
+	ctx context.Context,
+	event *events.Event[*pipelineevents.ExecutedPayload],
+) error {
+	payload, err := n.loadPayload(ctx, event.Payload.RepoID, event.Payload.PipelineID, event.Payload.ExecutionNum)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to process %s event for pipelineID %d: %w",
+			pipelineevents.ExecutedEvent,
+			event.Payload.PipelineID,
+			err,
+		)
+	}
+
+	// Failures are only logged. Returning an error would retry the event,
+	// and resend the notification through all the channels that already succeeded.
+	for _, sender := range n.senders {
+		if err := sender.Send(ctx, payload); err != nil {
+			log.Ctx(ctx).Warn().Err(err).
+				Str("channel", sender.Name()).
+				Int64("pipeline_id", event.Payload.PipelineID).
+				Int64("execution_number", event.Payload.ExecutionNum).
+				Msgf("failed to send %s notification", pipelineevents.ExecutedEvent)
+		}
+	}
+
+	return nil
+}
+
+func (n *PipelineNotifier) notifyStarted(
+	ctx context.Context,
+	event *events.Event[*pipelineevents.StartedPayload],
+) error {
+	payload, err := n.loadPayload(ctx, event.Payload.RepoID, event.Payload.PipelineID, event.Payload.ExecutionNum)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to process %s event for pipelineID %d: %w",
+			pipelineevents.StartedEvent,
+			event.Payload.PipelineID,
+			err,
+		)
+	}
+
+	// the event might be processed after the execution already finished, e.g. because of retries.
+	if payload.Execution.Status.IsDone() {
+		return nil
+	}
+
+	// Failures are only logged, for the same reason as in notifyExecuted.
+	for _, sender := range n.startedSenders {
+		if err := sender.SendStarted(ctx, payload); err != nil {
+			log.Ctx(ctx).Warn().Err(err).
+				Str("channel", sender.Name()).
+				Int64("pipeline_id", event.Payload.PipelineID).
+				Int64("execution_number", event.Payload.ExecutionNum).
+				Msgf("failed to send %s notification", pipelineevents.StartedEvent)
+		}
+	}
+
+	return nil
+}
+
+// loadPayload returns the payload of the notifications of the execution.
+func (n *PipelineNotifier) loadPayload(
+	ctx context.Context,
+	repoID int64,
+	pipelineID int64,
+	executionNum int64,
+) (*PipelineExecutedPayload, error) {
+	repo, err := n.repoStore.Find(ctx, repoID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch repo from repoStore: %w", err)
+	}
+
+	pipeline, err := n.pipelineStore.Find(ctx, pipelineID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch pipeline from pipelineStore: %w", err)
+	}
+
+	execution, err := n.executionStore.FindByNumber(ctx, pipeline.ID, executionNum)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch execution from executionStore: %w", err)
+	}
+
+	previous, err := n.executionStore.FindPreviousByRef(ctx, pipeline.ID, execution.Ref, execution.Number)
+	if errors.Is(err, gitness_store.ErrResourceNotFound) {
+		previous = nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to fetch previous execution from executionStore: %w", err)
+	}
+
+	stages, err := n.stageStore.ListWithSteps(ctx, execution.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch stages from stageStore: %w", err)
+	}
+
+	var duration time.Duration
+	if execution.Started > 0 && execution.Finished > execution.Started {
+		duration = time.Duration(execution.Finished-execution.Started) * time.Millisecond
+	}
+
+	payload := &PipelineExecutedPayload{
+		Repo:         repo,
+		Pipeline:     pipeline,
+		Execution:    execution,
+		Previous:     previous,
+		Stages:       stages,
+		Duration:     duration,
+		ExecutionURL: n.urlProvider.GenerateUIBuildURL(ctx, repo.Path, pipeline.Identifier, execution.Number),
+	}
+
+	// a broken template shouldn't prevent the notification, the default message is used instead.
+	payload.Title, payload.Body, err = n.templates.Render(payload)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("failed to render pipeline notification templates, using defaults")
+	}
+
+	return payload, nil
+}
+
+// containsStatus returns true if the status is part of the provided list of statuses.
+func containsStatus(statuses []enum.CIStatus, status enum.CIStatus) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,181 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"strings"
+
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// PipelineFilterConfig defines which executions are relevant for a notification channel.
+// Empty values don't filter any executions.
+type PipelineFilterConfig struct {
+	// OnChange limits the notifications to executions whose result differs from
+	// the result of the previous execution of the same pipeline and ref (i.e. fixed or broken).
+	OnChange bool
+	// Branches are the glob patterns of the branches the notifications are limited to.
+	// Pull request executions are matched using their target branch.
+	Branches []string
+	// Events are the trigger events the notifications are limited to.
+	Events []enum.TriggerEvent
+	// Repos are the glob patterns of the repository paths the notifications are limited to.
+	Repos []string
+}
+
+// Matches returns true if the execution of the payload passes the filter.
+func (f PipelineFilterConfig) Matches(payload *PipelineExecutedPayload) bool {
+	if !f.matchesTrigger(payload) {
+		return false
+	}
+
+	if f.OnChange && !pipelineStatusChanged(payload) {
+		return false
+	}
+
+	return true
+}
+
+// matchesTrigger returns true if the branch and event of the execution pass the filter.
+// Unlike Matches, it doesn't depend on the result of the execution.
+func (f PipelineFilterConfig) matchesTrigger(payload *PipelineExecutedPayload) bool {
+	execution := payload.Execution
+
+	if len(f.Repos) > 0 && !matchesAnyPattern(f.Repos, payload.Repo.Path) {
+		return false
+	}
+
+	if len(f.Events) > 0 && !containsTriggerEvent(f.Events, execution.Event) {
+		return false
+	}
+
+	if len(f.Branches) > 0 {
+		branch, ok := pipelineExecutionBranch(payload)
+		if !ok || !matchesAnyPattern(f.Branches, branch) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// filteredPipelineSender only forwards the executions that pass the filter to the wrapped sender.
+type filteredPipelineSender struct {
+	pipelineSender
+	filter PipelineFilterConfig
+}
+
+func withPipelineFilter(sender pipelineSender, filter PipelineFilterConfig) pipelineSender {
+	if !filter.OnChange && len(filter.Branches) == 0 && len(filter.Events) == 0 && len(filter.Repos) == 0 {
+		return sender
+	}
+
+	filtered := &filteredPipelineSender{
+		pipelineSender: sender,
+		filter:         filter,
+	}
+
+	if startedSender, ok := sender.(pipelineStartedSender); ok {
+		return &filteredPipelineStartedSender{
+			filteredPipelineSender: filtered,
+			sender:                 startedSender,
+		}
+	}
+
+	return filtered
+}
+
+func (s *filteredPipelineSender) Send(ctx context.Context, payload *PipelineExecutedPayload) error {
+	if !s.filter.Matches(payload) {
+		return nil
+	}
+
+	return s.pipelineSender.Send(ctx, payload)
+}
+
+// filteredPipelineStartedSender is the filteredPipelineSender of senders that notify about started executions.
+type filteredPipelineStartedSender struct {
+	*filteredPipelineSender
+	sender pipelineStartedSender
+}
+
+func (s *filteredPipelineStartedSender) SendStarted(ctx context.Context, payload *PipelineExecutedPayload) error {
+	if !s.filter.matchesTrigger(payload) {
+		return nil
+	}
+
+	return s.sender.SendStarted(ctx, payload)
+}
+
+func (s *filteredPipelineStartedSender) Send(ctx context.Context, payload *PipelineExecutedPayload) error {
+	if !s.filter.matchesTrigger(payload) {
+		return nil
+	}
+
+	// whether the status changed is only known once the execution finished.
+	if !s.filter.Matches(payload) {
+		return s.sender.Discard(ctx, payload)
+	}
+
+	return s.sender.Send(ctx, payload)
+}
+
+func (s *filteredPipelineStartedSender) Discard(ctx context.Context, payload *PipelineExecutedPayload) error {
+	return s.sender.Discard(ctx, payload)
+}
+
+// pipelineStatusChanged returns true if the execution succeeded while the previous one failed or vice versa.
+// The first execution of a ref is always considered a change.
+func pipelineStatusChanged(payload *PipelineExecutedPayload) bool {
+	if payload.Previous == nil {
+		return true
+	}
+
+	return payload.Previous.Status.IsFailed() != payload.Execution.Status.IsFailed()
+}
+
+// pipelineExecutionBranch returns the branch of the execution, or the target branch for pull requests.
+func pipelineExecutionBranch(payload *PipelineExecutedPayload) (string, bool) {
+	execution := payload.Execution
+	if execution.Event == enum.TriggerEventPullRequest {
+		return execution.Target, execution.Target != ""
+	}
+
+	return strings.CutPrefix(execution.Ref, "refs/heads/")
+}
+
+// matchesAnyPattern returns true if the name matches any of the glob patterns.
+func matchesAnyPattern(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := doublestar.Match(pattern, name); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsTriggerEvent(events []enum.TriggerEvent, event enum.TriggerEvent) bool {
+	for _, e := range events {
+		if e == event {
+			return true
+		}
+	}
+
+	return false
+}
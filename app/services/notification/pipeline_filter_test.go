@@ -0,0 +1,230 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"testing"
+
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+type recordingPipelineSender struct {
+	calls []string
+}
+
+func (s *recordingPipelineSender) Name() string { return "recording" }
+
+func (s *recordingPipelineSender) Send(context.Context, *PipelineExecutedPayload) error {
+	s.calls = append(s.calls, "send")
+	return nil
+}
+
+type recordingPipelineStartedSender struct {
+	recordingPipelineSender
+}
+
+func (s *recordingPipelineStartedSender) SendStarted(context.Context, *PipelineExecutedPayload) error {
+	s.calls = append(s.calls, "started")
+	return nil
+}
+
+func (s *recordingPipelineStartedSender) Discard(context.Context, *PipelineExecutedPayload) error {
+	s.calls = append(s.calls, "discard")
+	return nil
+}
+
+func pipelineFilterPayload(
+	event enum.TriggerEvent,
+	ref string,
+	status enum.CIStatus,
+	previous *enum.CIStatus,
+) *PipelineExecutedPayload {
+	payload := &PipelineExecutedPayload{
+		Repo:      &types.Repository{Path: "space/repo"},
+		Execution: &types.Execution{Event: event, Ref: ref, Target: "main", Status: status},
+	}
+	if previous != nil {
+		payload.Previous = &types.Execution{Status: *previous}
+	}
+
+	return payload
+}
+
+func TestPipelineFilterConfig_Matches(t *testing.T) {
+	success := enum.CIStatusSuccess
+	failure := enum.CIStatusFailure
+
+	tests := []struct {
+		name    string
+		filter  PipelineFilterConfig
+		payload *PipelineExecutedPayload
+		want    bool
+	}{
+		{
+			name:    "empty filter",
+			payload: pipelineFilterPayload(enum.TriggerEventPush, "refs/heads/dev", enum.CIStatusFailure, &failure),
+			want:    true,
+		},
+		{
+			name:    "branch matches",
+			filter:  PipelineFilterConfig{Branches: []string{"release/**"}},
+			payload: pipelineFilterPayload(enum.TriggerEventPush, "refs/heads/release/1.0", enum.CIStatusSuccess, nil),
+			want:    true,
+		},
+		{
+			name:    "branch doesn't match",
+			filter:  PipelineFilterConfig{Branches: []string{"main"}},
+			payload: pipelineFilterPayload(enum.TriggerEventPush, "refs/heads/dev", enum.CIStatusSuccess, nil),
+			want:    false,
+		},
+		{
+			name:    "tag doesn't match a branch",
+			filter:  PipelineFilterConfig{Branches: []string{"*"}},
+			payload: pipelineFilterPayload(enum.TriggerEventTag, "refs/tags/v1.0", enum.CIStatusSuccess, nil),
+			want:    false,
+		},
+		{
+			name:    "pull request matches the target branch",
+			filter:  PipelineFilterConfig{Branches: []string{"main"}},
+			payload: pipelineFilterPayload(enum.TriggerEventPullRequest, "refs/pullreq/1/head", enum.CIStatusSuccess, nil),
+			want:    true,
+		},
+		{
+			name:    "event doesn't match",
+			filter:  PipelineFilterConfig{Events: []enum.TriggerEvent{enum.TriggerEventPush}},
+			payload: pipelineFilterPayload(enum.TriggerEventManual, "refs/heads/main", enum.CIStatusSuccess, nil),
+			want:    false,
+		},
+		{
+			name:    "repo matches",
+			filter:  PipelineFilterConfig{Repos: []string{"space/**"}},
+			payload: pipelineFilterPayload(enum.TriggerEventPush, "refs/heads/main", enum.CIStatusSuccess, nil),
+			want:    true,
+		},
+		{
+			name:    "repo doesn't match",
+			filter:  PipelineFilterConfig{Repos: []string{"other/**"}},
+			payload: pipelineFilterPayload(enum.TriggerEventPush, "refs/heads/main", enum.CIStatusSuccess, nil),
+			want:    false,
+		},
+		{
+			name:    "on change without previous execution",
+			filter:  PipelineFilterConfig{OnChange: true},
+			payload: pipelineFilterPayload(enum.TriggerEventPush, "refs/heads/main", enum.CIStatusSuccess, nil),
+			want:    true,
+		},
+		{
+			name:    "on change fixed",
+			filter:  PipelineFilterConfig{OnChange: true},
+			payload: pipelineFilterPayload(enum.TriggerEventPush, "refs/heads/main", enum.CIStatusSuccess, &failure),
+			want:    true,
+		},
+		{
+			name:    "on change broken",
+			filter:  PipelineFilterConfig{OnChange: true},
+			payload: pipelineFilterPayload(enum.TriggerEventPush, "refs/heads/main", enum.CIStatusError, &success),
+			want:    true,
+		},
+		{
+			name:    "on change still failing",
+			filter:  PipelineFilterConfig{OnChange: true},
+			payload: pipelineFilterPayload(enum.TriggerEventPush, "refs/heads/main", enum.CIStatusKilled, &failure),
+			want:    false,
+		},
+		{
+			name:    "on change still passing",
+			filter:  PipelineFilterConfig{OnChange: true},
+			payload: pipelineFilterPayload(enum.TriggerEventPush, "refs/heads/main", enum.CIStatusSuccess, &success),
+			want:    false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.filter.Matches(test.payload); got != test.want {
+				t.Errorf("Matches() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
+
+func TestWithPipelineFilter(t *testing.T) {
+	ctx := context.Background()
+	success := enum.CIStatusSuccess
+
+	t.Run("empty filter returns the sender", func(t *testing.T) {
+		sender := &recordingPipelineStartedSender{}
+		if got := withPipelineFilter(sender, PipelineFilterConfig{}); got != pipelineSender(sender) {
+			t.Errorf("withPipelineFilter() = %T, want the unwrapped sender", got)
+		}
+	})
+
+	t.Run("filtered sender", func(t *testing.T) {
+		sender := &recordingPipelineSender{}
+		filtered := withPipelineFilter(sender, PipelineFilterConfig{Branches: []string{"main"}})
+		if _, ok := filtered.(pipelineStartedSender); ok {
+			t.Fatalf("withPipelineFilter() = %T, must not implement pipelineStartedSender", filtered)
+		}
+
+		_ = filtered.Send(ctx, pipelineFilterPayload(enum.TriggerEventPush, "refs/heads/dev", enum.CIStatusFailure, nil))
+		_ = filtered.Send(ctx, pipelineFilterPayload(enum.TriggerEventPush, "refs/heads/main", enum.CIStatusFailure, nil))
+
+		assertPipelineSenderCalls(t, sender.calls, []string{"send"})
+	})
+
+	t.Run("filtered started sender", func(t *testing.T) {
+		sender := &recordingPipelineStartedSender{}
+		filtered, ok := withPipelineFilter(sender, PipelineFilterConfig{
+			OnChange: true,
+			Branches: []string{"main"},
+		}).(pipelineStartedSender)
+		if !ok {
+			t.Fatal("withPipelineFilter() must implement pipelineStartedSender")
+		}
+
+		// the branch doesn't match, neither the start nor the result is sent.
+		dev := pipelineFilterPayload(enum.TriggerEventPush, "refs/heads/dev", enum.CIStatusFailure, nil)
+		_ = filtered.SendStarted(ctx, dev)
+		_ = filtered.Send(ctx, dev)
+		assertPipelineSenderCalls(t, sender.calls, nil)
+
+		// the status didn't change, the start notification is discarded.
+		unchanged := pipelineFilterPayload(enum.TriggerEventPush, "refs/heads/main", enum.CIStatusSuccess, &success)
+		_ = filtered.SendStarted(ctx, unchanged)
+		_ = filtered.Send(ctx, unchanged)
+		assertPipelineSenderCalls(t, sender.calls, []string{"started", "discard"})
+
+		sender.calls = nil
+		changed := pipelineFilterPayload(enum.TriggerEventPush, "refs/heads/main", enum.CIStatusFailure, &success)
+		_ = filtered.SendStarted(ctx, changed)
+		_ = filtered.Send(ctx, changed)
+		assertPipelineSenderCalls(t, sender.calls, []string{"started", "send"})
+	})
+}
+
+func assertPipelineSenderCalls(t *testing.T, got, want []string) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("calls = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("calls = %v, want %v", got, want)
+		}
+	}
+}
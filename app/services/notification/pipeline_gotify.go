@@ -0,0 +1,87 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	gotifyPriorityDefault = 5
+	gotifyPriorityHigh    = 8
+)
+
+type GotifyConfig struct {
+	URL string
+	// Token is the token of the gotify application the messages are sent as.
+	Token string
+	// Statuses are the execution statuses for which a message is sent.
+	Statuses []enum.CIStatus
+	// Filter further limits the executions for which a message is sent.
+	Filter PipelineFilterConfig
+}
+
+// gotifyPipelineSender sends the result of pipeline executions to a gotify server.
+type gotifyPipelineSender struct {
+	config GotifyConfig
+	client *http.Client
+}
+
+func newGotifyPipelineSender(config GotifyConfig) *gotifyPipelineSender {
+	return &gotifyPipelineSender{
+		config: config,
+		client: newPipelineSenderHTTPClient(),
+	}
+}
+
+type gotifyMessage struct {
+	Title    string         `json:"title"`
+	Message  string         `json:"message"`
+	Priority int            `json:"priority"`
+	Extras   map[string]any `json:"extras,omitempty"`
+}
+
+func (s *gotifyPipelineSender) Name() string {
+	return "gotify"
+}
+
+func (s *gotifyPipelineSender) Send(ctx context.Context, payload *PipelineExecutedPayload) error {
+	if !containsStatus(s.config.Statuses, payload.Execution.Status) {
+		return nil
+	}
+
+	msg := newPipelineMessage(payload)
+
+	priority := gotifyPriorityDefault
+	if msg.Failed() {
+		priority = gotifyPriorityHigh
+	}
+
+	return postJSON(ctx, s.client, strings.TrimSuffix(s.config.URL, "/")+"/message", gotifyMessage{
+		Title:    msg.Summary(),
+		Message:  pipelineMessageBody(msg),
+		Priority: priority,
+		Extras: map[string]any{
+			// opens the execution when the notification is clicked in the gotify android app.
+			"client::notification": map[string]any{
+				"click": map[string]string{"url": msg.URL},
+			},
+		},
+	}, map[string]string{"X-Gotify-Key": s.config.Token})
+}
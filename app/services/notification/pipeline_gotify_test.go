@@ -0,0 +1,128 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/harness/gitness/types/enum"
+)
+
+func TestGotifyPipelineSender_Send(t *testing.T) {
+	extras := map[string]any{
+		"client::notification": map[string]any{
+			"click": map[string]any{"url": testExecutionURL},
+		},
+	}
+
+	custom := newTestPipelinePayload(enum.CIStatusSuccess)
+	custom.Title = "Deployed"
+	custom.Body = "v1.2 is live"
+
+	tests := []struct {
+		name    string
+		payload *PipelineExecutedPayload
+		status  int
+		want    *gotifyMessage
+		wantErr bool
+	}{
+		{
+			name:    "execution failed",
+			payload: newTestPipelinePayload(enum.CIStatusFailure),
+			status:  http.StatusOK,
+			want: &gotifyMessage{
+				Title:    "space/repo: build #7 failed",
+				Message:  "Ref: main\nCommit: 01234567 Fix the build\nAuthor: Jane",
+				Priority: gotifyPriorityHigh,
+				Extras:   extras,
+			},
+		},
+		{
+			name:    "custom template",
+			payload: custom,
+			status:  http.StatusOK,
+			want: &gotifyMessage{
+				Title:    "Deployed",
+				Message:  "v1.2 is live",
+				Priority: gotifyPriorityDefault,
+				Extras:   extras,
+			},
+		},
+		{
+			name:    "status not configured",
+			payload: newTestPipelinePayload(enum.CIStatusError),
+			status:  http.StatusOK,
+		},
+		{
+			name:    "error response",
+			payload: newTestPipelinePayload(enum.CIStatusFailure),
+			status:  http.StatusUnauthorized,
+			want: &gotifyMessage{
+				Title:    "space/repo: build #7 failed",
+				Message:  "Ref: main\nCommit: 01234567 Fix the build\nAuthor: Jane",
+				Priority: gotifyPriorityHigh,
+				Extras:   extras,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newRecordingServer(t, respondWith(tt.status))
+
+			sender := newGotifyPipelineSender(GotifyConfig{
+				URL:      server.URL + "/",
+				Token:    "app-token",
+				Statuses: []enum.CIStatus{enum.CIStatusSuccess, enum.CIStatusFailure},
+			})
+
+			err := sender.Send(context.Background(), tt.payload)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Send() err = %v, want error %t", err, tt.wantErr)
+			}
+
+			requests := server.Requests()
+			if tt.want == nil {
+				if len(requests) != 0 {
+					t.Errorf("expected no message, got %d", len(requests))
+				}
+				return
+			}
+			if len(requests) != 1 {
+				t.Fatalf("expected one message, got %d", len(requests))
+			}
+
+			if path := requests[0].Path; path != "/message" {
+				t.Errorf("path = %q, want %q", path, "/message")
+			}
+			if key := requests[0].Header.Get("X-Gotify-Key"); key != "app-token" {
+				t.Errorf("key = %q, want %q", key, "app-token")
+			}
+
+			var got gotifyMessage
+			if err := json.Unmarshal([]byte(requests[0].Body), &got); err != nil {
+				t.Fatalf("failed to decode message: %v", err)
+			}
+			if !reflect.DeepEqual(&got, tt.want) {
+				t.Errorf("message = %+v, want %+v", got, *tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	// ircTimeout defines the time limit for connecting, registering and announcing a result.
+	ircTimeout = 30 * time.Second
+	// ircMaxMessageLength limits the length of a message to stay within the 512 bytes limit of an irc line.
+	ircMaxMessageLength = 400
+
+	ircBold       = "\x02"
+	ircColor      = "\x03"
+	ircColorGreen = "03"
+	ircColorRed   = "04"
+	ircColorGrey  = "14"
+)
+
+type IRCConfig struct {
+	// Server is the address of the irc server in the format host:port.
+	Server   string
+	TLS      bool
+	Nick     string
+	Channels []string
+	// SASLUsername and SASLPassword are used to authenticate using SASL PLAIN if set.
+	SASLUsername string
+	SASLPassword string
+	// Statuses are the execution statuses for which a message is announced.
+	Statuses []enum.CIStatus
+	// Filter further limits the executions for which a message is announced.
+	Filter PipelineFilterConfig
+}
+
+// ircPipelineSender connects to an irc server, joins the configured channels
+// and announces the result of pipeline executions.
+type ircPipelineSender struct {
+	config IRCConfig
+}
+
+func newIRCPipelineSender(config IRCConfig) *ircPipelineSender {
+	return &ircPipelineSender{
+		config: config,
+	}
+}
+
+func (s *ircPipelineSender) Name() string {
+	return "irc"
+}
+
+func (s *ircPipelineSender) Send(ctx context.Context, payload *PipelineExecutedPayload) error {
+	if !containsStatus(s.config.Statuses, payload.Execution.Status) {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ircTimeout)
+	defer cancel()
+
+	conn, err := s.dial(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to irc server: %w", err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	return s.announce(conn, ircText(newPipelineMessage(payload)))
+}
+
+func (s *ircPipelineSender) dial(ctx context.Context) (net.Conn, error) {
+	if s.config.TLS {
+		dialer := &tls.Dialer{}
+		return dialer.DialContext(ctx, "tcp", s.config.Server)
+	}
+
+	dialer := &net.Dialer{}
+	return dialer.DialContext(ctx, "tcp", s.config.Server)
+}
+
+// announce registers the connection, joins the channels and sends the message to all of them.
+//
+//nolint:gocognit // the irc protocol handling is easier to follow in a single loop.
+func (s *ircPipelineSender) announce(conn net.Conn, text string) error {
+	reader := bufio.NewReader(conn)
+	write := func(format string, args ...any) error {
+		_, err := fmt.Fprintf(conn, format+"\r\n", args...)
+		return err
+	}
+
+	useSASL := s.config.SASLUsername != ""
+	if useSASL {
+		if err := write("CAP REQ :sasl"); err != nil {
+			return err
+		}
+	}
+
+	nick := s.config.Nick
+	if err := write("NICK %s", nick); err != nil {
+		return err
+	}
+	if err := write("USER %s 0 * :%s", nick, nick); err != nil {
+		return err
+	}
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("failed to read from irc server: %w", err)
+		}
+
+		command, params := parseIRCLine(line)
+		switch command {
+		case "PING":
+			err = write("PONG :%s", strings.Join(params, " "))
+		case "CAP":
+			// CAP <nick> ACK|NAK :sasl
+			if len(params) >= 2 && params[1] == "NAK" {
+				return errors.New("irc server doesn't support sasl")
+			}
+			if len(params) >= 2 && params[1] == "ACK" {
+				err = write("AUTHENTICATE PLAIN")
+			}
+		case "AUTHENTICATE":
+			auth := "\x00" + s.config.SASLUsername + "\x00" + s.config.SASLPassword
+			err = write("AUTHENTICATE %s", base64.StdEncoding.EncodeToString([]byte(auth)))
+		case "903": // RPL_SASLSUCCESS
+			err = write("CAP END")
+		case "902", "904", "905", "906": // sasl authentication failed
+			return fmt.Errorf("irc sasl authentication failed: %s", strings.Join(params, " "))
+		case "433": // ERR_NICKNAMEINUSE
+			nick += "_"
+			err = write("NICK %s", nick)
+		case "001": // RPL_WELCOME
+			return s.sendToChannels(reader, write, text)
+		case "ERROR":
+			return fmt.Errorf("irc server closed the connection: %s", strings.Join(params, " "))
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to write to irc server: %w", err)
+		}
+	}
+}
+
+// sendToChannels joins the channels, sends the message and waits for the server to close the connection
+// to ensure all commands got processed.
+func (s *ircPipelineSender) sendToChannels(
+	reader *bufio.Reader,
+	write func(format string, args ...any) error,
+	text string,
+) error {
+	channels := strings.Join(s.config.Channels, ",")
+	if err := write("JOIN %s", channels); err != nil {
+		return fmt.Errorf("failed to join irc channels: %w", err)
+	}
+	for _, channel := range s.config.Channels {
+		if err := write("PRIVMSG %s :%s", channel, text); err != nil {
+			return fmt.Errorf("failed to send irc message to %s: %w", channel, err)
+		}
+	}
+	if err := write("QUIT :done"); err != nil {
+		return fmt.Errorf("failed to quit irc connection: %w", err)
+	}
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			// the server closes the connection after the quit command.
+			return nil
+		}
+
+		command, params := parseIRCLine(line)
+		switch command {
+		case "PING":
+			_ = write("PONG :%s", strings.Join(params, " "))
+		case "403", "404", "471", "473", "474", "475": // channel doesn't exist, can't send, can't join
+			return fmt.Errorf("failed to announce in irc channel: %s", strings.Join(params, " "))
+		case "ERROR":
+			return nil
+		}
+	}
+}
+
+// parseIRCLine returns the command and the parameters of a line received from an irc server.
+func parseIRCLine(line string) (string, []string) {
+	line = strings.TrimRight(line, "\r\n")
+
+	// skip the optional prefix
+	if strings.HasPrefix(line, ":") {
+		_, line, _ = strings.Cut(line, " ")
+	}
+
+	line, trailing, hasTrailing := strings.Cut(line, " :")
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return "", nil
+	}
+
+	params := fields[1:]
+	if hasTrailing {
+		params = append(params, trailing)
+	}
+
+	return strings.ToUpper(fields[0]), params
+}
+
+// ircText returns the message as a single irc line with a colorized status.
+func ircText(msg pipelineMessage) string {
+	color := ircColorGreen
+	if msg.Failed() {
+		color = ircColorRed
+	}
+
+	parts := []string{
+		fmt.Sprintf("%s[%s]%s %s #%d %s%s%s%s", ircBold, msg.RepoPath, ircBold,
+			msg.Pipeline, msg.Number, ircColor, color, msg.StatusText(), ircColor),
+	}
+	if msg.CustomTitle != "" {
+		parts[0] = fmt.Sprintf("%s%s%s%s", ircColor, color, msg.CustomTitle, ircColor)
+	}
+	if msg.CustomBody != "" {
+		parts = append(parts, msg.CustomBody)
+	} else {
+		for _, field := range msg.Fields() {
+			if field[0] == "Repository" || field[0] == "Error" {
+				continue
+			}
+			parts = append(parts, field[1])
+		}
+	}
+
+	text := strings.Join(parts, " "+ircColor+ircColorGrey+"|"+ircColor+" ")
+
+	// irc messages are terminated by a line break - remove all that are part of the content.
+	text = strings.NewReplacer("\r", " ", "\n", " ").Replace(text)
+	if len(text) > ircMaxMessageLength {
+		text = strings.ToValidUTF8(text[:ircMaxMessageLength], "") + "…"
+	}
+
+	return text + " " + msg.URL
+}
@@ -0,0 +1,232 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/harness/gitness/types/enum"
+)
+
+// ircTestServer accepts a single connection and replies to the received commands with the replies
+// returned by the reply function. All received lines are sent to the lines channel once the connection closes.
+func ircTestServer(t *testing.T, reply func(command string, params []string) []string) (string, <-chan []string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	linesCh := make(chan []string, 1)
+
+	go func() {
+		var lines []string
+		defer func() { linesCh <- lines }()
+
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+
+			line = strings.TrimRight(line, "\r\n")
+			lines = append(lines, line)
+
+			command, params := parseIRCLine(line)
+			for _, r := range reply(command, params) {
+				_, _ = fmt.Fprintf(conn, "%s\r\n", r)
+			}
+			if command == "QUIT" {
+				return
+			}
+		}
+	}()
+
+	return listener.Addr().String(), linesCh
+}
+
+func TestIRCPipelineSender_Send(t *testing.T) {
+	payload := newTestPipelinePayload(enum.CIStatusFailure)
+	text := ircText(newPipelineMessage(payload))
+
+	tests := []struct {
+		name      string
+		sasl      bool
+		reply     func(command string, params []string) []string
+		wantLines []string
+		wantErr   string
+	}{
+		{
+			name: "announce in all channels",
+			reply: func(command string, _ []string) []string {
+				if command == "USER" {
+					return []string{"PING :irc.example.com", ":irc.example.com 001 gitness :Welcome"}
+				}
+				return nil
+			},
+			wantLines: []string{
+				"NICK gitness",
+				"USER gitness 0 * :gitness",
+				"PONG :irc.example.com",
+				"JOIN #builds,#dev",
+				"PRIVMSG #builds :" + text,
+				"PRIVMSG #dev :" + text,
+				"QUIT :done",
+			},
+		},
+		{
+			name: "nick in use",
+			reply: func(command string, params []string) []string {
+				switch {
+				case command == "USER":
+					return []string{":irc.example.com 433 * gitness :Nickname is already in use"}
+				case command == "NICK" && params[0] == "gitness_":
+					return []string{":irc.example.com 001 gitness_ :Welcome"}
+				}
+				return nil
+			},
+			wantLines: []string{
+				"NICK gitness",
+				"USER gitness 0 * :gitness",
+				"NICK gitness_",
+				"JOIN #builds,#dev",
+				"PRIVMSG #builds :" + text,
+				"PRIVMSG #dev :" + text,
+				"QUIT :done",
+			},
+		},
+		{
+			name: "sasl authentication",
+			sasl: true,
+			reply: func(command string, params []string) []string {
+				switch command {
+				case "USER":
+					return []string{":irc.example.com CAP * ACK :sasl"}
+				case "AUTHENTICATE":
+					if params[0] == "PLAIN" {
+						return []string{"AUTHENTICATE +"}
+					}
+					return []string{":irc.example.com 903 gitness :SASL authentication successful"}
+				case "CAP":
+					if params[0] == "END" {
+						return []string{":irc.example.com 001 gitness :Welcome"}
+					}
+				}
+				return nil
+			},
+			wantLines: []string{
+				"CAP REQ :sasl",
+				"NICK gitness",
+				"USER gitness 0 * :gitness",
+				"AUTHENTICATE PLAIN",
+				"AUTHENTICATE AGdpdG5lc3MAc2VjcmV0",
+				"CAP END",
+				"JOIN #builds,#dev",
+				"PRIVMSG #builds :" + text,
+				"PRIVMSG #dev :" + text,
+				"QUIT :done",
+			},
+		},
+		{
+			name: "sasl authentication failed",
+			sasl: true,
+			reply: func(command string, params []string) []string {
+				switch command {
+				case "USER":
+					return []string{":irc.example.com CAP * ACK :sasl"}
+				case "AUTHENTICATE":
+					if params[0] == "PLAIN" {
+						return []string{"AUTHENTICATE +"}
+					}
+					return []string{":irc.example.com 904 gitness :SASL authentication failed"}
+				}
+				return nil
+			},
+			wantErr: "irc sasl authentication failed",
+		},
+		{
+			name: "channel can't be joined",
+			reply: func(command string, params []string) []string {
+				switch {
+				case command == "USER":
+					return []string{":irc.example.com 001 gitness :Welcome"}
+				case command == "PRIVMSG" && params[0] == "#dev":
+					return []string{":irc.example.com 404 gitness #dev :Cannot send to channel"}
+				}
+				return nil
+			},
+			wantErr: "failed to announce in irc channel",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, linesCh := ircTestServer(t, tt.reply)
+
+			config := IRCConfig{
+				Server:   addr,
+				Nick:     "gitness",
+				Channels: []string{"#builds", "#dev"},
+				Statuses: []enum.CIStatus{enum.CIStatusFailure},
+			}
+			if tt.sasl {
+				config.SASLUsername = "gitness"
+				config.SASLPassword = "secret"
+			}
+
+			err := newIRCPipelineSender(config).Send(context.Background(), payload)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Send() failed: %v", err)
+			}
+
+			if lines := <-linesCh; !reflect.DeepEqual(lines, tt.wantLines) {
+				t.Errorf("lines = %q, want %q", lines, tt.wantLines)
+			}
+		})
+	}
+}
+
+func TestIRCPipelineSender_Send_statusNotConfigured(t *testing.T) {
+	sender := newIRCPipelineSender(IRCConfig{
+		// nothing listens on the address, the sender must not connect.
+		Server:   "127.0.0.1:1",
+		Nick:     "gitness",
+		Channels: []string{"#builds"},
+		Statuses: []enum.CIStatus{enum.CIStatusFailure},
+	})
+
+	if err := sender.Send(context.Background(), newTestPipelinePayload(enum.CIStatusSuccess)); err != nil {
+		t.Fatalf("Send() failed: %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/harness/gitness/types/enum"
+)
+
+type MattermostConfig struct {
+	WebhookURL string
+	// Channel overrides the default channel of the incoming webhook if set.
+	Channel  string
+	Username string
+	// Statuses are the execution statuses for which a message is posted.
+	Statuses []enum.CIStatus
+	// Filter further limits the executions for which a message is posted.
+	Filter PipelineFilterConfig
+}
+
+// mattermostPipelineSender posts the result of pipeline executions to a mattermost incoming webhook.
+type mattermostPipelineSender struct {
+	config MattermostConfig
+	client *http.Client
+}
+
+func newMattermostPipelineSender(config MattermostConfig) *mattermostPipelineSender {
+	return &mattermostPipelineSender{
+		config: config,
+		client: newPipelineSenderHTTPClient(),
+	}
+}
+
+type mattermostMessage struct {
+	Channel     string           `json:"channel,omitempty"`
+	Username    string           `json:"username,omitempty"`
+	Attachments []chatAttachment `json:"attachments"`
+}
+
+func (s *mattermostPipelineSender) Name() string {
+	return "mattermost"
+}
+
+func (s *mattermostPipelineSender) Send(ctx context.Context, payload *PipelineExecutedPayload) error {
+	if !containsStatus(s.config.Statuses, payload.Execution.Status) {
+		return nil
+	}
+
+	return postJSON(ctx, s.client, s.config.WebhookURL, mattermostMessage{
+		Channel:     s.config.Channel,
+		Username:    s.config.Username,
+		Attachments: []chatAttachment{newChatAttachment(newPipelineMessage(payload))},
+	}, nil)
+}
@@ -0,0 +1,130 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/harness/gitness/types/enum"
+)
+
+func TestMattermostPipelineSender_Send(t *testing.T) {
+	failed := newTestPipelinePayload(enum.CIStatusFailure)
+	failed.Execution.Error = "exit code 1"
+
+	tests := []struct {
+		name    string
+		payload *PipelineExecutedPayload
+		status  int
+		want    *mattermostMessage
+		wantErr bool
+	}{
+		{
+			name:    "execution failed",
+			payload: failed,
+			status:  http.StatusOK,
+			want: &mattermostMessage{
+				Channel:  "town-square",
+				Username: "gitness",
+				Attachments: []chatAttachment{{
+					Fallback:  "space/repo: build #7 failed " + testExecutionURL,
+					Color:     chatColorFailure,
+					Title:     "space/repo: build #7 failed",
+					TitleLink: testExecutionURL,
+					Fields: []chatField{
+						{Title: "Repository", Value: "space/repo", Short: true},
+						{Title: "Ref", Value: "main", Short: true},
+						{Title: "Commit", Value: "01234567 Fix the build", Short: false},
+						{Title: "Author", Value: "Jane", Short: true},
+						{Title: "Error", Value: "exit code 1", Short: false},
+					},
+				}},
+			},
+		},
+		{
+			name:    "status not configured",
+			payload: newTestPipelinePayload(enum.CIStatusSuccess),
+			status:  http.StatusOK,
+		},
+		{
+			name:    "error response",
+			payload: failed,
+			status:  http.StatusInternalServerError,
+			want: &mattermostMessage{
+				Channel:  "town-square",
+				Username: "gitness",
+				Attachments: []chatAttachment{{
+					Fallback:  "space/repo: build #7 failed " + testExecutionURL,
+					Color:     chatColorFailure,
+					Title:     "space/repo: build #7 failed",
+					TitleLink: testExecutionURL,
+					Fields: []chatField{
+						{Title: "Repository", Value: "space/repo", Short: true},
+						{Title: "Ref", Value: "main", Short: true},
+						{Title: "Commit", Value: "01234567 Fix the build", Short: false},
+						{Title: "Author", Value: "Jane", Short: true},
+						{Title: "Error", Value: "exit code 1", Short: false},
+					},
+				}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newRecordingServer(t, respondWith(tt.status))
+
+			sender := newMattermostPipelineSender(MattermostConfig{
+				WebhookURL: server.URL + "/hooks/abc",
+				Channel:    "town-square",
+				Username:   "gitness",
+				Statuses:   []enum.CIStatus{enum.CIStatusFailure},
+			})
+
+			err := sender.Send(context.Background(), tt.payload)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Send() err = %v, want error %t", err, tt.wantErr)
+			}
+
+			requests := server.Requests()
+			if tt.want == nil {
+				if len(requests) != 0 {
+					t.Errorf("expected no message, got %d", len(requests))
+				}
+				return
+			}
+			if len(requests) != 1 {
+				t.Fatalf("expected one message, got %d", len(requests))
+			}
+
+			if path := requests[0].Path; path != "/hooks/abc" {
+				t.Errorf("path = %q, want %q", path, "/hooks/abc")
+			}
+
+			var got mattermostMessage
+			if err := json.Unmarshal([]byte(requests[0].Body), &got); err != nil {
+				t.Fatalf("failed to decode message: %v", err)
+			}
+			if !reflect.DeepEqual(&got, tt.want) {
+				t.Errorf("message = %+v, want %+v", got, *tt.want)
+			}
+		})
+	}
+}
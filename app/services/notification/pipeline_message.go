@@ -0,0 +1,212 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	// pipelineSenderTimeout defines the time limit of a single request to a notification channel.
+	pipelineSenderTimeout = 10 * time.Second
+	// pipelineSenderResponseBodyLimit defines the max number of bytes of an error response that are reported.
+	pipelineSenderResponseBodyLimit = 512
+	// shortSHALength defines the length of commit SHAs shown in notifications.
+	shortSHALength = 8
+)
+
+// pipelineMessage is the channel agnostic content of a pipeline execution notification.
+type pipelineMessage struct {
+	Status      enum.CIStatus
+	RepoPath    string
+	Pipeline    string
+	Number      int64
+	Ref         string
+	CommitSHA   string
+	CommitTitle string
+	Author      string
+	Duration    time.Duration
+	Error       string
+	URL         string
+
+	// CustomTitle and CustomBody are the rendered custom templates, empty if not configured.
+	CustomTitle string
+	CustomBody  string
+}
+
+func newPipelineMessage(payload *PipelineExecutedPayload) pipelineMessage {
+	execution := payload.Execution
+
+	sha := execution.After
+	if len(sha) > shortSHALength {
+		sha = sha[:shortSHALength]
+	}
+
+	title := execution.Title
+	if title == "" {
+		title, _, _ = strings.Cut(execution.Message, "\n")
+	}
+
+	author := execution.AuthorName
+	if author == "" {
+		author = execution.Author
+	}
+
+	return pipelineMessage{
+		Status:      execution.Status,
+		RepoPath:    payload.Repo.Path,
+		Pipeline:    payload.Pipeline.Identifier,
+		Number:      execution.Number,
+		Ref:         strings.TrimPrefix(strings.TrimPrefix(execution.Ref, "refs/heads/"), "refs/tags/"),
+		CommitSHA:   sha,
+		CommitTitle: strings.TrimSpace(title),
+		Author:      author,
+		Duration:    payload.Duration,
+		Error:       execution.Error,
+		URL:         payload.ExecutionURL,
+		CustomTitle: payload.Title,
+		CustomBody:  payload.Body,
+	}
+}
+
+// Title returns a single line summary of the execution result.
+func (m pipelineMessage) Title() string {
+	return fmt.Sprintf("%s #%d %s", m.Pipeline, m.Number, m.StatusText())
+}
+
+// Summary returns the title of the message including the repository, or the custom title if configured.
+func (m pipelineMessage) Summary() string {
+	if m.CustomTitle != "" {
+		return m.CustomTitle
+	}
+
+	return m.RepoPath + ": " + m.Title()
+}
+
+// StatusText returns a human readable description of the execution status.
+func (m pipelineMessage) StatusText() string {
+	switch m.Status {
+	case enum.CIStatusSuccess:
+		return "succeeded"
+	case enum.CIStatusFailure:
+		return "failed"
+	case enum.CIStatusError:
+		return "errored"
+	case enum.CIStatusKilled:
+		return "was killed"
+	case enum.CIStatusSkipped, enum.CIStatusBlocked, enum.CIStatusDeclined, enum.CIStatusWaitingOnDeps,
+		enum.CIStatusPending, enum.CIStatusRunning:
+	}
+
+	return string(m.Status)
+}
+
+// Failed returns true if the execution didn't succeed.
+func (m pipelineMessage) Failed() bool {
+	return m.Status.IsFailed()
+}
+
+// Fields returns the details of the execution as ordered name/value pairs (empty values are skipped).
+func (m pipelineMessage) Fields() [][2]string {
+	fields := [][2]string{
+		{"Repository", m.RepoPath},
+		{"Ref", m.Ref},
+		{"Commit", strings.TrimSpace(m.CommitSHA + " " + m.CommitTitle)},
+		{"Author", m.Author},
+	}
+	if m.Duration > 0 {
+		fields = append(fields, [2]string{"Duration", m.Duration.String()})
+	}
+	fields = append(fields, [2]string{"Error", m.Error})
+
+	nonEmpty := fields[:0]
+	for _, field := range fields {
+		if field[1] != "" {
+			nonEmpty = append(nonEmpty, field)
+		}
+	}
+
+	return nonEmpty
+}
+
+// pipelineMessageBody returns the details of the message as plaintext, one field per line,
+// or the custom body if configured.
+// The repository is skipped as channels with a separate title already show it as part of the title.
+func pipelineMessageBody(m pipelineMessage) string {
+	if m.CustomBody != "" {
+		return m.CustomBody
+	}
+
+	lines := make([]string, 0, len(m.Fields()))
+	for _, field := range m.Fields() {
+		if field[0] == "Repository" {
+			continue
+		}
+		lines = append(lines, field[0]+": "+field[1])
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// newPipelineSenderHTTPClient returns the http client used by senders of http based notification channels.
+func newPipelineSenderHTTPClient() *http.Client {
+	return &http.Client{Timeout: pipelineSenderTimeout}
+}
+
+// postJSON sends the json serialized body to the provided url and fails on non 2xx responses.
+func postJSON(ctx context.Context, client *http.Client, url string, body any, headers map[string]string) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	return doRequest(client, req)
+}
+
+// doRequest executes the request and fails on non 2xx responses.
+func doRequest(client *http.Client, req *http.Request) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, pipelineSenderResponseBodyLimit))
+
+	return fmt.Errorf("received response with status code %d: %s", resp.StatusCode, respBody)
+}
@@ -0,0 +1,163 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+const testExecutionURL = "https://git.example.com/space/repo/+/pipelines/build/executions/7"
+
+// newTestPipelinePayload returns the payload of execution #7 of the pipeline "build" on the main branch.
+func newTestPipelinePayload(status enum.CIStatus) *PipelineExecutedPayload {
+	return &PipelineExecutedPayload{
+		Repo:     &types.Repository{ID: 1, Path: "space/repo", DefaultBranch: "main"},
+		Pipeline: &types.Pipeline{ID: 2, Identifier: "build"},
+		Execution: &types.Execution{
+			ID:         3,
+			Number:     7,
+			Status:     status,
+			Ref:        "refs/heads/main",
+			After:      "0123456789abcdef",
+			Title:      "Fix the build",
+			AuthorName: "Jane",
+		},
+		ExecutionURL: testExecutionURL,
+	}
+}
+
+// recordedRequest is a request received by a recordingServer.
+type recordedRequest struct {
+	Path   string
+	Header http.Header
+	Body   string
+}
+
+// recordingServer is a test server that records all received requests
+// and responds with the status code returned by the respond function.
+type recordingServer struct {
+	*httptest.Server
+
+	mx       sync.Mutex
+	requests []recordedRequest
+}
+
+func newRecordingServer(t *testing.T, respond func(r recordedRequest) int) *recordingServer {
+	s := &recordingServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("failed to read request body: %v", err)
+		}
+
+		req := recordedRequest{Path: r.URL.Path, Header: r.Header, Body: string(body)}
+
+		s.mx.Lock()
+		s.requests = append(s.requests, req)
+		s.mx.Unlock()
+
+		status := respond(req)
+		w.WriteHeader(status)
+		if status >= http.StatusBadRequest {
+			_, _ = w.Write([]byte("invalid request"))
+		}
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *recordingServer) Requests() []recordedRequest {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	return s.requests
+}
+
+// respondWith returns a respond function of a recordingServer that always responds with the status code.
+func respondWith(status int) func(recordedRequest) int {
+	return func(recordedRequest) int { return status }
+}
+
+func TestPostJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr string
+	}{
+		{
+			name:   "success",
+			status: http.StatusNoContent,
+		},
+		{
+			name:    "error response",
+			status:  http.StatusBadRequest,
+			wantErr: "received response with status code 400: invalid request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newRecordingServer(t, respondWith(tt.status))
+
+			err := postJSON(context.Background(), newPipelineSenderHTTPClient(), server.URL+"/hook",
+				map[string]string{"key": "value"}, map[string]string{"X-Token": "secret"})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("postJSON() failed: %v", err)
+			}
+
+			requests := server.Requests()
+			if len(requests) != 1 {
+				t.Fatalf("expected one request, got %d", len(requests))
+			}
+			req := requests[0]
+			if req.Path != "/hook" {
+				t.Errorf("path = %q, want %q", req.Path, "/hook")
+			}
+			if got := req.Header.Get("Content-Type"); got != "application/json" {
+				t.Errorf("content type = %q, want %q", got, "application/json")
+			}
+			if got := req.Header.Get("X-Token"); got != "secret" {
+				t.Errorf("header = %q, want %q", got, "secret")
+			}
+			if req.Body != `{"key":"value"}` {
+				t.Errorf("body = %s, want %s", req.Body, `{"key":"value"}`)
+			}
+		})
+	}
+}
+
+func TestPostJSON_unreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	err := postJSON(context.Background(), newPipelineSenderHTTPClient(), url, struct{}{}, nil)
+	if err == nil || !strings.Contains(err.Error(), "failed to send request") {
+		t.Fatalf("err = %v, want a send failure", err)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	ntfyPriorityDefault = 3
+	ntfyPriorityHigh    = 4
+)
+
+type NtfyConfig struct {
+	URL   string
+	Topic string
+	// Token is the optional access token used for topics that require authentication.
+	Token string
+	// Statuses are the execution statuses for which a notification is published.
+	Statuses []enum.CIStatus
+	// Filter further limits the executions for which a notification is published.
+	Filter PipelineFilterConfig
+}
+
+// ntfyPipelineSender publishes the result of pipeline executions to a topic of a ntfy server.
+type ntfyPipelineSender struct {
+	config NtfyConfig
+	client *http.Client
+}
+
+func newNtfyPipelineSender(config NtfyConfig) *ntfyPipelineSender {
+	return &ntfyPipelineSender{
+		config: config,
+		client: newPipelineSenderHTTPClient(),
+	}
+}
+
+type ntfyMessage struct {
+	Topic    string   `json:"topic"`
+	Title    string   `json:"title"`
+	Message  string   `json:"message"`
+	Priority int      `json:"priority"`
+	Tags     []string `json:"tags"`
+	Click    string   `json:"click,omitempty"`
+}
+
+func (s *ntfyPipelineSender) Name() string {
+	return "ntfy"
+}
+
+func (s *ntfyPipelineSender) Send(ctx context.Context, payload *PipelineExecutedPayload) error {
+	if !containsStatus(s.config.Statuses, payload.Execution.Status) {
+		return nil
+	}
+
+	msg := newPipelineMessage(payload)
+
+	priority := ntfyPriorityDefault
+	tags := []string{"white_check_mark"}
+	if msg.Failed() {
+		priority = ntfyPriorityHigh
+		tags = []string{"x"}
+	}
+
+	var headers map[string]string
+	if s.config.Token != "" {
+		headers = map[string]string{"Authorization": "Bearer " + s.config.Token}
+	}
+
+	// publishing as json requires the message to be sent to the root url of the server.
+	return postJSON(ctx, s.client, strings.TrimSuffix(s.config.URL, "/"), ntfyMessage{
+		Topic:    s.config.Topic,
+		Title:    msg.Summary(),
+		Message:  pipelineMessageBody(msg),
+		Priority: priority,
+		Tags:     tags,
+		Click:    msg.URL,
+	}, headers)
+}
@@ -0,0 +1,130 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/harness/gitness/types/enum"
+)
+
+func TestNtfyPipelineSender_Send(t *testing.T) {
+	tests := []struct {
+		name     string
+		token    string
+		payload  *PipelineExecutedPayload
+		status   int
+		want     *ntfyMessage
+		wantAuth string
+		wantErr  bool
+	}{
+		{
+			name:    "execution failed",
+			token:   "tk_secret",
+			payload: newTestPipelinePayload(enum.CIStatusFailure),
+			status:  http.StatusOK,
+			want: &ntfyMessage{
+				Topic:    "builds",
+				Title:    "space/repo: build #7 failed",
+				Message:  "Ref: main\nCommit: 01234567 Fix the build\nAuthor: Jane",
+				Priority: ntfyPriorityHigh,
+				Tags:     []string{"x"},
+				Click:    testExecutionURL,
+			},
+			wantAuth: "Bearer tk_secret",
+		},
+		{
+			name:    "execution succeeded without token",
+			payload: newTestPipelinePayload(enum.CIStatusSuccess),
+			status:  http.StatusOK,
+			want: &ntfyMessage{
+				Topic:    "builds",
+				Title:    "space/repo: build #7 succeeded",
+				Message:  "Ref: main\nCommit: 01234567 Fix the build\nAuthor: Jane",
+				Priority: ntfyPriorityDefault,
+				Tags:     []string{"white_check_mark"},
+				Click:    testExecutionURL,
+			},
+		},
+		{
+			name:    "status not configured",
+			payload: newTestPipelinePayload(enum.CIStatusKilled),
+			status:  http.StatusOK,
+		},
+		{
+			name:    "error response",
+			payload: newTestPipelinePayload(enum.CIStatusSuccess),
+			status:  http.StatusForbidden,
+			want: &ntfyMessage{
+				Topic:    "builds",
+				Title:    "space/repo: build #7 succeeded",
+				Message:  "Ref: main\nCommit: 01234567 Fix the build\nAuthor: Jane",
+				Priority: ntfyPriorityDefault,
+				Tags:     []string{"white_check_mark"},
+				Click:    testExecutionURL,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newRecordingServer(t, respondWith(tt.status))
+
+			sender := newNtfyPipelineSender(NtfyConfig{
+				URL:      server.URL + "/",
+				Topic:    "builds",
+				Token:    tt.token,
+				Statuses: []enum.CIStatus{enum.CIStatusSuccess, enum.CIStatusFailure},
+			})
+
+			err := sender.Send(context.Background(), tt.payload)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Send() err = %v, want error %t", err, tt.wantErr)
+			}
+
+			requests := server.Requests()
+			if tt.want == nil {
+				if len(requests) != 0 {
+					t.Errorf("expected no notification, got %d", len(requests))
+				}
+				return
+			}
+			if len(requests) != 1 {
+				t.Fatalf("expected one notification, got %d", len(requests))
+			}
+
+			// json messages have to be published to the root url of the server.
+			if path := requests[0].Path; path != "/" {
+				t.Errorf("path = %q, want %q", path, "/")
+			}
+			if auth := requests[0].Header.Get("Authorization"); auth != tt.wantAuth {
+				t.Errorf("authorization = %q, want %q", auth, tt.wantAuth)
+			}
+
+			var got ntfyMessage
+			if err := json.Unmarshal([]byte(requests[0].Body), &got); err != nil {
+				t.Fatalf("failed to decode notification: %v", err)
+			}
+			if !reflect.DeepEqual(&got, tt.want) {
+				t.Errorf("notification = %+v, want %+v", got, *tt.want)
+			}
+		})
+	}
+}
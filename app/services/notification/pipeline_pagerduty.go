@@ -0,0 +1,165 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+const (
+	pagerDutyEventActionTrigger = "trigger"
+	pagerDutyEventActionResolve = "resolve"
+)
+
+type PagerDutyConfig struct {
+	EventsURL  string
+	RoutingKey string
+	// Branches define the severity of the incidents of protected branches matching a pattern.
+	Branches []PagerDutyBranch
+	// DefaultSeverity is the severity used for incidents of protected branches that match none of the Branches.
+	DefaultSeverity string
+	// Filter limits the executions for which events are sent.
+	Filter PipelineFilterConfig
+}
+
+// PagerDutyBranch maps a branch pattern to the severity of the incidents of matching branches.
+type PagerDutyBranch struct {
+	Pattern  string
+	Severity string
+}
+
+// pagerDutyPipelineSender triggers a pagerduty incident when an execution fails on a protected branch
+// and resolves the incident once a later execution of the same pipeline and branch succeeds.
+// A branch is protected if any branch rule of the repository applies to it.
+type pagerDutyPipelineSender struct {
+	config            PagerDutyConfig
+	protectionManager *protection.Manager
+	client            *http.Client
+}
+
+func newPagerDutyPipelineSender(
+	config PagerDutyConfig,
+	protectionManager *protection.Manager,
+) *pagerDutyPipelineSender {
+	return &pagerDutyPipelineSender{
+		config:            config,
+		protectionManager: protectionManager,
+		client:            newPipelineSenderHTTPClient(),
+	}
+}
+
+type pagerDutyEvent struct {
+	RoutingKey  string            `json:"routing_key"`
+	EventAction string            `json:"event_action"`
+	DedupKey    string            `json:"dedup_key"`
+	Payload     *pagerDutyPayload `json:"payload,omitempty"`
+	Links       []pagerDutyLink   `json:"links,omitempty"`
+}
+
+type pagerDutyPayload struct {
+	Summary       string            `json:"summary"`
+	Source        string            `json:"source"`
+	Severity      string            `json:"severity"`
+	Component     string            `json:"component,omitempty"`
+	CustomDetails map[string]string `json:"custom_details,omitempty"`
+}
+
+type pagerDutyLink struct {
+	Href string `json:"href"`
+	Text string `json:"text"`
+}
+
+func (s *pagerDutyPipelineSender) Name() string {
+	return "pagerduty"
+}
+
+func (s *pagerDutyPipelineSender) Send(ctx context.Context, payload *PipelineExecutedPayload) error {
+	branch, ok := strings.CutPrefix(payload.Execution.Ref, "refs/heads/")
+	if !ok {
+		return nil
+	}
+
+	severity, ok, err := s.severity(ctx, payload.Repo.ID, payload.Repo.DefaultBranch, branch)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	event := pagerDutyEvent{
+		RoutingKey: s.config.RoutingKey,
+		// the dedup key ensures a later successful execution resolves the incident of the failed one.
+		DedupKey: fmt.Sprintf("gitness/%d/%d/%s", payload.Repo.ID, payload.Pipeline.ID, branch),
+	}
+
+	msg := newPipelineMessage(payload)
+
+	switch {
+	case payload.Execution.Status == enum.CIStatusSuccess:
+		event.EventAction = pagerDutyEventActionResolve
+	case msg.Failed():
+		details := make(map[string]string)
+		for _, field := range msg.Fields() {
+			details[field[0]] = field[1]
+		}
+
+		event.EventAction = pagerDutyEventActionTrigger
+		event.Payload = &pagerDutyPayload{
+			Summary:       fmt.Sprintf("%s on %s", msg.Summary(), branch),
+			Source:        msg.RepoPath,
+			Severity:      severity,
+			Component:     msg.Pipeline,
+			CustomDetails: details,
+		}
+		event.Links = []pagerDutyLink{{Href: msg.URL, Text: "View execution"}}
+	default:
+		return nil
+	}
+
+	return postJSON(ctx, s.client, s.config.EventsURL, event, nil)
+}
+
+// severity returns the severity of incidents for the branch and false if the branch isn't protected.
+func (s *pagerDutyPipelineSender) severity(
+	ctx context.Context,
+	repoID int64,
+	defaultBranch string,
+	branch string,
+) (string, bool, error) {
+	protected, err := s.protectionManager.IsBranchProtected(ctx, repoID, defaultBranch, branch)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to check if the branch is protected: %w", err)
+	}
+	if !protected {
+		return "", false, nil
+	}
+
+	for _, b := range s.config.Branches {
+		if ok, _ := doublestar.Match(b.Pattern, branch); ok {
+			return b.Severity, true, nil
+		}
+	}
+
+	return s.config.DefaultSeverity, true, nil
+}
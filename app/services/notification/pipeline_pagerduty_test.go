@@ -0,0 +1,189 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// branchRuleStore returns the rules of all repositories, all other methods aren't implemented.
+type branchRuleStore struct {
+	store.RuleStore
+	rules []types.RuleInfoInternal
+	err   error
+}
+
+func (s branchRuleStore) ListAllRepoRules(context.Context, int64) ([]types.RuleInfoInternal, error) {
+	return s.rules, s.err
+}
+
+func TestPagerDutyPipelineSender_Send(t *testing.T) {
+	pattern := protection.Pattern{Include: []string{"main", "release/*"}}
+	rules := []types.RuleInfoInternal{{
+		RuleInfo: types.RuleInfo{ID: 1, Type: protection.TypeBranch, State: enum.RuleStateActive},
+		Pattern:  pattern.JSON(),
+	}}
+
+	withRef := func(payload *PipelineExecutedPayload, ref string) *PipelineExecutedPayload {
+		payload.Execution.Ref = ref
+		return payload
+	}
+
+	tests := []struct {
+		name      string
+		payload   *PipelineExecutedPayload
+		ruleErr   error
+		status    int
+		wantEvent *pagerDutyEvent
+		wantErr   bool
+	}{
+		{
+			name:    "failure on a branch with a severity",
+			payload: newTestPipelinePayload(enum.CIStatusFailure),
+			status:  http.StatusAccepted,
+			wantEvent: &pagerDutyEvent{
+				RoutingKey:  "routing-key",
+				EventAction: pagerDutyEventActionTrigger,
+				DedupKey:    "gitness/1/2/main",
+				Payload: &pagerDutyPayload{
+					Summary:   "space/repo: build #7 failed on main",
+					Source:    "space/repo",
+					Severity:  "critical",
+					Component: "build",
+					CustomDetails: map[string]string{
+						"Repository": "space/repo",
+						"Ref":        "main",
+						"Commit":     "01234567 Fix the build",
+						"Author":     "Jane",
+					},
+				},
+				Links: []pagerDutyLink{{Href: testExecutionURL, Text: "View execution"}},
+			},
+		},
+		{
+			name:    "failure on a protected branch uses the default severity",
+			payload: withRef(newTestPipelinePayload(enum.CIStatusError), "refs/heads/release/1.0"),
+			status:  http.StatusAccepted,
+			wantEvent: &pagerDutyEvent{
+				RoutingKey:  "routing-key",
+				EventAction: pagerDutyEventActionTrigger,
+				DedupKey:    "gitness/1/2/release/1.0",
+				Payload: &pagerDutyPayload{
+					Summary:   "space/repo: build #7 errored on release/1.0",
+					Source:    "space/repo",
+					Severity:  "error",
+					Component: "build",
+					CustomDetails: map[string]string{
+						"Repository": "space/repo",
+						"Ref":        "release/1.0",
+						"Commit":     "01234567 Fix the build",
+						"Author":     "Jane",
+					},
+				},
+				Links: []pagerDutyLink{{Href: testExecutionURL, Text: "View execution"}},
+			},
+		},
+		{
+			name:    "success resolves the incident",
+			payload: newTestPipelinePayload(enum.CIStatusSuccess),
+			status:  http.StatusAccepted,
+			wantEvent: &pagerDutyEvent{
+				RoutingKey:  "routing-key",
+				EventAction: pagerDutyEventActionResolve,
+				DedupKey:    "gitness/1/2/main",
+			},
+		},
+		{
+			name:    "unprotected branch",
+			payload: withRef(newTestPipelinePayload(enum.CIStatusFailure), "refs/heads/feature"),
+			status:  http.StatusAccepted,
+		},
+		{
+			name:    "tag",
+			payload: withRef(newTestPipelinePayload(enum.CIStatusFailure), "refs/tags/v1.0"),
+			status:  http.StatusAccepted,
+		},
+		{
+			name:    "execution not finished",
+			payload: newTestPipelinePayload(enum.CIStatusRunning),
+			status:  http.StatusAccepted,
+		},
+		{
+			name:    "rules can't be listed",
+			payload: newTestPipelinePayload(enum.CIStatusFailure),
+			ruleErr: errors.New("db down"),
+			status:  http.StatusAccepted,
+			wantErr: true,
+		},
+		{
+			name:    "error response",
+			payload: newTestPipelinePayload(enum.CIStatusSuccess),
+			status:  http.StatusBadRequest,
+			wantEvent: &pagerDutyEvent{
+				RoutingKey:  "routing-key",
+				EventAction: pagerDutyEventActionResolve,
+				DedupKey:    "gitness/1/2/main",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newRecordingServer(t, respondWith(tt.status))
+
+			sender := newPagerDutyPipelineSender(PagerDutyConfig{
+				EventsURL:       server.URL,
+				RoutingKey:      "routing-key",
+				Branches:        []PagerDutyBranch{{Pattern: "main", Severity: "critical"}},
+				DefaultSeverity: "error",
+			}, protection.NewManager(branchRuleStore{rules: rules, err: tt.ruleErr}))
+
+			err := sender.Send(context.Background(), tt.payload)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Send() err = %v, want error %t", err, tt.wantErr)
+			}
+
+			requests := server.Requests()
+			if tt.wantEvent == nil {
+				if len(requests) != 0 {
+					t.Errorf("expected no event, got %d", len(requests))
+				}
+				return
+			}
+			if len(requests) != 1 {
+				t.Fatalf("expected one event, got %d", len(requests))
+			}
+
+			var got pagerDutyEvent
+			if err := json.Unmarshal([]byte(requests[0].Body), &got); err != nil {
+				t.Fatalf("failed to decode event: %v", err)
+			}
+			if !reflect.DeepEqual(&got, tt.wantEvent) {
+				t.Errorf("event = %+v, want %+v", got, *tt.wantEvent)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/types/enum"
+
+	"go.uber.org/multierr"
+)
+
+// pushoverPriorityEmergency is the priority that requires retry and expire to be set.
+const pushoverPriorityEmergency = 2
+
+type PushoverConfig struct {
+	APIURL   string
+	AppToken string
+	// UserKeys are the keys of the users or groups that receive the notifications.
+	UserKeys []string
+	// Statuses are the execution statuses for which a notification is sent.
+	Statuses []enum.CIStatus
+	// SuccessPriority and FailurePriority are the priorities of notifications (-2 to 2).
+	SuccessPriority int
+	FailurePriority int
+	// Retry and Expire define how often and how long emergency notifications are repeated until acknowledged.
+	Retry  time.Duration
+	Expire time.Duration
+	// Filter further limits the executions for which a notification is sent.
+	Filter PipelineFilterConfig
+}
+
+// pushoverPipelineSender sends the result of pipeline executions to pushover users.
+type pushoverPipelineSender struct {
+	config PushoverConfig
+	client *http.Client
+}
+
+func newPushoverPipelineSender(config PushoverConfig) *pushoverPipelineSender {
+	return &pushoverPipelineSender{
+		config: config,
+		client: newPipelineSenderHTTPClient(),
+	}
+}
+
+func (s *pushoverPipelineSender) Name() string {
+	return "pushover"
+}
+
+func (s *pushoverPipelineSender) Send(ctx context.Context, payload *PipelineExecutedPayload) error {
+	if !containsStatus(s.config.Statuses, payload.Execution.Status) {
+		return nil
+	}
+
+	msg := newPipelineMessage(payload)
+
+	priority := s.config.SuccessPriority
+	if msg.Failed() {
+		priority = s.config.FailurePriority
+	}
+
+	form := url.Values{}
+	form.Set("token", s.config.AppToken)
+	form.Set("title", msg.Summary())
+	form.Set("message", pipelineMessageBody(msg))
+	form.Set("url", msg.URL)
+	form.Set("url_title", fmt.Sprintf("View execution #%d", msg.Number))
+	form.Set("priority", strconv.Itoa(priority))
+	if priority == pushoverPriorityEmergency {
+		form.Set("retry", strconv.Itoa(int(s.config.Retry.Seconds())))
+		form.Set("expire", strconv.Itoa(int(s.config.Expire.Seconds())))
+	}
+
+	// every user gets a separate notification to ensure an invalid key doesn't prevent others from being notified.
+	var errs error
+	for _, userKey := range s.config.UserKeys {
+		form.Set("user", userKey)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.APIURL, strings.NewReader(form.Encode()))
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		if err := doRequest(s.client, req); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,129 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/types/enum"
+)
+
+func TestPushoverPipelineSender_Send(t *testing.T) {
+	form := func(user string, priority string, extra ...string) url.Values {
+		values := url.Values{
+			"token":     {"app-token"},
+			"user":      {user},
+			"title":     {"space/repo: build #7 failed"},
+			"message":   {"Ref: main\nCommit: 01234567 Fix the build\nAuthor: Jane"},
+			"url":       {testExecutionURL},
+			"url_title": {"View execution #7"},
+			"priority":  {priority},
+		}
+		for i := 0; i+1 < len(extra); i += 2 {
+			values.Set(extra[i], extra[i+1])
+		}
+		return values
+	}
+
+	tests := []struct {
+		name            string
+		failurePriority int
+		userKeys        []string
+		payload         *PipelineExecutedPayload
+		want            []url.Values
+		wantErr         bool
+	}{
+		{
+			name:            "every user gets a notification",
+			failurePriority: 1,
+			userKeys:        []string{"user-a", "user-b"},
+			payload:         newTestPipelinePayload(enum.CIStatusFailure),
+			want:            []url.Values{form("user-a", "1"), form("user-b", "1")},
+		},
+		{
+			name:            "emergency priority requires retry and expire",
+			failurePriority: pushoverPriorityEmergency,
+			userKeys:        []string{"user-a"},
+			payload:         newTestPipelinePayload(enum.CIStatusFailure),
+			want:            []url.Values{form("user-a", "2", "retry", "60", "expire", "3600")},
+		},
+		{
+			name:            "status not configured",
+			failurePriority: 1,
+			userKeys:        []string{"user-a"},
+			payload:         newTestPipelinePayload(enum.CIStatusSuccess),
+		},
+		{
+			name:            "invalid user doesn't prevent others from being notified",
+			failurePriority: 1,
+			userKeys:        []string{"invalid", "user-b"},
+			payload:         newTestPipelinePayload(enum.CIStatusFailure),
+			want:            []url.Values{form("invalid", "1"), form("user-b", "1")},
+			wantErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newRecordingServer(t, func(r recordedRequest) int {
+				values, _ := url.ParseQuery(r.Body)
+				if values.Get("user") == "invalid" {
+					return http.StatusBadRequest
+				}
+				return http.StatusOK
+			})
+
+			sender := newPushoverPipelineSender(PushoverConfig{
+				APIURL:          server.URL + "/1/messages.json",
+				AppToken:        "app-token",
+				UserKeys:        tt.userKeys,
+				Statuses:        []enum.CIStatus{enum.CIStatusFailure},
+				SuccessPriority: -1,
+				FailurePriority: tt.failurePriority,
+				Retry:           time.Minute,
+				Expire:          time.Hour,
+			})
+
+			err := sender.Send(context.Background(), tt.payload)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Send() err = %v, want error %t", err, tt.wantErr)
+			}
+
+			requests := server.Requests()
+			if len(requests) != len(tt.want) {
+				t.Fatalf("expected %d notifications, got %d", len(tt.want), len(requests))
+			}
+
+			for i, req := range requests {
+				if ct := req.Header.Get("Content-Type"); ct != "application/x-www-form-urlencoded" {
+					t.Errorf("content type = %q, want form", ct)
+				}
+
+				got, err := url.ParseQuery(req.Body)
+				if err != nil {
+					t.Fatalf("failed to parse form: %v", err)
+				}
+				if !reflect.DeepEqual(got, tt.want[i]) {
+					t.Errorf("notification %d = %v, want %v", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/harness/gitness/types/enum"
+)
+
+type RocketChatConfig struct {
+	WebhookURL string
+	// Channel overrides the default channel of the incoming webhook if set.
+	Channel string
+	// Alias overrides the name shown as the author of the message if set.
+	Alias string
+	// Statuses are the execution statuses for which a message is posted.
+	Statuses []enum.CIStatus
+	// Filter further limits the executions for which a message is posted.
+	Filter PipelineFilterConfig
+}
+
+// rocketChatPipelineSender posts the result of pipeline executions to a rocket.chat incoming webhook.
+type rocketChatPipelineSender struct {
+	config RocketChatConfig
+	client *http.Client
+}
+
+func newRocketChatPipelineSender(config RocketChatConfig) *rocketChatPipelineSender {
+	return &rocketChatPipelineSender{
+		config: config,
+		client: newPipelineSenderHTTPClient(),
+	}
+}
+
+type rocketChatMessage struct {
+	Text        string           `json:"text"`
+	Channel     string           `json:"channel,omitempty"`
+	Alias       string           `json:"alias,omitempty"`
+	Attachments []chatAttachment `json:"attachments"`
+}
+
+func (s *rocketChatPipelineSender) Name() string {
+	return "rocketchat"
+}
+
+func (s *rocketChatPipelineSender) Send(ctx context.Context, payload *PipelineExecutedPayload) error {
+	if !containsStatus(s.config.Statuses, payload.Execution.Status) {
+		return nil
+	}
+
+	msg := newPipelineMessage(payload)
+
+	// rocket.chat doesn't support fallback texts, the message text is shown in notifications instead.
+	attachment := newChatAttachment(msg)
+	attachment.Fallback = ""
+
+	return postJSON(ctx, s.client, s.config.WebhookURL, rocketChatMessage{
+		Text:        msg.Summary(),
+		Channel:     s.config.Channel,
+		Alias:       s.config.Alias,
+		Attachments: []chatAttachment{attachment},
+	}, nil)
+}
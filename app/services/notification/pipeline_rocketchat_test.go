@@ -0,0 +1,141 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/harness/gitness/types/enum"
+)
+
+func TestRocketChatPipelineSender_Send(t *testing.T) {
+	custom := newTestPipelinePayload(enum.CIStatusSuccess)
+	custom.Title = "Deployed"
+	custom.Body = "v1.2 is live"
+
+	tests := []struct {
+		name    string
+		payload *PipelineExecutedPayload
+		status  int
+		want    *rocketChatMessage
+		wantErr bool
+	}{
+		{
+			name:    "execution succeeded",
+			payload: newTestPipelinePayload(enum.CIStatusSuccess),
+			status:  http.StatusOK,
+			want: &rocketChatMessage{
+				Text:    "space/repo: build #7 succeeded",
+				Channel: "#builds",
+				Alias:   "Gitness",
+				Attachments: []chatAttachment{{
+					Color:     chatColorSuccess,
+					Title:     "space/repo: build #7 succeeded",
+					TitleLink: testExecutionURL,
+					Fields: []chatField{
+						{Title: "Repository", Value: "space/repo", Short: true},
+						{Title: "Ref", Value: "main", Short: true},
+						{Title: "Commit", Value: "01234567 Fix the build", Short: false},
+						{Title: "Author", Value: "Jane", Short: true},
+					},
+				}},
+			},
+		},
+		{
+			name:    "custom template",
+			payload: custom,
+			status:  http.StatusOK,
+			want: &rocketChatMessage{
+				Text:    "Deployed",
+				Channel: "#builds",
+				Alias:   "Gitness",
+				Attachments: []chatAttachment{{
+					Color:     chatColorSuccess,
+					Title:     "Deployed",
+					TitleLink: testExecutionURL,
+					Text:      "v1.2 is live",
+				}},
+			},
+		},
+		{
+			name:    "status not configured",
+			payload: newTestPipelinePayload(enum.CIStatusFailure),
+			status:  http.StatusOK,
+		},
+		{
+			name:    "error response",
+			payload: newTestPipelinePayload(enum.CIStatusSuccess),
+			status:  http.StatusBadRequest,
+			want: &rocketChatMessage{
+				Text:    "space/repo: build #7 succeeded",
+				Channel: "#builds",
+				Alias:   "Gitness",
+				Attachments: []chatAttachment{{
+					Color:     chatColorSuccess,
+					Title:     "space/repo: build #7 succeeded",
+					TitleLink: testExecutionURL,
+					Fields: []chatField{
+						{Title: "Repository", Value: "space/repo", Short: true},
+						{Title: "Ref", Value: "main", Short: true},
+						{Title: "Commit", Value: "01234567 Fix the build", Short: false},
+						{Title: "Author", Value: "Jane", Short: true},
+					},
+				}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newRecordingServer(t, respondWith(tt.status))
+
+			sender := newRocketChatPipelineSender(RocketChatConfig{
+				WebhookURL: server.URL + "/hooks/abc",
+				Channel:    "#builds",
+				Alias:      "Gitness",
+				Statuses:   []enum.CIStatus{enum.CIStatusSuccess},
+			})
+
+			err := sender.Send(context.Background(), tt.payload)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Send() err = %v, want error %t", err, tt.wantErr)
+			}
+
+			requests := server.Requests()
+			if tt.want == nil {
+				if len(requests) != 0 {
+					t.Errorf("expected no message, got %d", len(requests))
+				}
+				return
+			}
+			if len(requests) != 1 {
+				t.Fatalf("expected one message, got %d", len(requests))
+			}
+
+			var got rocketChatMessage
+			if err := json.Unmarshal([]byte(requests[0].Body), &got); err != nil {
+				t.Fatalf("failed to decode message: %v", err)
+			}
+			if !reflect.DeepEqual(&got, tt.want) {
+				t.Errorf("message = %+v, want %+v", got, *tt.want)
+			}
+		})
+	}
+}
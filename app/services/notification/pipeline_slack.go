@@ -0,0 +1,300 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+	"github.com/slack-go/slack"
+)
+
+const (
+	// slackMessageRetention defines how long the started message of an execution is kept for updates.
+	slackMessageRetention = 24 * time.Hour
+	// slackMaxTextLength limits the text of a block to stay below the limit of slack (3000).
+	slackMaxTextLength = 2900
+)
+
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+type SlackConfig struct {
+	// WebhookURL is the incoming webhook the results are posted to.
+	WebhookURL string
+	// APIURL, BotToken and Channel configure the bot mode (takes precedence over the webhook),
+	// which posts a message once an execution started and updates it once the execution finished.
+	APIURL   string
+	BotToken string
+	Channel  string
+	// Thread posts the result as reply in the thread of the started message, instead of only updating it.
+	Thread bool
+	// Statuses are the execution statuses for which a message is posted.
+	Statuses []enum.CIStatus
+	// Filter further limits the executions for which a message is posted.
+	Filter PipelineFilterConfig
+}
+
+// slackWebhookPipelineSender posts the result of pipeline executions to a slack incoming webhook.
+type slackWebhookPipelineSender struct {
+	config SlackConfig
+	client *http.Client
+}
+
+func newSlackWebhookPipelineSender(config SlackConfig) *slackWebhookPipelineSender {
+	return &slackWebhookPipelineSender{
+		config: config,
+		client: newPipelineSenderHTTPClient(),
+	}
+}
+
+func (s *slackWebhookPipelineSender) Name() string {
+	return "slack"
+}
+
+func (s *slackWebhookPipelineSender) Send(ctx context.Context, payload *PipelineExecutedPayload) error {
+	if !containsStatus(s.config.Statuses, payload.Execution.Status) {
+		return nil
+	}
+
+	msg := newPipelineMessage(payload)
+
+	err := slack.PostWebhookCustomHTTPContext(ctx, s.config.WebhookURL, s.client, &slack.WebhookMessage{
+		Text:   msg.Summary(),
+		Blocks: &slack.Blocks{BlockSet: slackBlocks(msg, true)},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to post slack message: %w", err)
+	}
+
+	return nil
+}
+
+// slackBotPipelineSender posts a message to a slack channel once a pipeline execution started,
+// and updates it with the result once the execution finished.
+// The posted messages are stored in the database, so any instance can update them. If the message
+// of an execution isn't known (e.g. it expired), the result is posted as a new message.
+type slackBotPipelineSender struct {
+	config       SlackConfig
+	client       *slack.Client
+	messageStore store.SlackMessageStore
+}
+
+func newSlackBotPipelineSender(config SlackConfig, messageStore store.SlackMessageStore) *slackBotPipelineSender {
+	return &slackBotPipelineSender{
+		config: config,
+		client: slack.New(config.BotToken,
+			slack.OptionAPIURL(config.APIURL),
+			slack.OptionHTTPClient(newPipelineSenderHTTPClient())),
+		messageStore: messageStore,
+	}
+}
+
+func (s *slackBotPipelineSender) Name() string {
+	return "slack"
+}
+
+func (s *slackBotPipelineSender) SendStarted(ctx context.Context, payload *PipelineExecutedPayload) error {
+	now := time.Now()
+
+	// remove the messages of executions that never finished.
+	err := s.messageStore.DeleteBefore(ctx, now.Add(-slackMessageRetention).UnixMilli())
+	if err != nil {
+		return fmt.Errorf("failed to delete expired slack messages: %w", err)
+	}
+
+	msg := newPipelineMessage(payload)
+
+	channel, ts, err := s.client.PostMessageContext(ctx, s.config.Channel,
+		slack.MsgOptionText(msg.Summary(), false),
+		slack.MsgOptionBlocks(slackBlocks(msg, true)...))
+	if err != nil {
+		return fmt.Errorf("failed to post slack message: %w", err)
+	}
+
+	err = s.messageStore.Upsert(ctx, &types.SlackMessage{
+		ExecutionID: payload.Execution.ID,
+		Channel:     channel,
+		TS:          ts,
+		Created:     now.UnixMilli(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store slack message: %w", err)
+	}
+
+	return nil
+}
+
+func (s *slackBotPipelineSender) Send(ctx context.Context, payload *PipelineExecutedPayload) error {
+	msg := newPipelineMessage(payload)
+	relevant := containsStatus(s.config.Statuses, payload.Execution.Status)
+
+	ref, err := s.findMessage(ctx, payload.Execution.ID)
+	if err != nil {
+		return err
+	}
+
+	if ref == nil {
+		if !relevant {
+			return nil
+		}
+
+		_, _, err = s.client.PostMessageContext(ctx, s.config.Channel,
+			slack.MsgOptionText(msg.Summary(), false),
+			slack.MsgOptionBlocks(slackBlocks(msg, true)...))
+		if err != nil {
+			return fmt.Errorf("failed to post slack message: %w", err)
+		}
+
+		return nil
+	}
+
+	// the started message is always updated to not leave it in the running state,
+	// in thread mode the details are posted as reply.
+	_, _, _, err = s.client.UpdateMessageContext(ctx, ref.Channel, ref.TS,
+		slack.MsgOptionText(msg.Summary(), false),
+		slack.MsgOptionBlocks(slackBlocks(msg, !s.config.Thread)...))
+	if err != nil {
+		return fmt.Errorf("failed to update slack message: %w", err)
+	}
+
+	if s.config.Thread && relevant {
+		_, _, err = s.client.PostMessageContext(ctx, ref.Channel,
+			slack.MsgOptionTS(ref.TS),
+			slack.MsgOptionText(msg.Summary(), false),
+			slack.MsgOptionBlocks(slackBlocks(msg, true)...))
+		if err != nil {
+			return fmt.Errorf("failed to post slack thread reply: %w", err)
+		}
+	}
+
+	// the message is only forgotten once it's updated, a leftover expires with the retention.
+	s.forgetMessage(ctx, payload.Execution.ID)
+
+	return nil
+}
+
+func (s *slackBotPipelineSender) Discard(ctx context.Context, payload *PipelineExecutedPayload) error {
+	ref, err := s.findMessage(ctx, payload.Execution.ID)
+	if err != nil {
+		return err
+	}
+
+	if ref == nil {
+		return nil
+	}
+
+	_, _, err = s.client.DeleteMessageContext(ctx, ref.Channel, ref.TS)
+	if err != nil {
+		return fmt.Errorf("failed to delete slack message: %w", err)
+	}
+
+	s.forgetMessage(ctx, payload.Execution.ID)
+
+	return nil
+}
+
+// findMessage returns the started message of an execution, nil if there is none.
+func (s *slackBotPipelineSender) findMessage(ctx context.Context, executionID int64) (*types.SlackMessage, error) {
+	ref, err := s.messageStore.Find(ctx, executionID)
+	if errors.Is(err, gitness_store.ErrResourceNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find slack message: %w", err)
+	}
+
+	return ref, nil
+}
+
+// forgetMessage deletes the started message of an execution from the database.
+func (s *slackBotPipelineSender) forgetMessage(ctx context.Context, executionID int64) {
+	if err := s.messageStore.Delete(ctx, executionID); err != nil {
+		log.Ctx(ctx).Warn().Err(err).
+			Int64("execution_id", executionID).
+			Msg("failed to delete slack message")
+	}
+}
+
+// slackBlocks returns the block kit layout of a pipeline message, optionally including the details.
+func slackBlocks(msg pipelineMessage, details bool) []slack.Block {
+	icon := ":white_check_mark:"
+	switch {
+	case !msg.Status.IsDone():
+		icon = ":hourglass_flowing_sand:"
+	case msg.Failed():
+		icon = ":x:"
+	}
+
+	title := slackEscaper.Replace(msg.Summary())
+	if msg.URL != "" {
+		title = "<" + msg.URL + "|" + title + ">"
+	}
+
+	blocks := []slack.Block{
+		slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, icon+" *"+title+"*", false, false),
+			nil, nil),
+	}
+
+	if !details {
+		return blocks
+	}
+
+	if msg.CustomBody != "" {
+		blocks = append(blocks, slack.NewSectionBlock(slackText(msg.CustomBody), nil, nil))
+	} else {
+		var fields []*slack.TextBlockObject
+		for _, field := range msg.Fields() {
+			if field[0] == "Repository" || field[0] == "Error" {
+				continue
+			}
+			fields = append(fields, slackText("*"+field[0]+"*\n"+field[1]))
+		}
+		if len(fields) > 0 {
+			blocks = append(blocks, slack.NewSectionBlock(nil, fields, nil))
+		}
+		if msg.Error != "" {
+			blocks = append(blocks, slack.NewSectionBlock(slackText("```"+msg.Error+"```"), nil, nil))
+		}
+	}
+
+	if msg.URL != "" {
+		button := slack.NewButtonBlockElement("view_execution", "",
+			slack.NewTextBlockObject(slack.PlainTextType, "View execution", false, false))
+		button.URL = msg.URL
+		blocks = append(blocks, slack.NewActionBlock("", button))
+	}
+
+	return blocks
+}
+
+// slackText returns an escaped markdown text object.
+func slackText(text string) *slack.TextBlockObject {
+	text = slackEscaper.Replace(text)
+	if len(text) > slackMaxTextLength {
+		text = strings.ToValidUTF8(text[:slackMaxTextLength], "") + "…"
+	}
+
+	return slack.NewTextBlockObject(slack.MarkdownType, text, false, false)
+}
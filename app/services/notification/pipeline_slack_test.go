@@ -0,0 +1,128 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// memorySlackMessageStore is an in-memory store.SlackMessageStore.
+type memorySlackMessageStore struct {
+	messages map[int64]types.SlackMessage
+}
+
+func (s *memorySlackMessageStore) Find(_ context.Context, executionID int64) (*types.SlackMessage, error) {
+	msg, ok := s.messages[executionID]
+	if !ok {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+	return &msg, nil
+}
+
+func (s *memorySlackMessageStore) Upsert(_ context.Context, msg *types.SlackMessage) error {
+	s.messages[msg.ExecutionID] = *msg
+	return nil
+}
+
+func (s *memorySlackMessageStore) Delete(_ context.Context, executionID int64) error {
+	delete(s.messages, executionID)
+	return nil
+}
+
+func (s *memorySlackMessageStore) DeleteBefore(_ context.Context, before int64) error {
+	for id, msg := range s.messages {
+		if msg.Created < before {
+			delete(s.messages, id)
+		}
+	}
+	return nil
+}
+
+func TestSlackBotPipelineSender_Send(t *testing.T) {
+	ctx := context.Background()
+
+	var calls []string
+	failUpdate := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method := strings.TrimPrefix(r.URL.Path, "/")
+		calls = append(calls, method)
+
+		w.Header().Set("Content-Type", "application/json")
+		if method == "chat.update" && failUpdate {
+			_, _ = w.Write([]byte(`{"ok":false,"error":"internal_error"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true,"channel":"C1","ts":"1.0"}`))
+	}))
+	defer server.Close()
+
+	store := &memorySlackMessageStore{messages: map[int64]types.SlackMessage{
+		// the message of an execution that never finished.
+		2: {ExecutionID: 2, Channel: "C1", TS: "0.1", Created: 1},
+	}}
+
+	sender := newSlackBotPipelineSender(SlackConfig{
+		APIURL:   server.URL + "/",
+		BotToken: "xoxb-token",
+		Channel:  "builds",
+		Statuses: []enum.CIStatus{enum.CIStatusFailure},
+	}, store)
+
+	payload := &PipelineExecutedPayload{
+		Repo:      &types.Repository{Path: "space/repo"},
+		Pipeline:  &types.Pipeline{ID: 1, Identifier: "build"},
+		Execution: &types.Execution{ID: 1, Number: 7, Status: enum.CIStatusRunning, Ref: "refs/heads/main"},
+	}
+
+	if err := sender.SendStarted(ctx, payload); err != nil {
+		t.Fatalf("SendStarted() failed: %v", err)
+	}
+	if _, ok := store.messages[2]; ok {
+		t.Error("expected the expired message to be deleted")
+	}
+	if _, ok := store.messages[1]; !ok {
+		t.Fatal("expected the started message to be stored")
+	}
+
+	payload.Execution.Status = enum.CIStatusFailure
+
+	if err := sender.Send(ctx, payload); err == nil {
+		t.Fatal("expected Send() to fail")
+	}
+	if _, ok := store.messages[1]; !ok {
+		t.Fatal("expected the started message to be kept after a failed update")
+	}
+
+	failUpdate = false
+	if err := sender.Send(ctx, payload); err != nil {
+		t.Fatalf("Send() failed: %v", err)
+	}
+	if _, ok := store.messages[1]; ok {
+		t.Error("expected the started message to be deleted after the update")
+	}
+
+	want := []string{"chat.postMessage", "chat.update", "chat.update"}
+	if strings.Join(calls, ",") != strings.Join(want, ",") {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sns"
+)
+
+type SNSConfig struct {
+	TopicARN string
+	// Region is the region of the topic, if not set the region is taken from the topic ARN.
+	Region   string
+	Endpoint string
+	// AccessKeyID and SecretAccessKey are used for authentication if set,
+	// otherwise the default credential chain is used (environment, shared config, IAM role).
+	AccessKeyID     string
+	SecretAccessKey string
+	// Statuses are the execution statuses for which a message is published.
+	Statuses []enum.CIStatus
+	// Filter further limits the executions for which a message is published.
+	Filter PipelineFilterConfig
+}
+
+// snsPipelineSender publishes the result of pipeline executions as json to an AWS SNS topic.
+type snsPipelineSender struct {
+	config SNSConfig
+	client *sns.SNS
+}
+
+func newSNSPipelineSender(config SNSConfig) (*snsPipelineSender, error) {
+	region := config.Region
+	if region == "" {
+		// arn:aws:sns:<region>:<account-id>:<topic>
+		if parts := strings.Split(config.TopicARN, ":"); len(parts) == 6 {
+			region = parts[3]
+		}
+	}
+
+	awsConfig := &aws.Config{
+		Region: aws.String(region),
+	}
+	if config.Endpoint != "" {
+		awsConfig.Endpoint = aws.String(config.Endpoint)
+	}
+	if config.AccessKeyID != "" {
+		awsConfig.Credentials = credentials.NewStaticCredentials(config.AccessKeyID, config.SecretAccessKey, "")
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create aws session: %w", err)
+	}
+
+	return &snsPipelineSender{
+		config: config,
+		client: sns.New(sess),
+	}, nil
+}
+
+// snsMessage is the json document published for every execution.
+type snsMessage struct {
+	Repo struct {
+		ID   int64  `json:"id"`
+		Path string `json:"path"`
+	} `json:"repo"`
+	Pipeline struct {
+		ID         int64  `json:"id"`
+		Identifier string `json:"identifier"`
+	} `json:"pipeline"`
+	Execution struct {
+		Number      int64             `json:"number"`
+		Status      enum.CIStatus     `json:"status"`
+		Error       string            `json:"error,omitempty"`
+		Event       enum.TriggerEvent `json:"event"`
+		Ref         string            `json:"ref"`
+		BeforeSHA   string            `json:"before_sha,omitempty"`
+		SHA         string            `json:"sha"`
+		Title       string            `json:"title,omitempty"`
+		Message     string            `json:"message,omitempty"`
+		AuthorLogin string            `json:"author_login,omitempty"`
+		AuthorName  string            `json:"author_name,omitempty"`
+		AuthorEmail string            `json:"author_email,omitempty"`
+		Started     int64             `json:"started"`
+		Finished    int64             `json:"finished"`
+		URL         string            `json:"url"`
+	} `json:"execution"`
+}
+
+func (s *snsPipelineSender) Name() string {
+	return "sns"
+}
+
+func (s *snsPipelineSender) Send(ctx context.Context, payload *PipelineExecutedPayload) error {
+	if !containsStatus(s.config.Statuses, payload.Execution.Status) {
+		return nil
+	}
+
+	execution := payload.Execution
+
+	msg := snsMessage{}
+	msg.Repo.ID = payload.Repo.ID
+	msg.Repo.Path = payload.Repo.Path
+	msg.Pipeline.ID = payload.Pipeline.ID
+	msg.Pipeline.Identifier = payload.Pipeline.Identifier
+	msg.Execution.Number = execution.Number
+	msg.Execution.Status = execution.Status
+	msg.Execution.Error = execution.Error
+	msg.Execution.Event = execution.Event
+	msg.Execution.Ref = execution.Ref
+	msg.Execution.BeforeSHA = execution.Before
+	msg.Execution.SHA = execution.After
+	msg.Execution.Title = execution.Title
+	msg.Execution.Message = execution.Message
+	msg.Execution.AuthorLogin = execution.Author
+	msg.Execution.AuthorName = execution.AuthorName
+	msg.Execution.AuthorEmail = execution.AuthorEmail
+	msg.Execution.Started = execution.Started
+	msg.Execution.Finished = execution.Finished
+	msg.Execution.URL = payload.ExecutionURL
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal sns message: %w", err)
+	}
+
+	_, err = s.client.PublishWithContext(ctx, &sns.PublishInput{
+		TopicArn: aws.String(s.config.TopicARN),
+		Message:  aws.String(string(data)),
+		// attributes allow subscribers to filter the messages without parsing them.
+		MessageAttributes: map[string]*sns.MessageAttributeValue{
+			"status":   snsStringAttribute(string(execution.Status)),
+			"repo":     snsStringAttribute(payload.Repo.Path),
+			"pipeline": snsStringAttribute(payload.Pipeline.Identifier),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish sns message: %w", err)
+	}
+
+	return nil
+}
+
+func snsStringAttribute(value string) *sns.MessageAttributeValue {
+	return &sns.MessageAttributeValue{
+		DataType:    aws.String("String"),
+		StringValue: aws.String(value),
+	}
+}
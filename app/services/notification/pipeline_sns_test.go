@@ -0,0 +1,146 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/harness/gitness/types/enum"
+)
+
+const (
+	snsPublishResponse = `<PublishResponse xmlns="http://sns.amazonaws.com/doc/2010-03-31/">` +
+		`<PublishResult><MessageId>msg-1</MessageId></PublishResult>` +
+		`<ResponseMetadata><RequestId>req-1</RequestId></ResponseMetadata></PublishResponse>`
+	snsErrorResponse = `<ErrorResponse xmlns="http://sns.amazonaws.com/doc/2010-03-31/">` +
+		`<Error><Type>Sender</Type><Code>NotFound</Code><Message>Topic does not exist</Message></Error>` +
+		`<RequestId>req-1</RequestId></ErrorResponse>`
+)
+
+func TestSNSPipelineSender_Send(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  *PipelineExecutedPayload
+		status   int
+		wantSent bool
+		wantErr  bool
+	}{
+		{
+			name:     "execution failed",
+			payload:  newTestPipelinePayload(enum.CIStatusFailure),
+			status:   http.StatusOK,
+			wantSent: true,
+		},
+		{
+			name:    "status not configured",
+			payload: newTestPipelinePayload(enum.CIStatusSuccess),
+			status:  http.StatusOK,
+		},
+		{
+			name:     "error response",
+			payload:  newTestPipelinePayload(enum.CIStatusFailure),
+			status:   http.StatusNotFound,
+			wantSent: true,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var forms []url.Values
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					t.Errorf("failed to parse form: %v", err)
+				}
+				forms = append(forms, r.PostForm)
+
+				w.Header().Set("Content-Type", "text/xml")
+				w.WriteHeader(tt.status)
+				if tt.status == http.StatusOK {
+					_, _ = w.Write([]byte(snsPublishResponse))
+				} else {
+					_, _ = w.Write([]byte(snsErrorResponse))
+				}
+			}))
+			defer server.Close()
+
+			sender, err := newSNSPipelineSender(SNSConfig{
+				TopicARN:        "arn:aws:sns:eu-west-1:123456789012:builds",
+				Endpoint:        server.URL,
+				AccessKeyID:     "key",
+				SecretAccessKey: "secret",
+				Statuses:        []enum.CIStatus{enum.CIStatusFailure},
+			})
+			if err != nil {
+				t.Fatalf("failed to create sender: %v", err)
+			}
+
+			err = sender.Send(context.Background(), tt.payload)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Send() err = %v, want error %t", err, tt.wantErr)
+			}
+
+			if !tt.wantSent {
+				if len(forms) != 0 {
+					t.Errorf("expected no message, got %d", len(forms))
+				}
+				return
+			}
+			if len(forms) != 1 {
+				t.Fatalf("expected one message, got %d", len(forms))
+			}
+
+			form := forms[0]
+			if got := form.Get("Action"); got != "Publish" {
+				t.Errorf("action = %q, want %q", got, "Publish")
+			}
+			if got := form.Get("TopicArn"); got != "arn:aws:sns:eu-west-1:123456789012:builds" {
+				t.Errorf("topic = %q", got)
+			}
+
+			attributes := map[string]string{}
+			for i := 1; form.Has(snsAttributeKey(i, "Name")); i++ {
+				attributes[form.Get(snsAttributeKey(i, "Name"))] = form.Get(snsAttributeKey(i, "Value.StringValue"))
+			}
+			wantAttributes := map[string]string{"status": "failure", "repo": "space/repo", "pipeline": "build"}
+			for key, value := range wantAttributes {
+				if attributes[key] != value {
+					t.Errorf("attribute %s = %q, want %q", key, attributes[key], value)
+				}
+			}
+
+			var msg snsMessage
+			if err := json.Unmarshal([]byte(form.Get("Message")), &msg); err != nil {
+				t.Fatalf("failed to decode message: %v", err)
+			}
+			if msg.Repo.Path != "space/repo" || msg.Pipeline.Identifier != "build" ||
+				msg.Execution.Number != 7 || msg.Execution.Status != enum.CIStatusFailure ||
+				msg.Execution.Ref != "refs/heads/main" || msg.Execution.SHA != "0123456789abcdef" ||
+				msg.Execution.URL != testExecutionURL {
+				t.Errorf("unexpected message: %+v", msg)
+			}
+		})
+	}
+}
+
+func snsAttributeKey(i int, field string) string {
+	return "MessageAttributes.entry." + strconv.Itoa(i) + "." + field
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/harness/gitness/types/enum"
+)
+
+// telegramMarkdownReplacer escapes all characters that are reserved in telegram's MarkdownV2.
+var telegramMarkdownReplacer = strings.NewReplacer(
+	`\`, `\\`, "_", `\_`, "*", `\*`, "[", `\[`, "]", `\]`, "(", `\(`, ")", `\)`, "~", `\~`, "`", "\\`",
+	">", `\>`, "#", `\#`, "+", `\+`, "-", `\-`, "=", `\=`, "|", `\|`, "{", `\{`, "}", `\}`, ".", `\.`, "!", `\!`,
+)
+
+// telegramLinkReplacer escapes all characters that are reserved inside of a MarkdownV2 inline link.
+var telegramLinkReplacer = strings.NewReplacer(`\`, `\\`, ")", `\)`)
+
+type TelegramConfig struct {
+	APIURL   string
+	BotToken string
+	ChatID   string
+	// Statuses are the execution statuses for which a message is posted.
+	Statuses []enum.CIStatus
+	// SilentSuccess sends messages of successful executions without a notification sound.
+	SilentSuccess bool
+	// Filter further limits the executions for which a message is posted.
+	Filter PipelineFilterConfig
+}
+
+// telegramPipelineSender posts the result of pipeline executions to a telegram chat using a bot.
+type telegramPipelineSender struct {
+	config TelegramConfig
+	client *http.Client
+}
+
+func newTelegramPipelineSender(config TelegramConfig) *telegramPipelineSender {
+	return &telegramPipelineSender{
+		config: config,
+		client: newPipelineSenderHTTPClient(),
+	}
+}
+
+type telegramSendMessageRequest struct {
+	ChatID                string `json:"chat_id"`
+	Text                  string `json:"text"`
+	ParseMode             string `json:"parse_mode"`
+	DisableNotification   bool   `json:"disable_notification"`
+	DisableWebPagePreview bool   `json:"disable_web_page_preview"`
+}
+
+func (s *telegramPipelineSender) Name() string {
+	return "telegram"
+}
+
+func (s *telegramPipelineSender) Send(ctx context.Context, payload *PipelineExecutedPayload) error {
+	if !containsStatus(s.config.Statuses, payload.Execution.Status) {
+		return nil
+	}
+
+	msg := newPipelineMessage(payload)
+
+	url := fmt.Sprintf("%s/bot%s/sendMessage", strings.TrimSuffix(s.config.APIURL, "/"), s.config.BotToken)
+
+	return postJSON(ctx, s.client, url, telegramSendMessageRequest{
+		ChatID:                s.config.ChatID,
+		Text:                  telegramText(msg),
+		ParseMode:             "MarkdownV2",
+		DisableNotification:   s.config.SilentSuccess && msg.Status == enum.CIStatusSuccess,
+		DisableWebPagePreview: true,
+	}, nil)
+}
+
+func telegramText(msg pipelineMessage) string {
+	icon := "✅"
+	if msg.Failed() {
+		icon = "❌"
+	}
+
+	sb := strings.Builder{}
+	sb.WriteString(icon)
+	sb.WriteString(" *")
+	if msg.CustomTitle != "" {
+		sb.WriteString(telegramMarkdownReplacer.Replace(msg.CustomTitle))
+	} else {
+		sb.WriteString(telegramMarkdownReplacer.Replace(msg.Title()))
+	}
+	sb.WriteString("*")
+	if msg.CustomBody != "" {
+		sb.WriteString("\n")
+		sb.WriteString(telegramMarkdownReplacer.Replace(msg.CustomBody))
+	} else {
+		for _, field := range msg.Fields() {
+			sb.WriteString("\n")
+			sb.WriteString(telegramMarkdownReplacer.Replace(field[0]))
+			sb.WriteString(": ")
+			sb.WriteString(telegramMarkdownReplacer.Replace(field[1]))
+		}
+	}
+	sb.WriteString("\n[View execution](")
+	sb.WriteString(telegramLinkReplacer.Replace(msg.URL))
+	sb.WriteString(")")
+
+	return sb.String()
+}
@@ -0,0 +1,135 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/harness/gitness/types/enum"
+)
+
+func TestTelegramPipelineSender_Send(t *testing.T) {
+	failed := newTestPipelinePayload(enum.CIStatusFailure)
+	failed.Execution.Error = "exit code 1"
+
+	custom := newTestPipelinePayload(enum.CIStatusSuccess)
+	custom.Title = "Deployed v1.2"
+	custom.Body = "Released by (Jane)"
+
+	tests := []struct {
+		name     string
+		payload  *PipelineExecutedPayload
+		status   int
+		wantSent bool
+		want     telegramSendMessageRequest
+		wantErr  bool
+	}{
+		{
+			name:     "execution failed",
+			payload:  failed,
+			status:   http.StatusOK,
+			wantSent: true,
+			want: telegramSendMessageRequest{
+				ChatID: "-100123",
+				Text: "❌ *build \\#7 failed*\n" +
+					"Repository: space/repo\nRef: main\nCommit: 01234567 Fix the build\nAuthor: Jane\n" +
+					"Error: exit code 1\n" +
+					"[View execution](" + testExecutionURL + ")",
+				ParseMode:             "MarkdownV2",
+				DisableWebPagePreview: true,
+			},
+		},
+		{
+			name:     "silent success with custom template",
+			payload:  custom,
+			status:   http.StatusOK,
+			wantSent: true,
+			want: telegramSendMessageRequest{
+				ChatID:                "-100123",
+				Text:                  "✅ *Deployed v1\\.2*\nReleased by \\(Jane\\)\n[View execution](" + testExecutionURL + ")",
+				ParseMode:             "MarkdownV2",
+				DisableNotification:   true,
+				DisableWebPagePreview: true,
+			},
+		},
+		{
+			name:     "status not configured",
+			payload:  newTestPipelinePayload(enum.CIStatusKilled),
+			status:   http.StatusOK,
+			wantSent: false,
+		},
+		{
+			name:     "error response",
+			payload:  failed,
+			status:   http.StatusBadRequest,
+			wantSent: true,
+			want: telegramSendMessageRequest{
+				ChatID: "-100123",
+				Text: "❌ *build \\#7 failed*\n" +
+					"Repository: space/repo\nRef: main\nCommit: 01234567 Fix the build\nAuthor: Jane\n" +
+					"Error: exit code 1\n" +
+					"[View execution](" + testExecutionURL + ")",
+				ParseMode:             "MarkdownV2",
+				DisableWebPagePreview: true,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newRecordingServer(t, respondWith(tt.status))
+
+			sender := newTelegramPipelineSender(TelegramConfig{
+				APIURL:        server.URL + "/",
+				BotToken:      "123:token",
+				ChatID:        "-100123",
+				Statuses:      []enum.CIStatus{enum.CIStatusSuccess, enum.CIStatusFailure},
+				SilentSuccess: true,
+			})
+
+			err := sender.Send(context.Background(), tt.payload)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Send() err = %v, want error %t", err, tt.wantErr)
+			}
+
+			requests := server.Requests()
+			if !tt.wantSent {
+				if len(requests) != 0 {
+					t.Errorf("expected no message, got %d", len(requests))
+				}
+				return
+			}
+			if len(requests) != 1 {
+				t.Fatalf("expected one message, got %d", len(requests))
+			}
+
+			if path := requests[0].Path; path != "/bot123:token/sendMessage" {
+				t.Errorf("path = %q, want %q", path, "/bot123:token/sendMessage")
+			}
+
+			var got telegramSendMessageRequest
+			if err := json.Unmarshal([]byte(requests[0].Body), &got); err != nil {
+				t.Fatalf("failed to decode message: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("message = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
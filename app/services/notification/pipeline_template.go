@@ -0,0 +1,169 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// PipelineTemplateConfig contains the go templates used to customize the pipeline notification messages.
+// The templates are shared by all channels that show a title or message body, empty templates use the defaults.
+// They're only configured server-wide, as the channels are server-wide as well.
+type PipelineTemplateConfig struct {
+	Title string
+	Body  string
+}
+
+// pipelineTemplateData is the data passed to the pipeline notification templates.
+type pipelineTemplateData struct {
+	Repo      *types.Repository
+	Pipeline  *types.Pipeline
+	Execution *types.Execution
+	// Previous is the previous completed execution of the same ref, nil if there is none.
+	Previous *types.Execution
+	// Stages contains the stages of the execution including their steps.
+	Stages []*types.Stage
+
+	Status      enum.CIStatus
+	StatusText  string
+	Failed      bool
+	Ref         string
+	CommitSHA   string
+	CommitTitle string
+	Author      string
+	Duration    time.Duration
+	URL         string
+}
+
+// pipelineTemplates renders the custom title and body of pipeline notifications.
+type pipelineTemplates struct {
+	title *template.Template
+	body  *template.Template
+}
+
+var pipelineTemplateFuncs = template.FuncMap{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"trim":  strings.TrimSpace,
+	"truncate": func(length int, s string) string {
+		if length < 0 || len(s) <= length {
+			return s
+		}
+		return strings.ToValidUTF8(s[:length], "")
+	},
+	"since": func(millis int64) time.Duration {
+		return time.Since(time.UnixMilli(millis)).Truncate(time.Second)
+	},
+}
+
+// ValidatePipelineTemplates returns an error if any of the templates can't be parsed,
+// or refers to data that doesn't exist.
+func ValidatePipelineTemplates(config PipelineTemplateConfig) error {
+	t, err := newPipelineTemplates(config)
+	if err != nil {
+		return err
+	}
+
+	// render the templates with an empty execution, so that errors like unknown fields show up right away.
+	_, _, err = t.Render(&PipelineExecutedPayload{
+		Repo:      &types.Repository{},
+		Pipeline:  &types.Pipeline{},
+		Execution: &types.Execution{},
+		Previous:  &types.Execution{},
+	})
+
+	return err
+}
+
+func newPipelineTemplates(config PipelineTemplateConfig) (*pipelineTemplates, error) {
+	t := &pipelineTemplates{}
+
+	var err error
+	if config.Title != "" {
+		t.title, err = template.New("title").Funcs(pipelineTemplateFuncs).Parse(config.Title)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse title template: %w", err)
+		}
+	}
+
+	if config.Body != "" {
+		t.body, err = template.New("body").Funcs(pipelineTemplateFuncs).Parse(config.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse body template: %w", err)
+		}
+	}
+
+	return t, nil
+}
+
+// Render returns the custom title and body of the notification, empty if no template is configured.
+func (t *pipelineTemplates) Render(payload *PipelineExecutedPayload) (string, string, error) {
+	if t.title == nil && t.body == nil {
+		return "", "", nil
+	}
+
+	msg := newPipelineMessage(payload)
+	data := pipelineTemplateData{
+		Repo:        payload.Repo,
+		Pipeline:    payload.Pipeline,
+		Execution:   payload.Execution,
+		Previous:    payload.Previous,
+		Stages:      payload.Stages,
+		Status:      msg.Status,
+		StatusText:  msg.StatusText(),
+		Failed:      msg.Failed(),
+		Ref:         msg.Ref,
+		CommitSHA:   msg.CommitSHA,
+		CommitTitle: msg.CommitTitle,
+		Author:      msg.Author,
+		Duration:    msg.Duration,
+		URL:         msg.URL,
+	}
+
+	title, err := executePipelineTemplate(t.title, data)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to render title template: %w", err)
+	}
+
+	body, err := executePipelineTemplate(t.body, data)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to render body template: %w", err)
+	}
+
+	// titles are shown in a single line by all channels.
+	title = strings.Join(strings.Fields(title), " ")
+
+	return title, strings.TrimSpace(body), nil
+}
+
+func executePipelineTemplate(t *template.Template, data pipelineTemplateData) (string, error) {
+	if t == nil {
+		return "", nil
+	}
+
+	buf := bytes.Buffer{}
+	if err := t.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
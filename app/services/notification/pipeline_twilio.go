@@ -0,0 +1,229 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+	"go.uber.org/multierr"
+)
+
+// twilioMaxBodyLength limits the sms body to two sms segments.
+const twilioMaxBodyLength = 306
+
+type TwilioConfig struct {
+	APIURL     string
+	AccountSID string
+	AuthToken  string
+	// From is the twilio phone number (or messaging service sid) the messages are sent from.
+	From string
+	// To are the phone numbers that receive the messages.
+	To []string
+	// Statuses are the execution and step statuses for which a message is sent.
+	Statuses []enum.CIStatus
+	// Steps are the glob patterns of the step names (e.g. "deploy*") messages are limited to.
+	// If set, a message is only sent if a matching step ended with one of the Statuses,
+	// otherwise a message is sent if the execution or any of its steps did.
+	Steps []string
+	// RateLimit is the max number of messages sent per pipeline and ref within the RateLimitWindow.
+	// A value of zero disables the rate limit.
+	RateLimit       int
+	RateLimitWindow time.Duration
+	// Filter further limits the executions for which a message is sent.
+	Filter PipelineFilterConfig
+}
+
+// twilioPipelineSender sends the result of pipeline executions as sms using twilio.
+type twilioPipelineSender struct {
+	config  TwilioConfig
+	client  *http.Client
+	limiter *pipelineRateLimiter
+}
+
+func newTwilioPipelineSender(config TwilioConfig) *twilioPipelineSender {
+	return &twilioPipelineSender{
+		config:  config,
+		client:  newPipelineSenderHTTPClient(),
+		limiter: newPipelineRateLimiter(config.RateLimit, config.RateLimitWindow),
+	}
+}
+
+func (s *twilioPipelineSender) Name() string {
+	return "twilio"
+}
+
+func (s *twilioPipelineSender) Send(ctx context.Context, payload *PipelineExecutedPayload) error {
+	failedSteps := s.failedSteps(payload)
+
+	// steps with ignored failures don't fail the execution, so they're checked separately.
+	executionFailed := len(s.config.Steps) == 0 && containsStatus(s.config.Statuses, payload.Execution.Status)
+	if !executionFailed && len(failedSteps) == 0 {
+		return nil
+	}
+
+	// the rate limit is applied per pipeline and ref, to prevent a flapping branch from exhausting the quota.
+	key := fmt.Sprintf("%d/%s", payload.Pipeline.ID, payload.Execution.Ref)
+	allowed, last := s.limiter.Allow(key, payload.Execution.ID, time.Now())
+	if !allowed {
+		log.Ctx(ctx).Info().
+			Str("repo", payload.Repo.Path).
+			Str("pipeline", payload.Pipeline.Identifier).
+			Str("ref", payload.Execution.Ref).
+			Msg("sms notification skipped due to rate limit")
+		return nil
+	}
+
+	msg := newPipelineMessage(payload)
+
+	body := msg.Summary()
+	if msg.Ref != "" {
+		body += " (" + msg.Ref + ")"
+	}
+	if len(failedSteps) > 0 {
+		body += " - " + strings.Join(failedSteps, ", ")
+	}
+	if last {
+		body += fmt.Sprintf(" - further messages suppressed for %s", s.config.RateLimitWindow)
+	}
+	if msg.URL != "" {
+		body += " " + msg.URL
+	}
+	if len(body) > twilioMaxBodyLength {
+		body = strings.ToValidUTF8(body[:twilioMaxBodyLength-len("…")], "") + "…"
+	}
+
+	form := url.Values{}
+	form.Set("Body", body)
+	if strings.HasPrefix(s.config.From, "MG") {
+		form.Set("MessagingServiceSid", s.config.From)
+	} else {
+		form.Set("From", s.config.From)
+	}
+
+	endpoint := fmt.Sprintf("%s/2010-04-01/Accounts/%s/Messages.json",
+		strings.TrimSuffix(s.config.APIURL, "/"), url.PathEscape(s.config.AccountSID))
+
+	// every number gets a separate message to ensure an invalid number doesn't prevent others from being notified.
+	var errs error
+	for _, to := range s.config.To {
+		form.Set("To", to)
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth(s.config.AccountSID, s.config.AuthToken)
+
+		if err := doRequest(s.client, req); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// failedSteps returns the "stage/step: status" of the steps that ended with one of the configured statuses
+// and match the configured step patterns.
+func (s *twilioPipelineSender) failedSteps(payload *PipelineExecutedPayload) []string {
+	var steps []string
+	for _, stage := range payload.Stages {
+		for _, step := range stage.Steps {
+			if !containsStatus(s.config.Statuses, step.Status) {
+				continue
+			}
+			if len(s.config.Steps) > 0 && !matchesAnyPattern(s.config.Steps, step.Name) {
+				continue
+			}
+			steps = append(steps, fmt.Sprintf("%s/%s: %s", stage.Name, step.Name, step.Status))
+		}
+	}
+
+	return steps
+}
+
+// pipelineRateLimiter limits the number of notifications per key within a fixed time window.
+type pipelineRateLimiter struct {
+	limit  int
+	window time.Duration
+
+	mx      sync.Mutex
+	windows map[string]*pipelineRateLimitWindow
+}
+
+type pipelineRateLimitWindow struct {
+	start time.Time
+	count int
+	// executions are the executions that were allowed in the window (mapped to whether they were the last one),
+	// retries of the same execution don't count against the limit.
+	executions map[int64]bool
+}
+
+func newPipelineRateLimiter(limit int, window time.Duration) *pipelineRateLimiter {
+	return &pipelineRateLimiter{
+		limit:   limit,
+		window:  window,
+		windows: make(map[string]*pipelineRateLimitWindow),
+	}
+}
+
+// Allow returns whether a notification for the execution is allowed,
+// and whether it is the last one allowed within the current window.
+func (l *pipelineRateLimiter) Allow(key string, executionID int64, now time.Time) (bool, bool) {
+	if l.limit <= 0 {
+		return true, false
+	}
+
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	// remove expired windows to keep the map from growing indefinitely.
+	for k, w := range l.windows {
+		if now.Sub(w.start) >= l.window {
+			delete(l.windows, k)
+		}
+	}
+
+	w, ok := l.windows[key]
+	if !ok {
+		w = &pipelineRateLimitWindow{
+			start:      now,
+			executions: make(map[int64]bool),
+		}
+		l.windows[key] = w
+	}
+
+	if last, ok := w.executions[executionID]; ok {
+		return true, last
+	}
+
+	if w.count >= l.limit {
+		return false, false
+	}
+
+	w.count++
+	w.executions[executionID] = w.count == l.limit
+
+	return true, w.executions[executionID]
+}
@@ -0,0 +1,218 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+func TestPipelineRateLimiter_Allow(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type call struct {
+		key         string
+		executionID int64
+		after       time.Duration
+		wantAllowed bool
+		wantLast    bool
+	}
+
+	tests := []struct {
+		name  string
+		limit int
+		calls []call
+	}{
+		{
+			name:  "disabled",
+			limit: 0,
+			calls: []call{
+				{key: "a", executionID: 1, wantAllowed: true},
+				{key: "a", executionID: 2, wantAllowed: true},
+				{key: "a", executionID: 3, wantAllowed: true},
+			},
+		},
+		{
+			name:  "limit reached",
+			limit: 2,
+			calls: []call{
+				{key: "a", executionID: 1, wantAllowed: true},
+				{key: "a", executionID: 2, wantAllowed: true, wantLast: true},
+				{key: "a", executionID: 3, wantAllowed: false},
+			},
+		},
+		{
+			name:  "retries don't count",
+			limit: 2,
+			calls: []call{
+				{key: "a", executionID: 1, wantAllowed: true},
+				{key: "a", executionID: 1, wantAllowed: true},
+				{key: "a", executionID: 2, wantAllowed: true, wantLast: true},
+				{key: "a", executionID: 2, wantAllowed: true, wantLast: true},
+			},
+		},
+		{
+			name:  "keys are independent",
+			limit: 1,
+			calls: []call{
+				{key: "a", executionID: 1, wantAllowed: true, wantLast: true},
+				{key: "b", executionID: 2, wantAllowed: true, wantLast: true},
+				{key: "a", executionID: 3, wantAllowed: false},
+			},
+		},
+		{
+			name:  "window expires",
+			limit: 1,
+			calls: []call{
+				{key: "a", executionID: 1, wantAllowed: true, wantLast: true},
+				{key: "a", executionID: 2, after: 59 * time.Minute, wantAllowed: false},
+				{key: "a", executionID: 3, after: time.Hour, wantAllowed: true, wantLast: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := newPipelineRateLimiter(tt.limit, time.Hour)
+			for i, c := range tt.calls {
+				allowed, last := limiter.Allow(c.key, c.executionID, start.Add(c.after))
+				if allowed != c.wantAllowed || last != c.wantLast {
+					t.Errorf("call %d: Allow() = (%t, %t), want (%t, %t)",
+						i, allowed, last, c.wantAllowed, c.wantLast)
+				}
+			}
+		})
+	}
+}
+
+func TestTwilioPipelineSender_Send(t *testing.T) {
+	newPayload := func(status enum.CIStatus, stages ...*types.Stage) *PipelineExecutedPayload {
+		return &PipelineExecutedPayload{
+			Repo:      &types.Repository{Path: "space/repo"},
+			Pipeline:  &types.Pipeline{ID: 1, Identifier: "build"},
+			Execution: &types.Execution{ID: 1, Number: 7, Status: status, Ref: "refs/heads/main"},
+			Stages:    stages,
+		}
+	}
+
+	deployStage := &types.Stage{
+		Name: "release",
+		Steps: []*types.Step{
+			{Name: "test", Status: enum.CIStatusSuccess},
+			{Name: "deploy-prod", Status: enum.CIStatusFailure, ErrIgnore: true},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		steps    []string
+		payload  *PipelineExecutedPayload
+		wantSent bool
+		wantBody string
+	}{
+		{
+			name:     "execution succeeded",
+			payload:  newPayload(enum.CIStatusSuccess),
+			wantSent: false,
+		},
+		{
+			name:     "execution failed",
+			payload:  newPayload(enum.CIStatusFailure),
+			wantSent: true,
+			wantBody: "space/repo: build #7 failed (main)",
+		},
+		{
+			name:     "step failure ignored by the execution",
+			payload:  newPayload(enum.CIStatusSuccess, deployStage),
+			wantSent: true,
+			wantBody: "space/repo: build #7 succeeded (main) - release/deploy-prod: failure",
+		},
+		{
+			name:     "step patterns match",
+			steps:    []string{"deploy*"},
+			payload:  newPayload(enum.CIStatusFailure, deployStage),
+			wantSent: true,
+			wantBody: "space/repo: build #7 failed (main) - release/deploy-prod: failure",
+		},
+		{
+			name:     "step patterns don't match",
+			steps:    []string{"publish*"},
+			payload:  newPayload(enum.CIStatusFailure, deployStage),
+			wantSent: false,
+		},
+		{
+			name: "body truncated on rune boundary",
+			payload: newPayload(enum.CIStatusFailure, &types.Stage{
+				Name:  "stage",
+				Steps: []*types.Step{{Name: strings.Repeat("ü", 200), Status: enum.CIStatusFailure}},
+			}),
+			wantSent: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var bodies []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					t.Errorf("failed to parse form: %v", err)
+				}
+				bodies = append(bodies, r.PostForm.Get("Body"))
+				w.WriteHeader(http.StatusCreated)
+			}))
+			defer server.Close()
+
+			sender := newTwilioPipelineSender(TwilioConfig{
+				APIURL:     server.URL,
+				AccountSID: "AC123",
+				From:       "+15550000000",
+				To:         []string{"+15551111111"},
+				Statuses:   []enum.CIStatus{enum.CIStatusFailure, enum.CIStatusError},
+				Steps:      tt.steps,
+			})
+
+			if err := sender.Send(context.Background(), tt.payload); err != nil {
+				t.Fatalf("Send() failed: %v", err)
+			}
+
+			if !tt.wantSent {
+				if len(bodies) != 0 {
+					t.Errorf("expected no message, got %q", bodies)
+				}
+				return
+			}
+
+			if len(bodies) != 1 {
+				t.Fatalf("expected one message, got %d", len(bodies))
+			}
+
+			body := bodies[0]
+			if len(body) > twilioMaxBodyLength || !utf8.ValidString(body) {
+				t.Errorf("body isn't valid utf-8 within %d bytes: %q", twilioMaxBodyLength, body)
+			}
+			if tt.wantBody != "" && body != tt.wantBody {
+				t.Errorf("body = %q, want %q", body, tt.wantBody)
+			}
+		})
+	}
+}
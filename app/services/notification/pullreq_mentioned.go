@@ -0,0 +1,97 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/types"
+)
+
+type PullReqMentionedPayload struct {
+	Base      *BasePullReqPayload
+	Mentioner *types.PrincipalInfo
+}
+
+func (s *Service) notifyPullReqMentioned(
+	ctx context.Context,
+	event *events.Event[*pullreqevents.MentionedPayload],
+) error {
+	payload, recipients, err := s.processPullReqMentionedEvent(ctx, event)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to process %s event for pullReqID %d: %w",
+			pullreqevents.MentionedEvent,
+			event.Payload.PullReqID,
+			err,
+		)
+	}
+
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	err = s.notificationClient.SendPullReqMentions(ctx, recipients, payload)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to send notification for event %s for pullReqID %d: %w",
+			pullreqevents.MentionedEvent,
+			event.Payload.PullReqID,
+			err,
+		)
+	}
+
+	return nil
+}
+
+func (s *Service) processPullReqMentionedEvent(
+	ctx context.Context,
+	event *events.Event[*pullreqevents.MentionedPayload],
+) (*PullReqMentionedPayload, []*types.PrincipalInfo, error) {
+	base, err := s.getBasePayload(ctx, event.Payload.Base)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get base payload: %w", err)
+	}
+
+	mentioner, err := s.principalInfoCache.Get(ctx, event.Payload.PrincipalID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get mentioner from principalInfoCache: %w", err)
+	}
+
+	// principals don't get notified about mentioning themselves
+	var ids []int64
+	for _, id := range event.Payload.PrincipalIDs {
+		if id != mentioner.ID {
+			ids = append(ids, id)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil, nil, nil
+	}
+
+	recipients, err := s.principalInfoView.FindMany(ctx, ids)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch mentions from principalInfoView: %w", err)
+	}
+
+	return &PullReqMentionedPayload{
+		Base:      base,
+		Mentioner: mentioner,
+	}, recipients, nil
+}
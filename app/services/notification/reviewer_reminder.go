@@ -0,0 +1,231 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/app/url"
+	"github.com/harness/gitness/job"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+
+	"github.com/rs/zerolog/log"
+)
+
+const jobTypeReviewerReminder = "gitness:notification:reviewer-reminder"
+
+type ReviewerReminderPayload struct {
+	Base         *BasePullReqPayload
+	Reviewer     *types.PrincipalInfo
+	PendingSince time.Time
+}
+
+type ReviewerReminderConfig struct {
+	Enabled     bool
+	CRON        string
+	MaxDuration time.Duration
+	SLA         time.Duration
+	Interval    time.Duration
+	BatchSize   int
+}
+
+// ReviewerReminder is a recurring job that reminds reviewers about review requests
+// that have been pending for longer than the configured SLA.
+type ReviewerReminder struct {
+	config                    ReviewerReminderConfig
+	scheduler                 *job.Scheduler
+	notificationClient        Client
+	reminderStore             store.PullReqReviewerReminderStore
+	notificationSettingsStore store.NotificationSettingsStore
+	pullReqStore              store.PullReqStore
+	repoStore                 store.RepoStore
+	principalInfoCache        store.PrincipalInfoCache
+	urlProvider               url.Provider
+}
+
+var _ job.Handler = (*ReviewerReminder)(nil)
+
+// Register schedules the recurring job that sends the reviewer reminders.
+func (r *ReviewerReminder) Register(ctx context.Context) error {
+	if !r.config.Enabled {
+		return nil
+	}
+
+	err := r.scheduler.AddRecurring(ctx, jobTypeReviewerReminder, jobTypeReviewerReminder,
+		r.config.CRON, r.config.MaxDuration)
+	if err != nil {
+		return fmt.Errorf("failed to register recurring job for reviewer reminders: %w", err)
+	}
+
+	return nil
+}
+
+// Handle sends a reminder to every reviewer whose review request is overdue,
+// unless the reviewer opted out of reminders. Reminders of reviewers who are currently
+// in their quiet hours are postponed until the quiet hours end.
+func (r *ReviewerReminder) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	if !r.config.Enabled {
+		return "", nil
+	}
+
+	now := time.Now()
+
+	reviewers, err := r.reminderStore.ListDue(ctx,
+		now.Add(-r.config.SLA).UnixMilli(),
+		now.Add(-r.config.Interval).UnixMilli(),
+		now.UnixMilli(),
+		r.config.BatchSize)
+	if err != nil {
+		return "", fmt.Errorf("failed to list due reviewer reminders: %w", err)
+	}
+
+	var sent int
+	for _, reviewer := range reviewers {
+		ok, err := r.remind(ctx, reviewer, now)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).
+				Int64("pullreq_id", reviewer.PullReqID).
+				Int64("reviewer_id", reviewer.PrincipalID).
+				Msg("failed to send reviewer reminder")
+
+			// postpone the failed reminder by one interval, otherwise it stays at the head of the due list
+			// (e.g. when the pull request or the reviewer got deleted) and starves all other reminders.
+			next := now.Add(r.config.Interval).UnixMilli()
+			err = r.reminderStore.Postpone(ctx, reviewer.PullReqID, reviewer.PrincipalID, next)
+			if err != nil {
+				log.Ctx(ctx).Warn().Err(err).
+					Int64("pullreq_id", reviewer.PullReqID).
+					Int64("reviewer_id", reviewer.PrincipalID).
+					Msg("failed to postpone failed reviewer reminder")
+			}
+
+			continue
+		}
+		if ok {
+			sent++
+		}
+	}
+
+	return fmt.Sprintf("sent %d reviewer reminders", sent), nil
+}
+
+func (r *ReviewerReminder) remind(ctx context.Context, reviewer *types.PullReqReviewer, now time.Time) (bool, error) {
+	settings, err := r.notificationSettingsStore.Find(ctx, reviewer.PrincipalID)
+	if err != nil && !errors.Is(err, gitness_store.ErrResourceNotFound) {
+		return false, fmt.Errorf("failed to find notification settings: %w", err)
+	}
+
+	// reviewers who opted out of reminders are already excluded by the store
+	if settings != nil && inQuietHours(settings, now) {
+		// postpone the reminder to the end of the quiet hours, so that the reviewer leaves the due list
+		next := quietHoursEnd(settings, now)
+		err = r.reminderStore.Postpone(ctx, reviewer.PullReqID, reviewer.PrincipalID, next.UnixMilli())
+		if err != nil {
+			return false, fmt.Errorf("failed to postpone reviewer reminder: %w", err)
+		}
+
+		return false, nil
+	}
+
+	pullReq, err := r.pullReqStore.Find(ctx, reviewer.PullReqID)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch pullreq from pullReqStore: %w", err)
+	}
+
+	repo, err := r.repoStore.Find(ctx, pullReq.TargetRepoID)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch repo from repoStore: %w", err)
+	}
+
+	author, err := r.principalInfoCache.Get(ctx, pullReq.CreatedBy)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch author from principalInfoCache: %w", err)
+	}
+
+	reviewerPrincipal, err := r.principalInfoCache.Get(ctx, reviewer.PrincipalID)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch reviewer from principalInfoCache: %w", err)
+	}
+
+	payload := &ReviewerReminderPayload{
+		Base: &BasePullReqPayload{
+			Repo:       repo,
+			PullReq:    pullReq,
+			Author:     author,
+			PullReqURL: r.urlProvider.GenerateUIPRURL(ctx, repo.Path, pullReq.Number),
+		},
+		Reviewer:     reviewerPrincipal,
+		PendingSince: time.UnixMilli(reviewer.Created),
+	}
+
+	err = r.notificationClient.SendReviewerReminder(ctx, []*types.PrincipalInfo{reviewerPrincipal}, payload)
+	if err != nil {
+		return false, fmt.Errorf("failed to send email for reviewer reminder: %w", err)
+	}
+
+	err = r.reminderStore.Upsert(ctx, reviewer.PullReqID, reviewer.PrincipalID, now.UnixMilli())
+	if err != nil {
+		return false, fmt.Errorf("failed to store reviewer reminder: %w", err)
+	}
+
+	return true, nil
+}
+
+// inQuietHours returns true if the provided time falls into the quiet hours of the notification settings.
+// The quiet hours are evaluated in the time zone of the settings and can wrap around midnight (e.g. 22-7).
+func inQuietHours(settings *types.NotificationSettings, now time.Time) bool {
+	if settings.QuietHoursStart == nil || settings.QuietHoursEnd == nil {
+		return false
+	}
+
+	start, end := *settings.QuietHoursStart, *settings.QuietHoursEnd
+	if start == end {
+		return false
+	}
+
+	loc, err := time.LoadLocation(settings.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	hour := now.In(loc).Hour()
+
+	if start < end {
+		return hour >= start && hour < end
+	}
+
+	return hour >= start || hour < end
+}
+
+// quietHoursEnd returns the time the current quiet hours of the notification settings end.
+func quietHoursEnd(settings *types.NotificationSettings, now time.Time) time.Time {
+	loc, err := time.LoadLocation(settings.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	local := now.In(loc)
+	end := time.Date(local.Year(), local.Month(), local.Day(), *settings.QuietHoursEnd, 0, 0, 0, loc)
+	if !end.After(local) {
+		end = end.AddDate(0, 0, 1)
+	}
+
+	return end
+}
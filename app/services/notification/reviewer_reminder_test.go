@@ -0,0 +1,91 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notification
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/harness/gitness/app/store"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+)
+
+// memReminderStore returns the configured due reviewers and records the postponed reminders.
+type memReminderStore struct {
+	store.PullReqReviewerReminderStore
+	due       []*types.PullReqReviewer
+	postponed map[int64]int64
+}
+
+func (s *memReminderStore) ListDue(context.Context, int64, int64, int64, int) ([]*types.PullReqReviewer, error) {
+	return s.due, nil
+}
+
+func (s *memReminderStore) Postpone(_ context.Context, pullReqID, _, next int64) error {
+	s.postponed[pullReqID] = next
+	return nil
+}
+
+// noSettingsStore has no notification settings stored.
+type noSettingsStore struct {
+	store.NotificationSettingsStore
+}
+
+func (noSettingsStore) Find(context.Context, int64) (*types.NotificationSettings, error) {
+	return nil, gitness_store.ErrResourceNotFound
+}
+
+// noPullReqStore has no pull requests stored.
+type noPullReqStore struct {
+	store.PullReqStore
+}
+
+func (noPullReqStore) Find(context.Context, int64) (*types.PullReq, error) {
+	return nil, gitness_store.ErrResourceNotFound
+}
+
+func TestReviewerReminder_Handle_PostponesFailedReminders(t *testing.T) {
+	reminderStore := &memReminderStore{
+		due:       []*types.PullReqReviewer{{PullReqID: 7, PrincipalID: 3}},
+		postponed: map[int64]int64{},
+	}
+
+	r := &ReviewerReminder{
+		config: ReviewerReminderConfig{
+			Enabled:   true,
+			Interval:  time.Hour,
+			BatchSize: 10,
+		},
+		reminderStore:             reminderStore,
+		notificationSettingsStore: noSettingsStore{},
+		pullReqStore:              noPullReqStore{},
+	}
+
+	before := time.Now()
+
+	if _, err := r.Handle(context.Background(), "", nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	next, ok := reminderStore.postponed[7]
+	if !ok {
+		t.Fatalf("expected the failed reminder to be postponed")
+	}
+	if next < before.Add(time.Hour).UnixMilli() {
+		t.Errorf("expected the reminder to be postponed by an interval, got %s", time.UnixMilli(next))
+	}
+}
@@ -21,6 +21,8 @@ import (
 	"html/template"
 	"io/fs"
 	"path"
+	"strings"
+	texttemplate "text/template"
 
 	pullreqevents "github.com/harness/gitness/app/events/pullreq"
 	"github.com/harness/gitness/app/store"
@@ -40,6 +42,7 @@ var (
 	//go:embed  templates/*
 	files         embed.FS
 	htmlTemplates map[string]*template.Template
+	textTemplates map[string]*texttemplate.Template
 )
 
 func init() {
@@ -51,6 +54,7 @@ func init() {
 
 func LoadTemplates() error {
 	htmlTemplates = make(map[string]*template.Template)
+	textTemplates = make(map[string]*texttemplate.Template)
 	tmplFiles, err := fs.ReadDir(files, templatesDir)
 	if err != nil {
 		return err
@@ -61,6 +65,17 @@ func LoadTemplates() error {
 			continue
 		}
 
+		// plaintext templates mustn't be html escaped.
+		if strings.HasSuffix(tmpl.Name(), ".txt") {
+			pt, err := texttemplate.ParseFS(files, path.Join(templatesDir, tmpl.Name()))
+			if err != nil {
+				return err
+			}
+
+			textTemplates[tmpl.Name()] = pt
+			continue
+		}
+
 		pt, err := template.ParseFS(files, path.Join(templatesDir, tmpl.Name()))
 		if err != nil {
 			return err
@@ -142,6 +157,7 @@ func NewService(
 			_ = r.RegisterCommentCreated(service.notifyCommentCreated)
 			_ = r.RegisterBranchUpdated(service.notifyPullReqBranchUpdated)
 			_ = r.RegisterReviewSubmitted(service.notifyReviewSubmitted)
+			_ = r.RegisterMentioned(service.notifyPullReqMentioned)
 
 			// state changes
 			_ = r.RegisterMerged(service.notifyPullReqStateMerged)
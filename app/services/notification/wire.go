@@ -17,11 +17,15 @@ package notification
 import (
 	"context"
 
+	"github.com/harness/gitness/app/auth/authz"
+	pipelineevents "github.com/harness/gitness/app/events/pipeline"
 	pullreqevents "github.com/harness/gitness/app/events/pullreq"
 	"github.com/harness/gitness/app/services/notification/mailer"
+	"github.com/harness/gitness/app/services/protection"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/app/url"
 	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/job"
 
 	"github.com/google/wire"
 )
@@ -29,6 +33,8 @@ import (
 var WireSet = wire.NewSet(
 	ProvideMailClient,
 	ProvideNotificationService,
+	ProvideReviewerReminder,
+	ProvidePipelineNotifier,
 )
 
 func ProvideNotificationService(
@@ -61,6 +67,69 @@ func ProvideNotificationService(
 	)
 }
 
+func ProvideReviewerReminder(
+	config ReviewerReminderConfig,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	notificationClient Client,
+	reminderStore store.PullReqReviewerReminderStore,
+	notificationSettingsStore store.NotificationSettingsStore,
+	pullReqStore store.PullReqStore,
+	repoStore store.RepoStore,
+	principalInfoCache store.PrincipalInfoCache,
+	urlProvider url.Provider,
+) (*ReviewerReminder, error) {
+	reminder := &ReviewerReminder{
+		config:                    config,
+		scheduler:                 scheduler,
+		notificationClient:        notificationClient,
+		reminderStore:             reminderStore,
+		notificationSettingsStore: notificationSettingsStore,
+		pullReqStore:              pullReqStore,
+		repoStore:                 repoStore,
+		principalInfoCache:        principalInfoCache,
+		urlProvider:               urlProvider,
+	}
+
+	if err := executor.Register(jobTypeReviewerReminder, reminder); err != nil {
+		return nil, err
+	}
+
+	return reminder, nil
+}
+
+func ProvidePipelineNotifier(
+	ctx context.Context,
+	config PipelineConfig,
+	notificationClient Client,
+	pipelineReaderFactory *events.ReaderFactory[*pipelineevents.Reader],
+	principalStore store.PrincipalStore,
+	authorizer authz.Authorizer,
+	protectionManager *protection.Manager,
+	repoStore store.RepoStore,
+	pipelineStore store.PipelineStore,
+	executionStore store.ExecutionStore,
+	stageStore store.StageStore,
+	slackMessageStore store.SlackMessageStore,
+	urlProvider url.Provider,
+) (*PipelineNotifier, error) {
+	return NewPipelineNotifier(
+		ctx,
+		config,
+		notificationClient,
+		pipelineReaderFactory,
+		principalStore,
+		authorizer,
+		protectionManager,
+		repoStore,
+		pipelineStore,
+		executionStore,
+		stageStore,
+		slackMessageStore,
+		urlProvider,
+	)
+}
+
 func ProvideMailClient(mailer mailer.Mailer) Client {
 	return NewMailClient(mailer)
 }
@@ -85,6 +85,13 @@ func (v *Branch) RequiredChecks(
 	}, nil
 }
 
+func (v *Branch) StaleApprovals(
+	ctx context.Context,
+	in StaleApprovalsInput,
+) (StaleApprovalsOutput, error) {
+	return v.PullReq.StaleApprovals(ctx, in)
+}
+
 func (v *Branch) RefChangeVerify(
 	ctx context.Context,
 	in RefChangeVerifyInput,
@@ -145,6 +145,35 @@ func (m *Manager) ForRepository(ctx context.Context, repoID int64) (Protection,
 	}, nil
 }
 
+// IsBranchProtected returns true if any active or monitored branch rule of the repository applies to the branch.
+func (m *Manager) IsBranchProtected(
+	ctx context.Context,
+	repoID int64,
+	defaultBranch string,
+	branchName string,
+) (bool, error) {
+	ruleInfos, err := m.ruleStore.ListAllRepoRules(ctx, repoID)
+	if err != nil {
+		return false, fmt.Errorf("failed to list rules for repository: %w", err)
+	}
+
+	for _, r := range ruleInfos {
+		if r.Type != TypeBranch {
+			continue
+		}
+
+		matches, err := matchesName(r.Pattern, defaultBranch, branchName)
+		if err != nil {
+			return false, fmt.Errorf("failed to match branch name with rule ID=%d: %w", r.ID, err)
+		}
+		if matches {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 // GenerateErrorMessageForBlockingViolations generates an error message for a given slice of rule violations.
 // It simply takes the first blocking rule that has a violation and prints that, with indication if further
 // rules were violated.
@@ -15,9 +15,11 @@
 package protection
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
+	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/types"
 	"github.com/harness/gitness/types/enum"
 )
@@ -277,3 +279,85 @@ func TestGenerateErrorMessageForBlockingViolations(t *testing.T) {
 		})
 	}
 }
+
+type ruleStoreFake struct {
+	store.RuleStore
+	rules []types.RuleInfoInternal
+}
+
+func (s ruleStoreFake) ListAllRepoRules(context.Context, int64) ([]types.RuleInfoInternal, error) {
+	return s.rules, nil
+}
+
+func TestManager_IsBranchProtected(t *testing.T) {
+	const typeTag types.RuleType = "tag"
+
+	rule := func(ruleType types.RuleType, pattern Pattern) types.RuleInfoInternal {
+		return types.RuleInfoInternal{
+			RuleInfo: types.RuleInfo{Type: ruleType, State: enum.RuleStateActive},
+			Pattern:  pattern.JSON(),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		rules  []types.RuleInfoInternal
+		branch string
+		exp    bool
+	}{
+		{
+			name:   "no-rules",
+			rules:  nil,
+			branch: "main",
+			exp:    false,
+		},
+		{
+			name:   "branch-rule-matches",
+			rules:  []types.RuleInfoInternal{rule(TypeBranch, Pattern{Include: []string{"release/*"}})},
+			branch: "release/1.0",
+			exp:    true,
+		},
+		{
+			name:   "branch-rule-matches-default",
+			rules:  []types.RuleInfoInternal{rule(TypeBranch, Pattern{Default: true})},
+			branch: "main",
+			exp:    true,
+		},
+		{
+			name:   "branch-rule-no-match",
+			rules:  []types.RuleInfoInternal{rule(TypeBranch, Pattern{Include: []string{"release/*"}})},
+			branch: "feature/x",
+			exp:    false,
+		},
+		{
+			name:   "tag-rule-ignored",
+			rules:  []types.RuleInfoInternal{rule(typeTag, Pattern{Include: []string{"*"}})},
+			branch: "main",
+			exp:    false,
+		},
+		{
+			name: "tag-rule-ignored-branch-rule-matches",
+			rules: []types.RuleInfoInternal{
+				rule(typeTag, Pattern{Include: []string{"*"}}),
+				rule(TypeBranch, Pattern{Include: []string{"main"}}),
+			},
+			branch: "main",
+			exp:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := NewManager(ruleStoreFake{rules: test.rules})
+
+			got, err := m.IsBranchProtected(context.Background(), 1, "main", test.branch)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != test.exp {
+				t.Errorf("want=%t got=%t", test.exp, got)
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ package protection
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -462,6 +463,79 @@ func TestRuleSet_RequiredChecks(t *testing.T) {
 	}
 }
 
+func TestRuleSet_StaleApprovals(t *testing.T) {
+	input := StaleApprovalsInput{
+		Repo:    &types.Repository{ID: 1, DefaultBranch: "main"},
+		PullReq: &types.PullReq{ID: 1, SourceBranch: "pr", TargetBranch: "main"},
+	}
+
+	ruleWithDefinition := func(id int64, definition string) types.RuleInfoInternal {
+		return types.RuleInfoInternal{
+			RuleInfo: types.RuleInfo{
+				RepoPath:   "space/repo",
+				ID:         id,
+				Identifier: fmt.Sprintf("rule%d", id),
+				Type:       TypeBranch,
+				State:      enum.RuleStateActive,
+			},
+			Pattern:    []byte(`{"default":true}`),
+			Definition: []byte(definition),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		rules  []types.RuleInfoInternal
+		expOut StaleApprovalsOutput
+	}{
+		{
+			name:   "empty",
+			rules:  []types.RuleInfoInternal{},
+			expOut: StaleApprovalsOutput{Dismiss: false},
+		},
+		{
+			name: "not-enabled",
+			rules: []types.RuleInfoInternal{
+				ruleWithDefinition(1, `{"pullreq":{"approvals":{"require_minimum_count":1}}}`),
+			},
+			expOut: StaleApprovalsOutput{Dismiss: false},
+		},
+		{
+			name: "enabled-in-one-rule",
+			rules: []types.RuleInfoInternal{
+				ruleWithDefinition(1, `{"pullreq":{"approvals":{"require_minimum_count":1}}}`),
+				ruleWithDefinition(2, `{"pullreq":{"approvals":{"dismiss_stale_approvals":true}}}`),
+			},
+			expOut: StaleApprovalsOutput{Dismiss: true},
+		},
+	}
+
+	ctx := context.Background()
+
+	m := NewManager(nil)
+	_ = m.Register(TypeBranch, func() Definition {
+		return &Branch{}
+	})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			set := ruleSet{
+				rules:   test.rules,
+				manager: m,
+			}
+
+			out, err := set.StaleApprovals(ctx, input)
+			if err != nil {
+				t.Errorf("got error: %s", err.Error())
+			}
+
+			if want, got := test.expOut, out; want != got {
+				t.Errorf("output: want=%+v got=%+v", want, got)
+			}
+		})
+	}
+}
+
 func TestIntersectSorted(t *testing.T) {
 	tests := []struct {
 		name string
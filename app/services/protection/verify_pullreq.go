@@ -32,6 +32,7 @@ type (
 	MergeVerifier interface {
 		MergeVerify(ctx context.Context, in MergeVerifyInput) (MergeVerifyOutput, []types.RuleViolations, error)
 		RequiredChecks(ctx context.Context, in RequiredChecksInput) (RequiredChecksOutput, error)
+		StaleApprovals(ctx context.Context, in StaleApprovalsInput) (StaleApprovalsOutput, error)
 	}
 
 	MergeVerifyInput struct {
@@ -43,6 +44,7 @@ type (
 		SourceRepo         *types.Repository
 		PullReq            *types.PullReq
 		Reviewers          []*types.PullReqReviewer
+		UserGroupReviewers []*types.UserGroupReviewer
 		Method             enum.MergeMethod
 		CheckResults       []types.CheckResult
 		CodeOwners         *codeowners.Evaluation
@@ -71,6 +73,16 @@ type (
 		RequiredIdentifiers   map[string]struct{}
 		BypassableIdentifiers map[string]struct{}
 	}
+
+	StaleApprovalsInput struct {
+		Repo    *types.Repository
+		PullReq *types.PullReq
+	}
+
+	StaleApprovalsOutput struct {
+		// Dismiss is true if the existing approvals should be dismissed after new commits.
+		Dismiss bool
+	}
 )
 
 // ensures that the DefPullReq type implements Sanitizer and MergeVerifier interface.
@@ -85,6 +97,8 @@ const (
 	codePullReqApprovalReqLatestCommit          = "pullreq.approvals.require_latest_commit"
 	codePullReqApprovalReqChangeRequested       = "pullreq.approvals.require_change_requested"
 	codePullReqApprovalReqChangeRequestedOldSHA = "pullreq.approvals.require_change_requested_old_SHA"
+	codePullReqApprovalReqUserGroups            = "pullreq.approvals.require_user_groups"
+	codePullReqApprovalReqUserGroupsLatest      = "pullreq.approvals.require_user_groups:latest_commit"
 
 	codePullReqApprovalReqCodeOwnersNoApproval       = "pullreq.approvals.require_code_owners:no_approval"
 	codePullReqApprovalReqCodeOwnersChangeRequested  = "pullreq.approvals.require_code_owners:change_requested"
@@ -100,7 +114,7 @@ const (
 
 //nolint:gocognit,gocyclo,cyclop // well aware of this
 func (v *DefPullReq) MergeVerify(
-	_ context.Context,
+	ctx context.Context,
 	in MergeVerifyInput,
 ) (MergeVerifyOutput, []types.RuleViolations, error) {
 	var out MergeVerifyOutput
@@ -163,6 +177,13 @@ func (v *DefPullReq) MergeVerify(
 		}
 	}
 
+	if v.Approvals.RequireUserGroups && len(in.UserGroupReviewers) > 0 {
+		err := v.verifyUserGroupApprovals(ctx, in, &violations)
+		if err != nil {
+			return out, nil, err
+		}
+	}
+
 	if v.Approvals.RequireCodeOwners {
 		for _, entry := range in.CodeOwners.EvaluationEntries {
 			reviewDecision, approvers := getCodeOwnerApprovalStatus(entry)
@@ -258,6 +279,52 @@ func (v *DefPullReq) MergeVerify(
 	return out, nil, nil
 }
 
+// verifyUserGroupApprovals reports a violation for every user group reviewer that isn't approved yet.
+// A user group is approved by an approval of any of its members. The members are resolved
+// at the evaluation time, so the approvals of users who left the group don't count.
+func (v *DefPullReq) verifyUserGroupApprovals(
+	ctx context.Context,
+	in MergeVerifyInput,
+	violations *types.RuleViolations,
+) error {
+	approvers := make(map[int64]struct{}, len(in.Reviewers))
+	for _, reviewer := range in.Reviewers {
+		if reviewer.ReviewDecision != enum.PullReqReviewDecisionApproved {
+			continue
+		}
+		if v.Approvals.RequireLatestCommit && reviewer.SHA != in.PullReq.SourceSHA {
+			continue
+		}
+		approvers[reviewer.PrincipalID] = struct{}{}
+	}
+
+	for _, userGroupReviewer := range in.UserGroupReviewers {
+		memberIDs, err := in.ResolveUserGroupID(ctx, []int64{userGroupReviewer.UserGroupID})
+		if err != nil {
+			return fmt.Errorf("failed to resolve members of user group %d: %w", userGroupReviewer.UserGroupID, err)
+		}
+
+		approved := slices.ContainsFunc(memberIDs, func(id int64) bool {
+			_, ok := approvers[id]
+			return ok
+		})
+		if approved {
+			continue
+		}
+
+		name := userGroupReviewer.UserGroup.Name
+		if v.Approvals.RequireLatestCommit {
+			violations.Addf(codePullReqApprovalReqUserGroupsLatest,
+				"Approval of the latest commit by a member of user group %s is pending", name)
+		} else {
+			violations.Addf(codePullReqApprovalReqUserGroups,
+				"Approval by a member of user group %s is pending", name)
+		}
+	}
+
+	return nil
+}
+
 func (v *DefPullReq) RequiredChecks(
 	_ context.Context,
 	_ RequiredChecksInput,
@@ -271,11 +338,22 @@ func (v *DefPullReq) RequiredChecks(
 	}, nil
 }
 
+func (v *DefPullReq) StaleApprovals(
+	_ context.Context,
+	_ StaleApprovalsInput,
+) (StaleApprovalsOutput, error) {
+	return StaleApprovalsOutput{
+		Dismiss: v.Approvals.DismissStaleApprovals,
+	}, nil
+}
+
 type DefApprovals struct {
 	RequireCodeOwners      bool `json:"require_code_owners,omitempty"`
 	RequireMinimumCount    int  `json:"require_minimum_count,omitempty"`
 	RequireLatestCommit    bool `json:"require_latest_commit,omitempty"`
 	RequireNoChangeRequest bool `json:"require_no_change_request,omitempty"`
+	RequireUserGroups      bool `json:"require_user_groups,omitempty"`
+	DismissStaleApprovals  bool `json:"dismiss_stale_approvals,omitempty"`
 }
 
 func (v *DefApprovals) Sanitize() error {
@@ -283,8 +361,9 @@ func (v *DefApprovals) Sanitize() error {
 		return errors.New("minimum count must be zero or a positive integer")
 	}
 
-	if v.RequireLatestCommit && v.RequireMinimumCount == 0 && !v.RequireCodeOwners {
-		return errors.New("require latest commit can only be used with require code owners or require minimum count")
+	if v.RequireLatestCommit && v.RequireMinimumCount == 0 && !v.RequireCodeOwners && !v.RequireUserGroups {
+		return errors.New("require latest commit can only be used with require code owners, " +
+			"require user groups or require minimum count")
 	}
 
 	return nil
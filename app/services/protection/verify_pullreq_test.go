@@ -120,6 +120,89 @@ func TestDefPullReq_MergeVerify(t *testing.T) {
 				MinimumRequiredApprovalsCountLatest: 2,
 			},
 		},
+		{
+			name: codePullReqApprovalReqUserGroups + "-fail",
+			def:  DefPullReq{Approvals: DefApprovals{RequireUserGroups: true}},
+			in: MergeVerifyInput{
+				ResolveUserGroupID: resolveUserGroupMembers(map[int64][]int64{1: {10, 11}, 2: {20}}),
+				PullReq:            &types.PullReq{UnresolvedCount: 0, SourceSHA: "abc"},
+				Reviewers: []*types.PullReqReviewer{
+					{PrincipalID: 10, ReviewDecision: enum.PullReqReviewDecisionApproved, SHA: "abc"},
+					{PrincipalID: 20, ReviewDecision: enum.PullReqReviewDecisionChangeReq, SHA: "abc"},
+					{PrincipalID: 30, ReviewDecision: enum.PullReqReviewDecisionApproved, SHA: "abc"},
+				},
+				UserGroupReviewers: []*types.UserGroupReviewer{
+					{UserGroupID: 1, UserGroup: types.UserGroupInfo{Name: "oncall"}},
+					{UserGroupID: 2, UserGroup: types.UserGroupInfo{Name: "security"}},
+				},
+				Method: enum.MergeMethodMerge,
+			},
+			expCodes:  []string{codePullReqApprovalReqUserGroups},
+			expParams: [][]any{{"security"}},
+			expOut: MergeVerifyOutput{
+				AllowedMethods: enum.MergeMethods,
+			},
+		},
+		{
+			name: codePullReqApprovalReqUserGroups + "-success",
+			def:  DefPullReq{Approvals: DefApprovals{RequireUserGroups: true}},
+			in: MergeVerifyInput{
+				ResolveUserGroupID: resolveUserGroupMembers(map[int64][]int64{1: {10, 11}, 2: {20}}),
+				PullReq:            &types.PullReq{UnresolvedCount: 0, SourceSHA: "abc"},
+				Reviewers: []*types.PullReqReviewer{
+					{PrincipalID: 11, ReviewDecision: enum.PullReqReviewDecisionApproved, SHA: "abc"},
+					{PrincipalID: 20, ReviewDecision: enum.PullReqReviewDecisionApproved, SHA: "abd"},
+				},
+				UserGroupReviewers: []*types.UserGroupReviewer{
+					{UserGroupID: 1, UserGroup: types.UserGroupInfo{Name: "oncall"}},
+					{UserGroupID: 2, UserGroup: types.UserGroupInfo{Name: "security"}},
+				},
+				Method: enum.MergeMethodMerge,
+			},
+			expOut: MergeVerifyOutput{
+				AllowedMethods: enum.MergeMethods,
+			},
+		},
+		{
+			name: codePullReqApprovalReqUserGroupsLatest + "-fail",
+			def:  DefPullReq{Approvals: DefApprovals{RequireUserGroups: true, RequireLatestCommit: true}},
+			in: MergeVerifyInput{
+				ResolveUserGroupID: resolveUserGroupMembers(map[int64][]int64{1: {10, 11}}),
+				PullReq:            &types.PullReq{UnresolvedCount: 0, SourceSHA: "abc"},
+				Reviewers: []*types.PullReqReviewer{
+					{PrincipalID: 10, ReviewDecision: enum.PullReqReviewDecisionApproved, SHA: "abd"},
+				},
+				UserGroupReviewers: []*types.UserGroupReviewer{
+					{UserGroupID: 1, UserGroup: types.UserGroupInfo{Name: "oncall"}},
+				},
+				Method: enum.MergeMethodMerge,
+			},
+			expCodes:  []string{codePullReqApprovalReqUserGroupsLatest},
+			expParams: [][]any{{"oncall"}},
+			expOut: MergeVerifyOutput{
+				AllowedMethods: enum.MergeMethods,
+			},
+		},
+		{
+			name: codePullReqApprovalReqUserGroups + "-former-member",
+			def:  DefPullReq{Approvals: DefApprovals{RequireUserGroups: true}},
+			in: MergeVerifyInput{
+				ResolveUserGroupID: resolveUserGroupMembers(map[int64][]int64{1: {11}}),
+				PullReq:            &types.PullReq{UnresolvedCount: 0, SourceSHA: "abc"},
+				Reviewers: []*types.PullReqReviewer{
+					{PrincipalID: 10, ReviewDecision: enum.PullReqReviewDecisionApproved, SHA: "abc"},
+				},
+				UserGroupReviewers: []*types.UserGroupReviewer{
+					{UserGroupID: 1, UserGroup: types.UserGroupInfo{Name: "oncall"}},
+				},
+				Method: enum.MergeMethodMerge,
+			},
+			expCodes:  []string{codePullReqApprovalReqUserGroups},
+			expParams: [][]any{{"oncall"}},
+			expOut: MergeVerifyOutput{
+				AllowedMethods: enum.MergeMethods,
+			},
+		},
 		{
 			name: codePullReqApprovalReqCodeOwnersNoApproval + "-fail",
 			def:  DefPullReq{Approvals: DefApprovals{RequireCodeOwners: true}},
@@ -486,3 +569,15 @@ func TestDefPullReq_MergeVerify(t *testing.T) {
 		})
 	}
 }
+
+func resolveUserGroupMembers(
+	members map[int64][]int64,
+) func(ctx context.Context, userGroupIDs []int64) ([]int64, error) {
+	return func(_ context.Context, userGroupIDs []int64) ([]int64, error) {
+		var ids []int64
+		for _, userGroupID := range userGroupIDs {
+			ids = append(ids, members[userGroupID]...)
+		}
+		return ids, nil
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/auth"
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
+	"github.com/harness/gitness/app/services/codeowners"
+	"github.com/harness/gitness/events"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// addCodeOwnerReviewersOnBranchUpdate handles pull request Branch Updated events.
+// Code owners of the files changed by the new commits are requested as reviewers.
+func (s *Service) addCodeOwnerReviewersOnBranchUpdate(ctx context.Context,
+	event *events.Event[*pullreqevents.BranchUpdatedPayload],
+) error {
+	pr, err := s.pullreqStore.Find(ctx, event.Payload.PullReqID)
+	if err != nil {
+		return fmt.Errorf("failed to get pull request: %w", err)
+	}
+
+	if pr.State != enum.PullReqStateOpen {
+		return nil
+	}
+
+	targetRepo, err := s.repoStore.Find(ctx, pr.TargetRepoID)
+	if err != nil {
+		return fmt.Errorf("failed to get target repository: %w", err)
+	}
+
+	principal, err := s.principalStore.Find(ctx, event.Payload.PrincipalID)
+	if err != nil {
+		return fmt.Errorf("failed to get principal who updated the branch: %w", err)
+	}
+
+	pr, err = s.AddCodeOwnerReviewers(ctx, principal, targetRepo, pr)
+	if err != nil {
+		return err
+	}
+
+	if err = s.sseStreamer.Publish(ctx, targetRepo.ParentID, enum.SSETypePullRequestUpdated, pr); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("failed to publish PR changed event")
+	}
+
+	return nil
+}
+
+// AddCodeOwnerReviewers requests a review from all code owners of the files changed in the pull request.
+// Code owners without review access to the repository, code owners that are reviewers already
+// and code owners that were removed as reviewers of the pull request are skipped.
+// It returns the pull request with its activity sequence updated.
+func (s *Service) AddCodeOwnerReviewers(
+	ctx context.Context,
+	addedBy *types.Principal,
+	repo *types.Repository,
+	pr *types.PullReq,
+) (*types.PullReq, error) {
+	ownerEvaluation, err := s.codeOwners.Evaluate(ctx, repo, pr, nil)
+	if errors.Is(err, codeowners.ErrNotFound) {
+		return pr, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate code owners: %w", err)
+	}
+
+	// code owners who were explicitly removed as reviewers aren't requested again.
+	checked, err := s.removedReviewerIDs(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range ownerEvaluation.EvaluationEntries {
+		for _, owner := range entry.OwnerEvaluations {
+			if _, ok := checked[owner.Owner.ID]; ok || owner.Owner.ID == pr.CreatedBy {
+				continue
+			}
+			checked[owner.Owner.ID] = struct{}{}
+
+			pr, err = s.addCodeOwnerReviewer(ctx, addedBy, repo, pr, owner.Owner.ID)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return pr, nil
+}
+
+func (s *Service) addCodeOwnerReviewer(
+	ctx context.Context,
+	addedBy *types.Principal,
+	repo *types.Repository,
+	pr *types.PullReq,
+	ownerID int64,
+) (*types.PullReq, error) {
+	_, err := s.reviewerStore.Find(ctx, pr.ID, ownerID)
+	if err == nil {
+		return pr, nil
+	}
+	if !errors.Is(err, gitness_store.ErrResourceNotFound) {
+		return nil, fmt.Errorf("failed to find pull request reviewer: %w", err)
+	}
+
+	ownerPrincipal, err := s.principalStore.Find(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find code owner principal: %w", err)
+	}
+
+	if err = apiauth.CheckRepo(ctx, s.authorizer, &auth.Session{
+		Principal: *ownerPrincipal,
+		Metadata:  nil,
+	}, repo, enum.PermissionRepoReview); err != nil {
+		log.Ctx(ctx).Info().Msgf("Code owner principal: %s access error: %s", ownerPrincipal.UID, err)
+		return pr, nil
+	}
+
+	_, pr, err = s.AddReviewer(ctx, addedBy, repo, pr,
+		ownerPrincipal.ToPrincipalInfo(), enum.PullReqReviewerTypeRequested)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add code owner as reviewer: %w", err)
+	}
+
+	return pr, nil
+}
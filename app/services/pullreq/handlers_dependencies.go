@@ -0,0 +1,147 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/git"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// retargetDependentsOnMerged handles pull request Merged events.
+// Open pull requests that depend on the merged pull request and target its source branch
+// are retargeted to the target branch of the merged pull request.
+func (s *Service) retargetDependentsOnMerged(ctx context.Context,
+	event *events.Event[*pullreqevents.MergedPayload],
+) error {
+	merged, err := s.pullreqStore.Find(ctx, event.Payload.PullReqID)
+	if err != nil {
+		return fmt.Errorf("failed to find merged pull request: %w", err)
+	}
+
+	dependentIDs, err := s.dependencyStore.ListDependentIDs(ctx, merged.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list pull request dependents: %w", err)
+	}
+
+	for _, id := range dependentIDs {
+		if err = s.retargetDependent(ctx, merged, id, event.Payload.PrincipalID); err != nil {
+			// continue with other dependents
+			log.Ctx(ctx).Warn().Err(err).Int64("pullreq_id", id).
+				Msgf("failed to retarget pull request after pull request %d got merged", merged.Number)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) retargetDependent(
+	ctx context.Context,
+	merged *types.PullReq,
+	dependentID int64,
+	principalID int64,
+) error {
+	pr, err := s.pullreqStore.Find(ctx, dependentID)
+	if err != nil {
+		return fmt.Errorf("failed to find dependent pull request: %w", err)
+	}
+
+	if pr.State != enum.PullReqStateOpen ||
+		pr.TargetRepoID != merged.SourceRepoID ||
+		pr.TargetBranch != merged.SourceBranch {
+		return nil
+	}
+
+	targetRepo, err := s.repoGitInfoCache.Get(ctx, pr.TargetRepoID)
+	if err != nil {
+		return fmt.Errorf("failed to get target repo git info: %w", err)
+	}
+
+	mergeBaseInfo, err := s.git.MergeBase(ctx, git.MergeBaseParams{
+		ReadParams: git.ReadParams{RepoUID: targetRepo.GitUID},
+		Ref1:       pr.SourceSHA,
+		Ref2:       merged.TargetBranch,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get merge base with the new target branch: %w", err)
+	}
+
+	oldTargetBranch := pr.TargetBranch
+	oldMergeBase := pr.MergeBaseSHA
+
+	pr, err = s.pullreqStore.UpdateOptLock(ctx, pr, func(pr *types.PullReq) error {
+		if pr.State != enum.PullReqStateOpen {
+			return errPRNotOpen
+		}
+
+		pr.ActivitySeq++
+		pr.TargetBranch = merged.TargetBranch
+		pr.MergeBaseSHA = mergeBaseInfo.MergeBaseSHA.String()
+
+		// reset merge-check fields for new run
+
+		pr.MergeSHA = nil
+		pr.Stats.DiffStats.Commits = nil
+		pr.Stats.DiffStats.FilesChanged = nil
+		pr.MarkAsMergeUnchecked()
+
+		return nil
+	})
+	if errors.Is(err, errPRNotOpen) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update target branch: %w", err)
+	}
+
+	payload := &types.PullRequestActivityPayloadTargetBranchChange{
+		Old:              oldTargetBranch,
+		New:              pr.TargetBranch,
+		DependencyNumber: merged.Number,
+	}
+	if _, err = s.activityStore.CreateWithPayload(ctx, pr, principalID, payload, nil); err != nil {
+		// non-critical error
+		log.Ctx(ctx).Err(err).Msgf("failed to write pull request activity after target branch change")
+	}
+
+	s.pullreqEvReporter.TargetBranchChanged(ctx, &pullreqevents.TargetBranchChangedPayload{
+		Base: pullreqevents.Base{
+			PullReqID:    pr.ID,
+			SourceRepoID: pr.SourceRepoID,
+			TargetRepoID: pr.TargetRepoID,
+			PrincipalID:  principalID,
+			Number:       pr.Number,
+		},
+		SourceSHA:       pr.SourceSHA,
+		OldTargetBranch: oldTargetBranch,
+		NewTargetBranch: pr.TargetBranch,
+		OldMergeBaseSHA: oldMergeBase,
+		NewMergeBaseSHA: pr.MergeBaseSHA,
+	})
+
+	if err = s.sseStreamer.Publish(ctx, targetRepo.ParentID, enum.SSETypePullRequestUpdated, pr); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("failed to publish PR changed event")
+	}
+
+	return nil
+}
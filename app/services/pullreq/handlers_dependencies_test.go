@@ -0,0 +1,271 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
+	"github.com/harness/gitness/app/sse"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/git"
+	"github.com/harness/gitness/git/sha"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+const retargetMergeBaseSHA = "1111111111111111111111111111111111111111"
+
+// memoryPullReqStore finds and updates the pull requests of the map, all other methods aren't implemented.
+type memoryPullReqStore struct {
+	store.PullReqStore
+	prs map[int64]*types.PullReq
+}
+
+func (s *memoryPullReqStore) Find(_ context.Context, id int64) (*types.PullReq, error) {
+	pr, ok := s.prs[id]
+	if !ok {
+		return nil, gitness_store.ErrResourceNotFound
+	}
+	prCopy := *pr
+	return &prCopy, nil
+}
+
+func (s *memoryPullReqStore) UpdateOptLock(
+	_ context.Context,
+	pr *types.PullReq,
+	mutateFn func(pr *types.PullReq) error,
+) (*types.PullReq, error) {
+	prCopy := *s.prs[pr.ID]
+	if err := mutateFn(&prCopy); err != nil {
+		return nil, err
+	}
+	s.prs[pr.ID] = &prCopy
+	return &prCopy, nil
+}
+
+// dependentsStore lists the dependents of the merged pull request, all other methods aren't implemented.
+type dependentsStore struct {
+	store.PullReqDependencyStore
+	dependentIDs []int64
+}
+
+func (s dependentsStore) ListDependentIDs(context.Context, int64) ([]int64, error) {
+	return s.dependentIDs, nil
+}
+
+// targetBranchActivityStore records the payloads of the created activities.
+type targetBranchActivityStore struct {
+	store.PullReqActivityStore
+	payloads map[int64]types.PullReqActivityPayload
+}
+
+func (s *targetBranchActivityStore) CreateWithPayload(
+	_ context.Context,
+	pr *types.PullReq,
+	_ int64,
+	payload types.PullReqActivityPayload,
+	_ *types.PullReqActivityMetadata,
+) (*types.PullReqActivity, error) {
+	s.payloads[pr.ID] = payload
+	return &types.PullReqActivity{}, nil
+}
+
+type repoGitInfoCacheFake struct{}
+
+func (repoGitInfoCacheFake) Stats() (int64, int64) { return 0, 0 }
+
+func (repoGitInfoCacheFake) Get(_ context.Context, id int64) (*types.RepositoryGitInfo, error) {
+	return &types.RepositoryGitInfo{ID: id, ParentID: 1, GitUID: "repo"}, nil
+}
+
+// mergeBaseGit returns the same merge base for all refs, all other methods aren't implemented.
+type mergeBaseGit struct {
+	git.Interface
+}
+
+func (mergeBaseGit) MergeBase(context.Context, git.MergeBaseParams) (git.MergeBaseOutput, error) {
+	return git.MergeBaseOutput{MergeBaseSHA: sha.Must(retargetMergeBaseSHA)}, nil
+}
+
+type noopStreamer struct {
+	sse.Streamer
+}
+
+func (noopStreamer) Publish(context.Context, int64, enum.SSEType, any) error { return nil }
+
+// nolint:gocognit // it's a unit test
+func TestService_retargetDependentsOnMerged(t *testing.T) {
+	merged := &types.PullReq{
+		ID:           1,
+		Number:       1,
+		State:        enum.PullReqStateMerged,
+		SourceRepoID: 1,
+		SourceBranch: "feature-a",
+		TargetRepoID: 1,
+		TargetBranch: "main",
+	}
+
+	dependent := func(id int64, state enum.PullReqState, targetRepoID int64, targetBranch string) *types.PullReq {
+		return &types.PullReq{
+			ID:           id,
+			Number:       id,
+			State:        state,
+			SourceRepoID: targetRepoID,
+			SourceBranch: "feature-b",
+			SourceSHA:    "2222222222222222222222222222222222222222",
+			TargetRepoID: targetRepoID,
+			TargetBranch: targetBranch,
+			MergeBaseSHA: "3333333333333333333333333333333333333333",
+		}
+	}
+
+	tests := []struct {
+		name           string
+		dependents     []*types.PullReq
+		dependentIDs   []int64
+		wantRetargeted []int64
+	}{
+		{
+			name:           "dependent targeting the merged branch",
+			dependents:     []*types.PullReq{dependent(2, enum.PullReqStateOpen, 1, "feature-a")},
+			dependentIDs:   []int64{2},
+			wantRetargeted: []int64{2},
+		},
+		{
+			name:         "dependent targeting another branch",
+			dependents:   []*types.PullReq{dependent(2, enum.PullReqStateOpen, 1, "develop")},
+			dependentIDs: []int64{2},
+		},
+		{
+			name:         "closed dependent",
+			dependents:   []*types.PullReq{dependent(2, enum.PullReqStateClosed, 1, "feature-a")},
+			dependentIDs: []int64{2},
+		},
+		{
+			name:         "dependent targeting a branch of another repository",
+			dependents:   []*types.PullReq{dependent(2, enum.PullReqStateOpen, 2, "feature-a")},
+			dependentIDs: []int64{2},
+		},
+		{
+			name: "failure doesn't stop other dependents",
+			dependents: []*types.PullReq{
+				dependent(3, enum.PullReqStateOpen, 1, "feature-a"),
+				dependent(4, enum.PullReqStateOpen, 1, "feature-a"),
+			},
+			// pull request 2 doesn't exist.
+			dependentIDs:   []int64{2, 3, 4},
+			wantRetargeted: []int64{3, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			prs := map[int64]*types.PullReq{merged.ID: merged}
+			for _, pr := range tt.dependents {
+				prs[pr.ID] = pr
+			}
+			originals := make(map[int64]types.PullReq, len(prs))
+			for id, pr := range prs {
+				originals[id] = *pr
+			}
+
+			eventsSystem, err := events.ProvideSystem(events.Config{
+				Mode:            events.ModeInMemory,
+				Namespace:       "test",
+				MaxStreamLength: 100,
+			}, nil)
+			if err != nil {
+				t.Fatalf("failed to create events system: %v", err)
+			}
+			reporter, err := pullreqevents.NewReporter(eventsSystem)
+			if err != nil {
+				t.Fatalf("failed to create reporter: %v", err)
+			}
+
+			activityStore := &targetBranchActivityStore{payloads: map[int64]types.PullReqActivityPayload{}}
+			pullreqStore := &memoryPullReqStore{prs: prs}
+
+			s := &Service{
+				pullreqEvReporter: reporter,
+				git:               mergeBaseGit{},
+				repoGitInfoCache:  repoGitInfoCacheFake{},
+				pullreqStore:      pullreqStore,
+				activityStore:     activityStore,
+				dependencyStore:   dependentsStore{dependentIDs: tt.dependentIDs},
+				sseStreamer:       noopStreamer{},
+			}
+
+			err = s.retargetDependentsOnMerged(ctx, &events.Event[*pullreqevents.MergedPayload]{
+				Payload: &pullreqevents.MergedPayload{
+					Base: pullreqevents.Base{PullReqID: merged.ID, PrincipalID: 7},
+				},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var retargeted []int64
+			for id, pr := range pullreqStore.prs {
+				original := originals[id]
+				if pr.TargetBranch == original.TargetBranch {
+					if !reflect.DeepEqual(*pr, original) {
+						t.Errorf("pull request %d changed without being retargeted", id)
+					}
+					continue
+				}
+
+				retargeted = append(retargeted, id)
+
+				if pr.TargetBranch != "main" {
+					t.Errorf("pull request %d target branch = %q, want %q", id, pr.TargetBranch, "main")
+				}
+				if pr.MergeBaseSHA != retargetMergeBaseSHA {
+					t.Errorf("pull request %d merge base = %q, want %q", id, pr.MergeBaseSHA, retargetMergeBaseSHA)
+				}
+				if pr.MergeCheckStatus != enum.MergeCheckStatusUnchecked {
+					t.Errorf("pull request %d merge check status = %q, want unchecked", id, pr.MergeCheckStatus)
+				}
+				if pr.ActivitySeq != original.ActivitySeq+1 {
+					t.Errorf("pull request %d activity seq = %d, want %d", id, pr.ActivitySeq, original.ActivitySeq+1)
+				}
+
+				wantPayload := &types.PullRequestActivityPayloadTargetBranchChange{
+					Old:              "feature-a",
+					New:              "main",
+					DependencyNumber: merged.Number,
+				}
+				if got := activityStore.payloads[id]; !reflect.DeepEqual(got, wantPayload) {
+					t.Errorf("pull request %d activity = %+v, want %+v", id, got, wantPayload)
+				}
+			}
+
+			sort.Slice(retargeted, func(i, j int) bool { return retargeted[i] < retargeted[j] })
+			if !reflect.DeepEqual(retargeted, tt.wantRetargeted) {
+				t.Errorf("retargeted = %v, want %v", retargeted, tt.wantRetargeted)
+			}
+			if len(activityStore.payloads) != len(tt.wantRetargeted) {
+				t.Errorf("activities = %d, want %d", len(activityStore.payloads), len(tt.wantRetargeted))
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/git"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+)
+
+// CachedFileStats returns the per-file diff statistics of the pull request only if they are already cached
+// for the current source SHA and merge base SHA of the pull request. It never computes the statistics,
+// so it returns nil if they're missing or stale.
+func (s *Service) CachedFileStats(ctx context.Context, pr *types.PullReq) (*types.PullReqFileStats, error) {
+	stats, err := s.fileStatsStore.Find(ctx, pr.ID)
+	if errors.Is(err, gitness_store.ErrResourceNotFound) {
+		return nil, nil //nolint:nilnil // missing stats aren't an error
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find cached pull request file stats: %w", err)
+	}
+
+	if stats.SourceSHA != pr.SourceSHA || stats.MergeBaseSHA != pr.MergeBaseSHA {
+		return nil, nil //nolint:nilnil // stale stats aren't an error
+	}
+
+	return stats, nil
+}
+
+// FileStats returns the per-file diff statistics of the pull request. The statistics are served
+// from the cache if they were computed for the current source SHA and merge base SHA of the pull request,
+// otherwise they are computed and cached.
+func (s *Service) FileStats(ctx context.Context, pr *types.PullReq) (*types.PullReqFileStats, error) {
+	stats, err := s.fileStatsStore.Find(ctx, pr.ID)
+	if err != nil && !errors.Is(err, gitness_store.ErrResourceNotFound) {
+		return nil, fmt.Errorf("failed to find cached pull request file stats: %w", err)
+	}
+
+	if stats != nil && stats.SourceSHA == pr.SourceSHA && stats.MergeBaseSHA == pr.MergeBaseSHA {
+		return stats, nil
+	}
+
+	repoGit, err := s.repoGitInfoCache.Get(ctx, pr.TargetRepoID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repo git info: %w", err)
+	}
+
+	out, err := s.git.DiffFileStats(ctx, &git.DiffParams{
+		ReadParams: git.ReadParams{
+			RepoUID: repoGit.GitUID,
+		},
+		BaseRef:   pr.MergeBaseSHA,
+		HeadRef:   pr.SourceSHA,
+		MergeBase: false, // the merge base is already resolved
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get diff file stats: %w", err)
+	}
+
+	files := make([]types.CommitFileStats, len(out.Files))
+	for i, file := range out.Files {
+		files[i] = types.CommitFileStats{
+			Path:       file.Path,
+			OldPath:    file.OldPath,
+			Status:     file.Status,
+			Similarity: file.Similarity,
+			ChangeStats: types.ChangeStats{
+				Insertions: file.Additions,
+				Deletions:  file.Deletions,
+				Changes:    file.Additions + file.Deletions,
+			},
+		}
+	}
+
+	stats = &types.PullReqFileStats{
+		PullReqID:    pr.ID,
+		SourceSHA:    pr.SourceSHA,
+		MergeBaseSHA: pr.MergeBaseSHA,
+		Files:        files,
+		Updated:      time.Now().UnixMilli(),
+	}
+
+	if err = s.fileStatsStore.Upsert(ctx, stats); err != nil {
+		return nil, fmt.Errorf("failed to cache pull request file stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+// updateFileStatsOnCreated computes the file statistics of a newly created pull request.
+func (s *Service) updateFileStatsOnCreated(ctx context.Context,
+	event *events.Event[*pullreqevents.CreatedPayload],
+) error {
+	return s.updateFileStats(ctx, event.Payload.PullReqID)
+}
+
+// updateFileStatsOnBranchUpdate recomputes the file statistics of a pull request after its source branch moved.
+func (s *Service) updateFileStatsOnBranchUpdate(ctx context.Context,
+	event *events.Event[*pullreqevents.BranchUpdatedPayload],
+) error {
+	return s.updateFileStats(ctx, event.Payload.PullReqID)
+}
+
+func (s *Service) updateFileStats(ctx context.Context, pullReqID int64) error {
+	pr, err := s.pullreqStore.Find(ctx, pullReqID)
+	if err != nil {
+		return fmt.Errorf("failed to find pull request: %w", err)
+	}
+
+	if _, err = s.FileStats(ctx, pr); err != nil {
+		return fmt.Errorf("failed to update pull request file stats: %w", err)
+	}
+
+	return nil
+}
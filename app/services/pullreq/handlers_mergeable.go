@@ -80,6 +80,20 @@ func (s *Service) mergeCheckOnReopen(ctx context.Context,
 	)
 }
 
+// mergeCheckOnTargetBranchChange handles pull request TargetBranchChanged events.
+// It recomputes the mergeability of the pull request against the new target branch.
+func (s *Service) mergeCheckOnTargetBranchChange(ctx context.Context,
+	event *events.Event[*pullreqevents.TargetBranchChangedPayload],
+) error {
+	return s.updateMergeData(
+		ctx,
+		event.Payload.TargetRepoID,
+		event.Payload.Number,
+		sha.None.String(),
+		event.Payload.SourceSHA,
+	)
+}
+
 // mergeCheckOnClosed deletes the merge ref.
 func (s *Service) mergeCheckOnClosed(ctx context.Context,
 	event *events.Event[*pullreqevents.ClosedPayload],
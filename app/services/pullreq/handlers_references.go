@@ -0,0 +1,218 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/git"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// closingKeywordRegex matches a closing keyword followed by a pull request number, e.g. "Fixes #123".
+var closingKeywordRegex = regexp.MustCompile(`(?i)\b(?:close[sd]?|fix(?:e[sd])?|resolve[sd]?):?\s+#(\d+)\b`)
+
+// maxReferenceCommitCount is the maximum number of commits of a merged pull request
+// whose messages are searched for closing keywords.
+const maxReferenceCommitCount = 100
+
+// parseClosingReferences returns unique pull request numbers referenced with closing keywords
+// in the provided texts, in the order of their first occurrence.
+func parseClosingReferences(texts ...string) []int64 {
+	var numbers []int64
+	seen := make(map[int64]struct{})
+
+	for _, text := range texts {
+		for _, match := range closingKeywordRegex.FindAllStringSubmatch(text, -1) {
+			number, err := strconv.ParseInt(match[1], 10, 64)
+			if err != nil || number <= 0 {
+				continue
+			}
+
+			if _, ok := seen[number]; ok {
+				continue
+			}
+
+			seen[number] = struct{}{}
+			numbers = append(numbers, number)
+		}
+	}
+
+	return numbers
+}
+
+// closeReferencedOnMerge handles pull request Merged events.
+// It searches the pull request description and commit messages for closing keywords
+// referencing other pull requests of the same repository. Referenced pull requests that are still open
+// get closed, and a reference activity is written to both pull requests.
+func (s *Service) closeReferencedOnMerge(ctx context.Context,
+	event *events.Event[*pullreqevents.MergedPayload],
+) error {
+	pr, err := s.pullreqStore.Find(ctx, event.Payload.PullReqID)
+	if err != nil {
+		return fmt.Errorf("failed to get pull request: %w", err)
+	}
+
+	repoGit, err := s.repoGitInfoCache.Get(ctx, pr.TargetRepoID)
+	if err != nil {
+		return fmt.Errorf("failed to get repo git info: %w", err)
+	}
+
+	texts := []string{pr.Description}
+
+	output, err := s.git.ListCommits(ctx, &git.ListCommitsParams{
+		ReadParams: git.ReadParams{RepoUID: repoGit.GitUID},
+		GitREF:     event.Payload.SourceSHA,
+		After:      event.Payload.TargetSHA,
+		Limit:      maxReferenceCommitCount,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list pull request commits: %w", err)
+	}
+
+	for _, commit := range output.Commits {
+		texts = append(texts, commit.Title, commit.Message)
+	}
+
+	for _, number := range parseClosingReferences(texts...) {
+		if number == pr.Number {
+			continue
+		}
+
+		// failures are logged and skipped to avoid writing duplicate activities on retry.
+		err = s.closeReferenced(ctx, event.Payload.PrincipalID, repoGit, pr, number)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).
+				Int64("referenced_pullreq_number", number).
+				Msg("failed to process closing reference of merged pull request")
+		}
+	}
+
+	return nil
+}
+
+// closeReferenced closes the referenced pull request if it's open
+// and writes the reference activities to both pull requests.
+func (s *Service) closeReferenced(ctx context.Context,
+	principalID int64,
+	repoGit *types.RepositoryGitInfo,
+	pr *types.PullReq,
+	number int64,
+) error {
+	refPR, err := s.pullreqStore.FindByNumber(ctx, pr.TargetRepoID, number)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find referenced pull request: %w", err)
+	}
+
+	var closed bool
+	var activitySeqReference, activitySeqPRClosed int64
+	refPR, err = s.pullreqStore.UpdateOptLock(ctx, refPR, func(refPR *types.PullReq) error {
+		closed = refPR.State == enum.PullReqStateOpen
+
+		refPR.ActivitySeq++
+		activitySeqReference = refPR.ActivitySeq
+
+		if !closed {
+			return nil
+		}
+
+		refPR.ActivitySeq++
+		activitySeqPRClosed = refPR.ActivitySeq
+
+		now := time.Now().UnixMilli()
+		refPR.State = enum.PullReqStateClosed
+		refPR.Closed = &now
+		refPR.MergeSHA = nil
+		refPR.MarkAsMergeUnchecked()
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update referenced pull request: %w", err)
+	}
+
+	refPR.ActivitySeq = activitySeqReference
+	_, err = s.activityStore.CreateWithPayload(ctx, refPR, principalID, &types.PullRequestActivityPayloadReference{
+		Number:   pr.Number,
+		Incoming: true,
+		Closed:   closed,
+	}, nil)
+	if err != nil {
+		// non-critical error
+		log.Ctx(ctx).Err(err).Msg("failed to write pull request activity for incoming reference")
+	}
+
+	if closed {
+		refPR.ActivitySeq = activitySeqPRClosed
+		payload := &types.PullRequestActivityPayloadStateChange{
+			Old:      enum.PullReqStateOpen,
+			New:      enum.PullReqStateClosed,
+			OldDraft: refPR.IsDraft,
+			NewDraft: refPR.IsDraft,
+		}
+		if _, err := s.activityStore.CreateWithPayload(ctx, refPR, principalID, payload, nil); err != nil {
+			// non-critical error
+			log.Ctx(ctx).Err(err).Msg("failed to write pull request activity for closure by reference")
+		}
+
+		s.pullreqEvReporter.Closed(ctx, &pullreqevents.ClosedPayload{
+			Base: pullreqevents.Base{
+				PullReqID:    refPR.ID,
+				SourceRepoID: refPR.SourceRepoID,
+				TargetRepoID: refPR.TargetRepoID,
+				PrincipalID:  principalID,
+				Number:       refPR.Number,
+			},
+			SourceSHA: refPR.SourceSHA,
+		})
+
+		if err = s.sseStreamer.Publish(ctx, repoGit.ParentID, enum.SSETypePullRequestUpdated, refPR); err != nil {
+			log.Ctx(ctx).Warn().Err(err).Msg("failed to publish PR changed event")
+		}
+	}
+
+	err = func() error {
+		if pr, err = s.pullreqStore.UpdateActivitySeq(ctx, pr); err != nil {
+			return fmt.Errorf("failed to increment pull request activity sequence: %w", err)
+		}
+
+		_, err = s.activityStore.CreateWithPayload(ctx, pr, principalID, &types.PullRequestActivityPayloadReference{
+			Number:   refPR.Number,
+			Incoming: false,
+			Closed:   closed,
+		}, nil)
+		return err
+	}()
+	if err != nil {
+		// non-critical error
+		log.Ctx(ctx).Err(err).Msg("failed to write pull request activity for outgoing reference")
+	}
+
+	return nil
+}
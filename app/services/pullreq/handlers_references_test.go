@@ -0,0 +1,66 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_parseClosingReferences(t *testing.T) {
+	tests := []struct {
+		name  string
+		texts []string
+		want  []int64
+	}{
+		{
+			name:  "test empty",
+			texts: []string{""},
+			want:  nil,
+		},
+		{
+			name:  "test no keyword",
+			texts: []string{"see #12 for details"},
+			want:  nil,
+		},
+		{
+			name:  "test keyword variants",
+			texts: []string{"Fixes #1, closed #2 and Resolve: #3"},
+			want:  []int64{1, 2, 3},
+		},
+		{
+			name:  "test keyword without number",
+			texts: []string{"fixes the bug #"},
+			want:  nil,
+		},
+		{
+			name:  "test keyword as part of another word",
+			texts: []string{"prefixes #4"},
+			want:  nil,
+		},
+		{
+			name:  "test duplicates across texts",
+			texts: []string{"fix #5", "fixed #6\n\ncloses #5"},
+			want:  []int64{5, 6},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseClosingReferences(tt.texts...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseClosingReferences() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"fmt"
+
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
+	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// dismissStaleApprovalsOnBranchUpdate handles pull request Branch Updated events.
+// If a branch rule of the target branch requires it, all approvals given for an older commit
+// are dismissed and a system activity listing the affected reviewers is written to the pull request.
+func (s *Service) dismissStaleApprovalsOnBranchUpdate(ctx context.Context,
+	event *events.Event[*pullreqevents.BranchUpdatedPayload],
+) error {
+	pr, err := s.pullreqStore.Find(ctx, event.Payload.PullReqID)
+	if err != nil {
+		return fmt.Errorf("failed to get pull request: %w", err)
+	}
+
+	if pr.State != enum.PullReqStateOpen {
+		return nil
+	}
+
+	targetRepo, err := s.repoStore.Find(ctx, pr.TargetRepoID)
+	if err != nil {
+		return fmt.Errorf("failed to get target repository: %w", err)
+	}
+
+	protectionRules, err := s.protectionManager.ForRepository(ctx, targetRepo.ID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch protection rules for the repository: %w", err)
+	}
+
+	out, err := protectionRules.StaleApprovals(ctx, protection.StaleApprovalsInput{
+		Repo:    targetRepo,
+		PullReq: pr,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check if stale approvals should be dismissed: %w", err)
+	}
+
+	if !out.Dismiss {
+		return nil
+	}
+
+	reviewers, err := s.reviewerStore.List(ctx, pr.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list pull request reviewers: %w", err)
+	}
+
+	var dismissedIDs []int64
+
+	for _, reviewer := range reviewers {
+		if reviewer.ReviewDecision != enum.PullReqReviewDecisionApproved || reviewer.SHA == pr.SourceSHA {
+			continue
+		}
+
+		reviewer.ReviewDecision = enum.PullReqReviewDecisionPending
+
+		err = s.reviewerStore.Update(ctx, reviewer)
+		if err != nil {
+			return fmt.Errorf("failed to dismiss approval of reviewer %d: %w", reviewer.PrincipalID, err)
+		}
+
+		dismissedIDs = append(dismissedIDs, reviewer.PrincipalID)
+	}
+
+	if len(dismissedIDs) == 0 {
+		return nil
+	}
+
+	err = func() error {
+		if pr, err = s.pullreqStore.UpdateActivitySeq(ctx, pr); err != nil {
+			return fmt.Errorf("failed to increment pull request activity sequence: %w", err)
+		}
+
+		payload := &types.PullRequestActivityPayloadReviewDismiss{
+			CommitSHA:    pr.SourceSHA,
+			PrincipalIDs: dismissedIDs,
+		}
+		_, err = s.activityStore.CreateWithPayload(ctx, pr, event.Payload.PrincipalID, payload, nil)
+		return err
+	}()
+	if err != nil {
+		// non-critical error
+		log.Ctx(ctx).Err(err).Msg("failed to write pull request activity after dismissing stale approvals")
+	}
+
+	if err = s.sseStreamer.Publish(ctx, targetRepo.ParentID, enum.SSETypePullRequestUpdated, pr); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("failed to publish PR changed event")
+	}
+
+	return nil
+}
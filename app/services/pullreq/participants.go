@@ -0,0 +1,113 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// ListParticipants returns all principals taking part in the pull request: its author, the reviewers,
+// the authors of the (published, not deleted) comments and the principals mentioned
+// in the description or in the comments.
+// The author is always listed first, the rest in the order in which they joined the pull request.
+func (s *Service) ListParticipants(
+	ctx context.Context,
+	pr *types.PullReq,
+) ([]*types.PullReqParticipant, error) {
+	var ids []int64
+	roles := make(map[int64][]enum.PullReqParticipantRole)
+
+	addRole := func(principalID int64, role enum.PullReqParticipantRole) {
+		r, ok := roles[principalID]
+		if !ok {
+			ids = append(ids, principalID)
+		}
+		if !slices.Contains(r, role) {
+			roles[principalID] = append(r, role)
+		}
+	}
+
+	addRole(pr.CreatedBy, enum.PullReqParticipantRoleAuthor)
+
+	reviewers, err := s.reviewerStore.List(ctx, pr.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull request reviewers: %w", err)
+	}
+
+	for _, reviewer := range reviewers {
+		addRole(reviewer.PrincipalID, enum.PullReqParticipantRoleReviewer)
+	}
+
+	mentions, err := s.mentionStore.List(ctx, pr.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull request mentions: %w", err)
+	}
+
+	for _, mention := range mentions {
+		addRole(mention.PrincipalID, enum.PullReqParticipantRoleMentioned)
+	}
+
+	comments, err := s.activityStore.List(ctx, pr.ID, &types.PullReqActivityFilter{
+		Kinds: []enum.PullReqActivityKind{
+			enum.PullReqActivityKindComment,
+			enum.PullReqActivityKindChangeComment,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull request comments: %w", err)
+	}
+
+	for _, comment := range comments {
+		if comment.Deleted != nil {
+			continue
+		}
+
+		addRole(comment.CreatedBy, enum.PullReqParticipantRoleCommenter)
+
+		if comment.Metadata == nil || comment.Metadata.Mentions == nil {
+			continue
+		}
+
+		for _, id := range comment.Metadata.Mentions.IDs {
+			addRole(id, enum.PullReqParticipantRoleMentioned)
+		}
+	}
+
+	infoMap, err := s.principalInfoCache.Map(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch participant infos: %w", err)
+	}
+
+	participants := make([]*types.PullReqParticipant, 0, len(ids))
+	for _, id := range ids {
+		info, ok := infoMap[id]
+		if !ok {
+			// the principal doesn't exist anymore
+			continue
+		}
+
+		participants = append(participants, &types.PullReqParticipant{
+			Principal: *info,
+			Roles:     roles[id],
+		})
+	}
+
+	return participants, nil
+}
@@ -0,0 +1,145 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// AddReviewer adds the principal as a reviewer of the pull request, unless it's a reviewer already.
+// For a new reviewer it writes the reviewer added activity and reports the reviewer added event.
+// It returns the (new or existing) reviewer and the pull request with its activity sequence updated.
+func (s *Service) AddReviewer(
+	ctx context.Context,
+	addedBy *types.Principal,
+	repo *types.Repository,
+	pr *types.PullReq,
+	reviewerInfo *types.PrincipalInfo,
+	reviewerType enum.PullReqReviewerType,
+) (*types.PullReqReviewer, *types.PullReq, error) {
+	reviewer, err := s.reviewerStore.Find(ctx, pr.ID, reviewerInfo.ID)
+	if err == nil {
+		return reviewer, pr, nil
+	}
+	if !errors.Is(err, gitness_store.ErrResourceNotFound) {
+		return nil, nil, fmt.Errorf("failed to find pull request reviewer: %w", err)
+	}
+
+	now := time.Now().UnixMilli()
+	reviewer = &types.PullReqReviewer{
+		PullReqID:      pr.ID,
+		PrincipalID:    reviewerInfo.ID,
+		CreatedBy:      addedBy.ID,
+		Created:        now,
+		Updated:        now,
+		RepoID:         repo.ID,
+		Type:           reviewerType,
+		LatestReviewID: nil,
+		ReviewDecision: enum.PullReqReviewDecisionPending,
+		SHA:            "",
+		Reviewer:       *reviewerInfo,
+		AddedBy:        *addedBy.ToPrincipalInfo(),
+	}
+
+	err = s.reviewerStore.Create(ctx, reviewer)
+	if errors.Is(err, gitness_store.ErrDuplicate) {
+		// the reviewer got added concurrently
+		reviewer, err = s.reviewerStore.Find(ctx, pr.ID, reviewerInfo.ID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to find concurrently added pull request reviewer: %w", err)
+		}
+
+		return reviewer, pr, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create pull request reviewer: %w", err)
+	}
+
+	err = func() error {
+		payload := &types.PullRequestActivityPayloadReviewerAdd{
+			PrincipalID:  reviewer.PrincipalID,
+			ReviewerType: reviewerType,
+		}
+
+		metadata := &types.PullReqActivityMetadata{
+			Mentions: &types.PullReqActivityMentionsMetadata{IDs: []int64{reviewer.PrincipalID}},
+		}
+
+		updatedPR, err := s.pullreqStore.UpdateActivitySeq(ctx, pr)
+		if err != nil {
+			return fmt.Errorf("failed to increment pull request activity sequence: %w", err)
+		}
+
+		pr = updatedPR
+
+		_, err = s.activityStore.CreateWithPayload(ctx, pr, addedBy.ID, payload, metadata)
+		if err != nil {
+			return fmt.Errorf("failed to create pull request activity: %w", err)
+		}
+
+		return nil
+	}()
+	if err != nil {
+		// non-critical error
+		log.Ctx(ctx).Err(err).Msg("failed to write pull request activity after adding a reviewer")
+	}
+
+	s.pullreqEvReporter.ReviewerAdded(ctx, &pullreqevents.ReviewerAddedPayload{
+		Base: pullreqevents.Base{
+			PullReqID:    pr.ID,
+			SourceRepoID: pr.SourceRepoID,
+			TargetRepoID: pr.TargetRepoID,
+			PrincipalID:  addedBy.ID,
+			Number:       pr.Number,
+		},
+		ReviewerID: reviewer.PrincipalID,
+	})
+
+	return reviewer, pr, nil
+}
+
+// removedReviewerIDs returns the IDs of the principals that were removed as reviewers of the pull request.
+func (s *Service) removedReviewerIDs(ctx context.Context, pr *types.PullReq) (map[int64]struct{}, error) {
+	activities, err := s.activityStore.List(ctx, pr.ID, &types.PullReqActivityFilter{
+		Types: []enum.PullReqActivityType{enum.PullReqActivityTypeReviewerDelete},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reviewer delete activities: %w", err)
+	}
+
+	removed := make(map[int64]struct{}, len(activities))
+	for _, activity := range activities {
+		payload, err := activity.GetPayload()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get reviewer delete activity payload: %w", err)
+		}
+
+		if reviewerDelete, ok := payload.(*types.PullRequestActivityPayloadReviewerDelete); ok {
+			removed[reviewerDelete.PrincipalID] = struct{}{}
+		}
+	}
+
+	return removed, nil
+}
@@ -0,0 +1,158 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apiauth "github.com/harness/gitness/app/api/auth"
+	"github.com/harness/gitness/app/auth"
+	pullreqevents "github.com/harness/gitness/app/events/pullreq"
+	"github.com/harness/gitness/app/services/settings"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+// AddDefaultReviewers requests a review from the default reviewers (users and user groups) configured
+// in the repository settings, but only if the pull request targets a protected branch.
+// Reviewers that are already assigned, for example as code owners, are left unchanged.
+// It returns the pull request with its activity sequence updated.
+func (s *Service) AddDefaultReviewers(
+	ctx context.Context,
+	addedBy *types.Principal,
+	repo *types.Repository,
+	pr *types.PullReq,
+) (*types.PullReq, error) {
+	reviewerIDs, err := settings.RepoGet(ctx, s.settings, repo.ID,
+		settings.KeyDefaultReviewerIDs, settings.DefaultDefaultReviewerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get default reviewers setting: %w", err)
+	}
+
+	userGroupIDs, err := settings.RepoGet(ctx, s.settings, repo.ID,
+		settings.KeyDefaultUserGroupReviewerIDs, settings.DefaultDefaultUserGroupReviewerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get default user group reviewers setting: %w", err)
+	}
+
+	if len(reviewerIDs) == 0 && len(userGroupIDs) == 0 {
+		return pr, nil
+	}
+
+	protected, err := s.protectionManager.IsBranchProtected(ctx, repo.ID, repo.DefaultBranch, pr.TargetBranch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if the target branch is protected: %w", err)
+	}
+	if !protected {
+		return pr, nil
+	}
+
+	for _, reviewerID := range reviewerIDs {
+		if reviewerID == pr.CreatedBy {
+			continue
+		}
+
+		reviewerPrincipal, err := s.principalStore.Find(ctx, reviewerID)
+		if errors.Is(err, gitness_store.ErrResourceNotFound) {
+			log.Ctx(ctx).Info().Msgf("Default reviewer principal ID=%d not found", reviewerID)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to find default reviewer principal: %w", err)
+		}
+
+		if err = apiauth.CheckRepo(ctx, s.authorizer, &auth.Session{
+			Principal: *reviewerPrincipal,
+			Metadata:  nil,
+		}, repo, enum.PermissionRepoReview); err != nil {
+			log.Ctx(ctx).Info().Msgf("Default reviewer principal: %s access error: %s", reviewerPrincipal.UID, err)
+			continue
+		}
+
+		_, pr, err = s.AddReviewer(ctx, addedBy, repo, pr,
+			reviewerPrincipal.ToPrincipalInfo(), enum.PullReqReviewerTypeRequested)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add default reviewer: %w", err)
+		}
+	}
+
+	for _, userGroupID := range userGroupIDs {
+		if err := s.addDefaultUserGroupReviewer(ctx, addedBy, repo, pr, userGroupID); err != nil {
+			return nil, err
+		}
+	}
+
+	return pr, nil
+}
+
+func (s *Service) addDefaultUserGroupReviewer(
+	ctx context.Context,
+	addedBy *types.Principal,
+	repo *types.Repository,
+	pr *types.PullReq,
+	userGroupID int64,
+) error {
+	userGroup, err := s.userGroupStore.Find(ctx, userGroupID)
+	if errors.Is(err, gitness_store.ErrResourceNotFound) {
+		log.Ctx(ctx).Info().Msgf("Default reviewer user group ID=%d not found", userGroupID)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find default reviewer user group: %w", err)
+	}
+
+	_, err = s.groupReviewerStore.Find(ctx, pr.ID, userGroupID)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, gitness_store.ErrResourceNotFound) {
+		return fmt.Errorf("failed to find user group reviewer: %w", err)
+	}
+
+	now := time.Now().UnixMilli()
+	userGroupReviewer := &types.UserGroupReviewer{
+		PullReqID:   pr.ID,
+		UserGroupID: userGroupID,
+		CreatedBy:   addedBy.ID,
+		Created:     now,
+		Updated:     now,
+		RepoID:      repo.ID,
+		UserGroup:   *userGroup.ToUserGroupInfo(),
+		AddedBy:     *addedBy.ToPrincipalInfo(),
+	}
+
+	if err = s.groupReviewerStore.Create(ctx, userGroupReviewer); err != nil {
+		return fmt.Errorf("failed to add default user group reviewer: %w", err)
+	}
+
+	s.pullreqEvReporter.UserGroupReviewerAdded(ctx, &pullreqevents.UserGroupReviewerAddedPayload{
+		Base: pullreqevents.Base{
+			PullReqID:    pr.ID,
+			SourceRepoID: pr.SourceRepoID,
+			TargetRepoID: pr.TargetRepoID,
+			PrincipalID:  addedBy.ID,
+			Number:       pr.Number,
+		},
+		UserGroupReviewerID: userGroupID,
+	})
+
+	return nil
+}
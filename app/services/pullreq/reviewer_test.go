@@ -0,0 +1,85 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pullreq
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// reviewerDeleteActivityStore lists reviewer delete activities of the removed principals.
+type reviewerDeleteActivityStore struct {
+	store.PullReqActivityStore
+	removed []int64
+}
+
+func (s *reviewerDeleteActivityStore) List(
+	context.Context,
+	int64,
+	*types.PullReqActivityFilter,
+) ([]*types.PullReqActivity, error) {
+	activities := make([]*types.PullReqActivity, len(s.removed))
+	for i, principalID := range s.removed {
+		activities[i] = &types.PullReqActivity{Type: enum.PullReqActivityTypeReviewerDelete}
+		err := activities[i].SetPayload(&types.PullRequestActivityPayloadReviewerDelete{PrincipalID: principalID})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return activities, nil
+}
+
+func TestService_removedReviewerIDs(t *testing.T) {
+	tests := []struct {
+		name    string
+		removed []int64
+		want    map[int64]struct{}
+	}{
+		{
+			name:    "no removed reviewers",
+			removed: nil,
+			want:    map[int64]struct{}{},
+		},
+		{
+			name:    "removed reviewers",
+			removed: []int64{3, 5},
+			want:    map[int64]struct{}{3: {}, 5: {}},
+		},
+		{
+			name:    "reviewer removed twice",
+			removed: []int64{3, 3},
+			want:    map[int64]struct{}{3: {}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{activityStore: &reviewerDeleteActivityStore{removed: tt.removed}}
+
+			got, err := s.removedReviewerIDs(context.Background(), &types.PullReq{ID: 1})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("removedReviewerIDs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -20,11 +20,15 @@ import (
 	"sync"
 	"time"
 
+	"github.com/harness/gitness/app/auth/authz"
 	"github.com/harness/gitness/app/bootstrap"
 	gitevents "github.com/harness/gitness/app/events/git"
 	pullreqevents "github.com/harness/gitness/app/events/pullreq"
 	"github.com/harness/gitness/app/githook"
 	"github.com/harness/gitness/app/services/codecomments"
+	"github.com/harness/gitness/app/services/codeowners"
+	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/app/services/settings"
 	"github.com/harness/gitness/app/sse"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/app/url"
@@ -46,6 +50,17 @@ type Service struct {
 	principalInfoCache  store.PrincipalInfoCache
 	codeCommentMigrator *codecomments.Migrator
 	fileViewStore       store.PullReqFileViewStore
+	reviewerStore       store.PullReqReviewerStore
+	mentionStore        store.PullReqMentionStore
+	fileStatsStore      store.PullReqFileStatsStore
+	dependencyStore     store.PullReqDependencyStore
+	protectionManager   *protection.Manager
+	codeOwners          *codeowners.Service
+	principalStore      store.PrincipalStore
+	authorizer          authz.Authorizer
+	settings            *settings.Service
+	userGroupStore      store.UserGroupStore
+	groupReviewerStore  store.UserGroupReviewersStore
 	sseStreamer         sse.Streamer
 	urlProvider         url.Provider
 
@@ -69,6 +84,17 @@ func New(ctx context.Context,
 	codeCommentView store.CodeCommentView,
 	codeCommentMigrator *codecomments.Migrator,
 	fileViewStore store.PullReqFileViewStore,
+	reviewerStore store.PullReqReviewerStore,
+	mentionStore store.PullReqMentionStore,
+	fileStatsStore store.PullReqFileStatsStore,
+	dependencyStore store.PullReqDependencyStore,
+	protectionManager *protection.Manager,
+	codeOwners *codeowners.Service,
+	principalStore store.PrincipalStore,
+	authorizer authz.Authorizer,
+	settings *settings.Service,
+	userGroupStore store.UserGroupStore,
+	groupReviewerStore store.UserGroupReviewersStore,
 	principalInfoCache store.PrincipalInfoCache,
 	bus pubsub.PubSub,
 	urlProvider url.Provider,
@@ -86,6 +112,17 @@ func New(ctx context.Context,
 		urlProvider:         urlProvider,
 		codeCommentMigrator: codeCommentMigrator,
 		fileViewStore:       fileViewStore,
+		reviewerStore:       reviewerStore,
+		mentionStore:        mentionStore,
+		fileStatsStore:      fileStatsStore,
+		dependencyStore:     dependencyStore,
+		protectionManager:   protectionManager,
+		codeOwners:          codeOwners,
+		principalStore:      principalStore,
+		authorizer:          authorizer,
+		settings:            settings,
+		userGroupStore:      userGroupStore,
+		groupReviewerStore:  groupReviewerStore,
 		cancelMergeability:  make(map[string]context.CancelFunc),
 		pubsub:              bus,
 		sseStreamer:         sseStreamer,
@@ -159,6 +196,28 @@ func New(ctx context.Context,
 		return nil, err
 	}
 
+	// pull request file stats maintenance
+
+	const groupPullReqFileStats = "gitness:pullreq:filestats"
+	_, err = pullreqEvReaderFactory.Launch(ctx, groupPullReqFileStats, config.InstanceID,
+		func(r *pullreqevents.Reader) error {
+			const idleTimeout = 30 * time.Second
+			r.Configure(
+				stream.WithConcurrency(3),
+				stream.WithHandlerOptions(
+					stream.WithIdleTimeout(idleTimeout),
+					stream.WithMaxRetries(1),
+				))
+
+			_ = r.RegisterCreated(service.updateFileStatsOnCreated)
+			_ = r.RegisterBranchUpdated(service.updateFileStatsOnBranchUpdate)
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
 	const groupPullReqCounters = "gitness:pullreq:counters"
 	_, err = pullreqEvReaderFactory.Launch(ctx, groupPullReqCounters, config.InstanceID,
 		func(r *pullreqevents.Reader) error {
@@ -181,6 +240,27 @@ func New(ctx context.Context,
 		return nil, err
 	}
 
+	// pull request dependencies
+
+	const groupPullReqDependencies = "gitness:pullreq:dependencies"
+	_, err = pullreqEvReaderFactory.Launch(ctx, groupPullReqDependencies, config.InstanceID,
+		func(r *pullreqevents.Reader) error {
+			const idleTimeout = 30 * time.Second
+			r.Configure(
+				stream.WithConcurrency(1),
+				stream.WithHandlerOptions(
+					stream.WithIdleTimeout(idleTimeout),
+					stream.WithMaxRetries(2),
+				))
+
+			_ = r.RegisterMerged(service.retargetDependentsOnMerged)
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
 	// mergeability check
 	const groupPullReqMergeable = "gitness:pullreq:mergeable"
 	_, err = pullreqEvReaderFactory.Launch(ctx, groupPullReqMergeable, config.InstanceID,
@@ -196,6 +276,7 @@ func New(ctx context.Context,
 			_ = r.RegisterCreated(service.mergeCheckOnCreated)
 			_ = r.RegisterBranchUpdated(service.mergeCheckOnBranchUpdate)
 			_ = r.RegisterReopened(service.mergeCheckOnReopen)
+			_ = r.RegisterTargetBranchChanged(service.mergeCheckOnTargetBranchChange)
 			_ = r.RegisterClosed(service.mergeCheckOnClosed)
 			_ = r.RegisterMerged(service.mergeCheckOnMerged)
 
@@ -247,6 +328,66 @@ func New(ctx context.Context,
 		return nil, err
 	}
 
+	// closing keywords
+	const groupPullReqReferences = "gitness:pullreq:references"
+	_, err = pullreqEvReaderFactory.Launch(ctx, groupPullReqReferences, config.InstanceID,
+		func(r *pullreqevents.Reader) error {
+			const idleTimeout = 10 * time.Second
+			r.Configure(
+				stream.WithConcurrency(1),
+				stream.WithHandlerOptions(
+					stream.WithIdleTimeout(idleTimeout),
+					stream.WithMaxRetries(2),
+				))
+
+			_ = r.RegisterMerged(service.closeReferencedOnMerge)
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	// dismissal of stale approvals
+	const groupPullReqReviews = "gitness:pullreq:reviews"
+	_, err = pullreqEvReaderFactory.Launch(ctx, groupPullReqReviews, config.InstanceID,
+		func(r *pullreqevents.Reader) error {
+			const idleTimeout = 10 * time.Second
+			r.Configure(
+				stream.WithConcurrency(1),
+				stream.WithHandlerOptions(
+					stream.WithIdleTimeout(idleTimeout),
+					stream.WithMaxRetries(2),
+				))
+
+			_ = r.RegisterBranchUpdated(service.dismissStaleApprovalsOnBranchUpdate)
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	// requesting reviews from code owners of files changed by new commits
+	const groupPullReqCodeOwners = "gitness:pullreq:codeowners"
+	_, err = pullreqEvReaderFactory.Launch(ctx, groupPullReqCodeOwners, config.InstanceID,
+		func(r *pullreqevents.Reader) error {
+			const idleTimeout = 10 * time.Second
+			r.Configure(
+				stream.WithConcurrency(1),
+				stream.WithHandlerOptions(
+					stream.WithIdleTimeout(idleTimeout),
+					stream.WithMaxRetries(2),
+				))
+
+			_ = r.RegisterBranchUpdated(service.addCodeOwnerReviewersOnBranchUpdate)
+
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
 	return service, nil
 }
 
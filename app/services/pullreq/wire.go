@@ -21,7 +21,10 @@ import (
 	gitevents "github.com/harness/gitness/app/events/git"
 	pullreqevents "github.com/harness/gitness/app/events/pullreq"
 	"github.com/harness/gitness/app/services/codecomments"
+	"github.com/harness/gitness/app/services/codeowners"
 	"github.com/harness/gitness/app/services/label"
+	"github.com/harness/gitness/app/services/protection"
+	"github.com/harness/gitness/app/services/settings"
 	"github.com/harness/gitness/app/sse"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/app/url"
@@ -53,6 +56,17 @@ func ProvideService(ctx context.Context,
 	codeCommentView store.CodeCommentView,
 	codeCommentMigrator *codecomments.Migrator,
 	fileViewStore store.PullReqFileViewStore,
+	reviewerStore store.PullReqReviewerStore,
+	mentionStore store.PullReqMentionStore,
+	fileStatsStore store.PullReqFileStatsStore,
+	dependencyStore store.PullReqDependencyStore,
+	protectionManager *protection.Manager,
+	codeOwners *codeowners.Service,
+	principalStore store.PrincipalStore,
+	authorizer authz.Authorizer,
+	settings *settings.Service,
+	userGroupStore store.UserGroupStore,
+	groupReviewerStore store.UserGroupReviewersStore,
 	pubsub pubsub.PubSub,
 	urlProvider url.Provider,
 	sseStreamer sse.Streamer,
@@ -70,6 +84,17 @@ func ProvideService(ctx context.Context,
 		codeCommentView,
 		codeCommentMigrator,
 		fileViewStore,
+		reviewerStore,
+		mentionStore,
+		fileStatsStore,
+		dependencyStore,
+		protectionManager,
+		codeOwners,
+		principalStore,
+		authorizer,
+		settings,
+		userGroupStore,
+		groupReviewerStore,
 		principalInfoCache,
 		pubsub,
 		urlProvider,
@@ -0,0 +1,235 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reviewanalytics
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/harness/gitness/app/api/usererror"
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	jobType = "gitness:review-analytics"
+
+	defaultRange = 30 * 24 * time.Hour
+	maxRange     = 366 * 24 * time.Hour
+)
+
+// Service maintains the daily review rollups and builds review analytics from them.
+type Service struct {
+	enabled            bool
+	cron               string
+	maxDur             time.Duration
+	lookback           time.Duration
+	tx                 dbtx.Transactor
+	scheduler          *job.Scheduler
+	reviewRollupStore  store.ReviewRollupStore
+	principalInfoCache store.PrincipalInfoCache
+}
+
+var _ job.Handler = (*Service)(nil)
+
+// Register schedules the recurring job that refreshes the review rollups.
+func (s *Service) Register(ctx context.Context) error {
+	if !s.enabled {
+		return nil
+	}
+
+	err := s.scheduler.AddRecurring(ctx, jobType, jobType, s.cron, s.maxDur)
+	if err != nil {
+		return fmt.Errorf("failed to register recurring job for review analytics: %w", err)
+	}
+
+	return nil
+}
+
+// Handle refreshes the review rollups of the last days. All rollups get calculated on the first run.
+func (s *Service) Handle(ctx context.Context, _ string, _ job.ProgressReporter) (string, error) {
+	if !s.enabled {
+		return "", nil
+	}
+
+	isEmpty, err := s.reviewRollupStore.IsEmpty(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to check for existing review rollups: %w", err)
+	}
+
+	var since int64
+	if !isEmpty {
+		since = time.Now().Add(-s.lookback).UnixMilli()
+	}
+
+	// the old rollups are replaced in a single transaction, so a failure never leaves partial rollups behind.
+	err = s.tx.WithTx(ctx, func(ctx context.Context) error {
+		return s.reviewRollupStore.Refresh(ctx, since)
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to refresh review rollups: %w", err)
+	}
+
+	return fmt.Sprintf("refreshed review rollups since %s", time.UnixMilli(since).UTC().Format(time.DateOnly)), nil
+}
+
+// GetForRepo returns the review analytics of the repository.
+func (s *Service) GetForRepo(
+	ctx context.Context,
+	repoID int64,
+	filter *types.ReviewAnalyticsFilter,
+) (*types.ReviewAnalytics, error) {
+	if err := sanitizeFilter(filter); err != nil {
+		return nil, err
+	}
+
+	return s.get(ctx, &types.ReviewRollupFilter{
+		RepoID: repoID,
+		From:   filter.Since,
+		To:     filter.Until,
+	})
+}
+
+// GetForSpaces returns the review analytics of all repositories in the spaces.
+func (s *Service) GetForSpaces(
+	ctx context.Context,
+	spaceIDs []int64,
+	filter *types.ReviewAnalyticsFilter,
+) (*types.ReviewAnalytics, error) {
+	if err := sanitizeFilter(filter); err != nil {
+		return nil, err
+	}
+
+	return s.get(ctx, &types.ReviewRollupFilter{
+		SpaceIDs: spaceIDs,
+		From:     filter.Since,
+		To:       filter.Until,
+	})
+}
+
+func sanitizeFilter(filter *types.ReviewAnalyticsFilter) error {
+	if filter.Until == 0 {
+		filter.Until = time.Now().UnixMilli()
+	}
+	if filter.Since == 0 {
+		filter.Since = filter.Until - defaultRange.Milliseconds()
+	}
+
+	if filter.Since >= filter.Until {
+		return usererror.BadRequest("The start of the time range must be before its end.")
+	}
+	if filter.Until-filter.Since > maxRange.Milliseconds() {
+		return usererror.BadRequestf("The time range can't be longer than %d days.", int(maxRange.Hours()/24))
+	}
+
+	return nil
+}
+
+func (s *Service) get(
+	ctx context.Context,
+	filter *types.ReviewRollupFilter,
+) (*types.ReviewAnalytics, error) {
+	rollups, err := s.reviewRollupStore.Aggregate(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate review rollups: %w", err)
+	}
+
+	analytics := &types.ReviewAnalytics{
+		Since:      filter.From,
+		Until:      filter.To,
+		Throughput: []types.ReviewThroughput{},
+		ReviewLoad: []types.ReviewerLoad{},
+	}
+
+	var firstReviewTotal, mergeTotal int64
+	throughput := make(map[int64]*types.ReviewThroughput)
+	load := make(map[int64]*types.ReviewerLoad)
+
+	for _, rollup := range rollups {
+		switch rollup.Metric {
+		case enum.ReviewRollupMetricOpened:
+			getThroughput(throughput, rollup.Day).Opened += rollup.Count
+		case enum.ReviewRollupMetricMerged:
+			getThroughput(throughput, rollup.Day).Merged += rollup.Count
+			analytics.TimeToMerge.Count += rollup.Count
+			mergeTotal += rollup.Duration
+		case enum.ReviewRollupMetricFirstReview:
+			analytics.TimeToFirstReview.Count += rollup.Count
+			firstReviewTotal += rollup.Duration
+		case enum.ReviewRollupMetricReviewed:
+			getLoad(load, rollup.PrincipalID).Reviews += rollup.Count
+		case enum.ReviewRollupMetricApproved:
+			getLoad(load, rollup.PrincipalID).Approved += rollup.Count
+		case enum.ReviewRollupMetricChangeReq:
+			getLoad(load, rollup.PrincipalID).ChangesRequested += rollup.Count
+		}
+	}
+
+	if analytics.TimeToFirstReview.Count > 0 {
+		analytics.TimeToFirstReview.Average = firstReviewTotal / analytics.TimeToFirstReview.Count
+	}
+	if analytics.TimeToMerge.Count > 0 {
+		analytics.TimeToMerge.Average = mergeTotal / analytics.TimeToMerge.Count
+	}
+
+	for _, t := range throughput {
+		analytics.Throughput = append(analytics.Throughput, *t)
+	}
+	sort.Slice(analytics.Throughput, func(i, j int) bool {
+		return analytics.Throughput[i].Day < analytics.Throughput[j].Day
+	})
+
+	for principalID, l := range load {
+		l.Reviewer, err = s.principalInfoCache.Get(ctx, principalID)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Int64("principal_id", principalID).Msg("failed to find reviewer")
+			continue
+		}
+		analytics.ReviewLoad = append(analytics.ReviewLoad, *l)
+	}
+	sort.Slice(analytics.ReviewLoad, func(i, j int) bool {
+		if analytics.ReviewLoad[i].Reviews != analytics.ReviewLoad[j].Reviews {
+			return analytics.ReviewLoad[i].Reviews > analytics.ReviewLoad[j].Reviews
+		}
+		return analytics.ReviewLoad[i].Reviewer.ID < analytics.ReviewLoad[j].Reviewer.ID
+	})
+
+	return analytics, nil
+}
+
+func getThroughput(m map[int64]*types.ReviewThroughput, day int64) *types.ReviewThroughput {
+	t, ok := m[day]
+	if !ok {
+		t = &types.ReviewThroughput{Day: day}
+		m[day] = t
+	}
+	return t
+}
+
+func getLoad(m map[int64]*types.ReviewerLoad, principalID int64) *types.ReviewerLoad {
+	l, ok := m[principalID]
+	if !ok {
+		l = &types.ReviewerLoad{}
+		m[principalID] = l
+	}
+	return l
+}
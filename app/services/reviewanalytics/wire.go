@@ -0,0 +1,54 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reviewanalytics
+
+import (
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/job"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+
+	"github.com/google/wire"
+)
+
+var WireSet = wire.NewSet(
+	ProvideService,
+)
+
+func ProvideService(
+	config *types.Config,
+	tx dbtx.Transactor,
+	scheduler *job.Scheduler,
+	executor *job.Executor,
+	reviewRollupStore store.ReviewRollupStore,
+	principalInfoCache store.PrincipalInfoCache,
+) (*Service, error) {
+	service := &Service{
+		enabled:            config.ReviewAnalytics.Enabled,
+		cron:               config.ReviewAnalytics.CRON,
+		maxDur:             config.ReviewAnalytics.MaxDuration,
+		lookback:           config.ReviewAnalytics.Lookback,
+		tx:                 tx,
+		scheduler:          scheduler,
+		reviewRollupStore:  reviewRollupStore,
+		principalInfoCache: principalInfoCache,
+	}
+
+	if err := executor.Register(jobType, service); err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
@@ -24,4 +24,15 @@ var (
 	DefaultFileSizeLimit             = int64(1e+8) // 100 MB
 	KeyInstallID                 Key = "install_id"
 	DefaultInstallID                 = string("")
+	// KeyDefaultReviewerIDs [[]int64] lists principals requested as reviewers on pull requests
+	// opened against protected branches.
+	KeyDefaultReviewerIDs     Key = "default_reviewer_ids"
+	DefaultDefaultReviewerIDs     = []int64{}
+	// KeyDefaultUserGroupReviewerIDs [[]int64] lists user groups requested as reviewers on pull requests
+	// opened against protected branches.
+	KeyDefaultUserGroupReviewerIDs     Key = "default_usergroup_reviewer_ids"
+	DefaultDefaultUserGroupReviewerIDs     = []int64{}
+	// KeyMergeQueueBranches [[]string] lists the branches for which pull requests are merged using the merge queue.
+	KeyMergeQueueBranches     Key = "merge_queue_branches"
+	DefaultMergeQueueBranches     = []string{}
 )
@@ -0,0 +1,98 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pipelineevents "github.com/harness/gitness/app/events/pipeline"
+	"github.com/harness/gitness/events"
+	"github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+// PipelineExecutionPayload describes the body of the pipeline executed trigger.
+type PipelineExecutionPayload struct {
+	BaseSegment
+	PipelineExecutionSegment
+	HeadCommit *CommitInfo `json:"head_commit,omitempty"`
+}
+
+// handleEventPipelineExecuted handles pipeline executed events
+// and triggers pipeline executed webhooks for the repo of the pipeline.
+func (s *Service) handleEventPipelineExecuted(ctx context.Context,
+	event *events.Event[*pipelineevents.ExecutedPayload]) error {
+	pipeline, execution, err := s.findExecutionForEvent(ctx, event.Payload.PipelineID, event.Payload.ExecutionNum)
+	if err != nil {
+		return err
+	}
+
+	return s.triggerForEventWithRepo(ctx, enum.WebhookTriggerPipelineExecuted,
+		event.ID, execution.CreatedBy, event.Payload.RepoID,
+		func(principal *types.Principal, repo *types.Repository) (any, error) {
+			var headCommit *CommitInfo
+			if execution.After != "" {
+				commitInfo, err := s.fetchCommitInfoForEvent(ctx, repo.GitUID, execution.After)
+				if err != nil {
+					return nil, err
+				}
+				headCommit = &commitInfo
+			}
+
+			return &PipelineExecutionPayload{
+				BaseSegment: BaseSegment{
+					Trigger:   enum.WebhookTriggerPipelineExecuted,
+					Repo:      repositoryInfoFrom(ctx, repo, s.urlProvider),
+					Principal: principalInfoFrom(principal.ToPrincipalInfo()),
+				},
+				PipelineExecutionSegment: PipelineExecutionSegment{
+					Pipeline:  pipelineInfoFrom(pipeline),
+					Execution: executionInfoFrom(ctx, execution, pipeline, repo, s.urlProvider),
+				},
+				HeadCommit: headCommit,
+			}, nil
+		})
+}
+
+// findExecutionForEvent finds the pipeline and the execution for the provided pipelineID and execution number.
+func (s *Service) findExecutionForEvent(
+	ctx context.Context,
+	pipelineID int64,
+	executionNum int64,
+) (*types.Pipeline, *types.Execution, error) {
+	pipeline, err := s.pipelineStore.Find(ctx, pipelineID)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		// not found error is unrecoverable - most likely a racing condition of pipeline being deleted by now
+		return nil, nil, events.NewDiscardEventErrorf("pipeline with id '%d' doesn't exist anymore", pipelineID)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get pipeline for id '%d': %w", pipelineID, err)
+	}
+
+	execution, err := s.executionStore.FindByNumber(ctx, pipelineID, executionNum)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return nil, nil, events.NewDiscardEventErrorf("execution %d of pipeline with id '%d' doesn't exist anymore",
+			executionNum, pipelineID)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get execution %d for pipeline with id '%d': %w",
+			executionNum, pipelineID, err)
+	}
+
+	return pipeline, execution, nil
+}
@@ -414,3 +414,53 @@ func (s *Service) handleEventPullReqUpdated(
 			}, nil
 		})
 }
+
+// PullReqReviewSubmittedPayload describes the body of the pullreq review submitted trigger.
+type PullReqReviewSubmittedPayload struct {
+	BaseSegment
+	PullReqSegment
+	PullReqTargetReferenceSegment
+	ReferenceSegment
+	PullReqReviewSegment
+}
+
+// handleEventPullReqReviewSubmitted handles review submitted events for pull requests
+// and triggers pullreq review submitted webhooks for the target repo.
+func (s *Service) handleEventPullReqReviewSubmitted(
+	ctx context.Context,
+	event *events.Event[*pullreqevents.ReviewSubmittedPayload],
+) error {
+	return s.triggerForEventWithPullReq(ctx, enum.WebhookTriggerPullReqReviewSubmitted,
+		event.ID, event.Payload.PrincipalID, event.Payload.PullReqID,
+		func(principal *types.Principal, pr *types.PullReq, targetRepo, sourceRepo *types.Repository) (any, error) {
+			targetRepoInfo := repositoryInfoFrom(ctx, targetRepo, s.urlProvider)
+			sourceRepoInfo := repositoryInfoFrom(ctx, sourceRepo, s.urlProvider)
+
+			return &PullReqReviewSubmittedPayload{
+				BaseSegment: BaseSegment{
+					Trigger:   enum.WebhookTriggerPullReqReviewSubmitted,
+					Repo:      targetRepoInfo,
+					Principal: principalInfoFrom(principal.ToPrincipalInfo()),
+				},
+				PullReqSegment: PullReqSegment{
+					PullReq: pullReqInfoFrom(ctx, pr, targetRepo, s.urlProvider),
+				},
+				PullReqTargetReferenceSegment: PullReqTargetReferenceSegment{
+					TargetRef: ReferenceInfo{
+						Name: gitReferenceNamePrefixBranch + pr.TargetBranch,
+						Repo: targetRepoInfo,
+					},
+				},
+				ReferenceSegment: ReferenceSegment{
+					Ref: ReferenceInfo{
+						Name: gitReferenceNamePrefixBranch + pr.SourceBranch,
+						Repo: sourceRepoInfo,
+					},
+				},
+				PullReqReviewSegment: PullReqReviewSegment{
+					ReviewDecision: event.Payload.Decision,
+					ReviewerID:     event.Payload.ReviewerID,
+				},
+			}, nil
+		})
+}
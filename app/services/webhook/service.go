@@ -22,6 +22,7 @@ import (
 	"time"
 
 	gitevents "github.com/harness/gitness/app/events/git"
+	pipelineevents "github.com/harness/gitness/app/events/pipeline"
 	pullreqevents "github.com/harness/gitness/app/events/pullreq"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/app/url"
@@ -81,6 +82,8 @@ type Service struct {
 	urlProvider           url.Provider
 	repoStore             store.RepoStore
 	pullreqStore          store.PullReqStore
+	pipelineStore         store.PipelineStore
+	executionStore        store.ExecutionStore
 	principalStore        store.PrincipalStore
 	git                   git.Interface
 	activityStore         store.PullReqActivityStore
@@ -100,10 +103,13 @@ func NewService(
 	config Config,
 	gitReaderFactory *events.ReaderFactory[*gitevents.Reader],
 	prReaderFactory *events.ReaderFactory[*pullreqevents.Reader],
+	pipelineReaderFactory *events.ReaderFactory[*pipelineevents.Reader],
 	webhookStore store.WebhookStore,
 	webhookExecutionStore store.WebhookExecutionStore,
 	repoStore store.RepoStore,
 	pullreqStore store.PullReqStore,
+	pipelineStore store.PipelineStore,
+	executionStore store.ExecutionStore,
 	activityStore store.PullReqActivityStore,
 	urlProvider url.Provider,
 	principalStore store.PrincipalStore,
@@ -118,6 +124,8 @@ func NewService(
 		webhookExecutionStore: webhookExecutionStore,
 		repoStore:             repoStore,
 		pullreqStore:          pullreqStore,
+		pipelineStore:         pipelineStore,
+		executionStore:        executionStore,
 		activityStore:         activityStore,
 		urlProvider:           urlProvider,
 		principalStore:        principalStore,
@@ -176,6 +184,7 @@ func NewService(
 			_ = r.RegisterCommentCreated(service.handleEventPullReqComment)
 			_ = r.RegisterMerged(service.handleEventPullReqMerged)
 			_ = r.RegisterUpdated(service.handleEventPullReqUpdated)
+			_ = r.RegisterReviewSubmitted(service.handleEventPullReqReviewSubmitted)
 
 			return nil
 		})
@@ -183,5 +192,24 @@ func NewService(
 		return nil, fmt.Errorf("failed to launch pr event reader for webhooks: %w", err)
 	}
 
+	_, err = pipelineReaderFactory.Launch(ctx, eventsReaderGroupName, config.EventReaderName,
+		func(r *pipelineevents.Reader) error {
+			const idleTimeout = 1 * time.Minute
+			r.Configure(
+				stream.WithConcurrency(config.Concurrency),
+				stream.WithHandlerOptions(
+					stream.WithIdleTimeout(idleTimeout),
+					stream.WithMaxRetries(config.MaxRetries),
+				))
+
+			// register events
+			_ = r.RegisterExecuted(service.handleEventPipelineExecuted)
+
+			return nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to launch pipeline event reader for webhooks: %w", err)
+	}
+
 	return service, nil
 }
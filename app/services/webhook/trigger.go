@@ -19,6 +19,7 @@ import (
 	"context"
 	"crypto/hmac"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -44,6 +45,9 @@ const (
 	// responseHeadersBytesLimit defines the maximum number of bytes processed from the webhook response headers.
 	responseHeadersBytesLimit = 1024
 
+	// redactedHeaderValue is stored instead of the value of user provided headers.
+	redactedHeaderValue = "******"
+
 	// responseBodyBytesLimit defines the maximum number of bytes processed from the webhook response body.
 	responseBodyBytesLimit = 1024
 )
@@ -332,15 +336,24 @@ func (s *Service) prepareHTTPRequest(ctx context.Context, execution *types.Webho
 		return nil, tErr
 	}
 
+	// setup user provided headers first to ensure they can't overwrite any of the system headers
+	for _, header := range webhook.ExtraHeaders {
+		value, err := s.decryptExtraHeader(header.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt webhook extra header %q: %w", header.Key, err)
+		}
+		req.Header.Add(header.Key, value)
+	}
+
 	// setup headers
-	req.Header.Add("User-Agent", fmt.Sprintf("%s/%s", s.config.UserAgentIdentity, version.Version))
-	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add(s.toXHeader("Trigger"), string(triggerType))
-	req.Header.Add(s.toXHeader("Webhook-Parent-Type"), string(webhook.ParentType))
-	req.Header.Add(s.toXHeader("Webhook-Parent-Id"), fmt.Sprint(webhook.ParentID))
+	req.Header.Set("User-Agent", fmt.Sprintf("%s/%s", s.config.UserAgentIdentity, version.Version))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(s.toXHeader("Trigger"), string(triggerType))
+	req.Header.Set(s.toXHeader("Webhook-Parent-Type"), string(webhook.ParentType))
+	req.Header.Set(s.toXHeader("Webhook-Parent-Id"), fmt.Sprint(webhook.ParentID))
 	// TODO [CODE-1363]: remove after identifier migration.
-	req.Header.Add(s.toXHeader("Webhook-Uid"), fmt.Sprint(webhook.Identifier))
-	req.Header.Add(s.toXHeader("Webhook-Identifier"), fmt.Sprint(webhook.Identifier))
+	req.Header.Set(s.toXHeader("Webhook-Uid"), fmt.Sprint(webhook.Identifier))
+	req.Header.Set(s.toXHeader("Webhook-Identifier"), fmt.Sprint(webhook.Identifier))
 
 	// add HMAC only if a secret was provided
 	if webhook.Secret != "" {
@@ -353,11 +366,19 @@ func (s *Service) prepareHTTPRequest(ctx context.Context, execution *types.Webho
 		if err != nil {
 			return nil, fmt.Errorf("failed to generate SHA256 based HMAC: %w", err)
 		}
-		req.Header.Add(s.toXHeader("Signature"), hmac)
+		req.Header.Set(s.toXHeader("Signature"), hmac)
+	}
+
+	// values of user provided headers might contain credentials - don't store them as part of the execution.
+	hLog := req.Header.Clone()
+	for _, header := range webhook.ExtraHeaders {
+		if _, ok := hLog[http.CanonicalHeaderKey(header.Key)]; ok {
+			hLog.Set(header.Key, redactedHeaderValue)
+		}
 	}
 
 	hBuffer := &bytes.Buffer{}
-	err = req.Header.Write(hBuffer)
+	err = hLog.Write(hBuffer)
 	if err != nil {
 		tErr := fmt.Errorf("failed to write request headers: %w", err)
 		execution.Error = tErr.Error()
@@ -369,6 +390,20 @@ func (s *Service) prepareHTTPRequest(ctx context.Context, execution *types.Webho
 	return req, nil
 }
 
+// decryptExtraHeader returns the value of an extra header, which is stored encrypted and base64 encoded.
+func (s *Service) decryptExtraHeader(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	encrypted, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode value: %w", err)
+	}
+
+	return s.encrypter.Decrypt(encrypted)
+}
+
 func (s *Service) toXHeader(name string) string {
 	return fmt.Sprintf("X-%s-%s", s.config.HeaderIdentity, name)
 }
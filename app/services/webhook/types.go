@@ -90,6 +90,18 @@ type PullReqUpdateSegment struct {
 	DescriptionNew     string `json:"description_new"`
 }
 
+// PullReqReviewSegment contains details for all pull req review related payloads for webhooks.
+type PullReqReviewSegment struct {
+	ReviewDecision enum.PullReqReviewDecision `json:"review_decision"`
+	ReviewerID     int64                      `json:"reviewer_id"`
+}
+
+// PipelineExecutionSegment contains details for all pipeline execution related payloads for webhooks.
+type PipelineExecutionSegment struct {
+	Pipeline  PipelineInfo  `json:"pipeline"`
+	Execution ExecutionInfo `json:"execution"`
+}
+
 // RepositoryInfo describes the repo related info for a webhook payload.
 // NOTE: don't use types package as we want webhook payload to be independent from API calls.
 type RepositoryInfo struct {
@@ -281,6 +293,84 @@ func identityInfoFrom(identity git.Identity) IdentityInfo {
 	}
 }
 
+// PipelineInfo describes the pipeline related info for a webhook payload.
+// NOTE: don't use types package as we want webhook payload to be independent from API calls.
+type PipelineInfo struct {
+	ID            int64  `json:"id"`
+	Identifier    string `json:"identifier"`
+	Description   string `json:"description"`
+	DefaultBranch string `json:"default_branch"`
+	ConfigPath    string `json:"config_path"`
+}
+
+// pipelineInfoFrom gets the PipelineInfo from a types.Pipeline.
+func pipelineInfoFrom(pipeline *types.Pipeline) PipelineInfo {
+	return PipelineInfo{
+		ID:            pipeline.ID,
+		Identifier:    pipeline.Identifier,
+		Description:   pipeline.Description,
+		DefaultBranch: pipeline.DefaultBranch,
+		ConfigPath:    pipeline.ConfigPath,
+	}
+}
+
+// ExecutionInfo describes the pipeline execution related info for a webhook payload.
+// NOTE: don't use types package as we want webhook payload to be independent from API calls.
+type ExecutionInfo struct {
+	Number      int64              `json:"number"`
+	Status      enum.CIStatus      `json:"status"`
+	Error       string             `json:"error,omitempty"`
+	Event       enum.TriggerEvent  `json:"event"`
+	Action      enum.TriggerAction `json:"action,omitempty"`
+	Trigger     string             `json:"trigger"`
+	Ref         string             `json:"ref"`
+	Source      string             `json:"source,omitempty"`
+	Target      string             `json:"target,omitempty"`
+	BeforeSHA   string             `json:"before_sha,omitempty"`
+	SHA         string             `json:"sha"`
+	Title       string             `json:"title,omitempty"`
+	Message     string             `json:"message,omitempty"`
+	AuthorLogin string             `json:"author_login,omitempty"`
+	AuthorName  string             `json:"author_name,omitempty"`
+	AuthorEmail string             `json:"author_email,omitempty"`
+	Created     int64              `json:"created"`
+	Started     int64              `json:"started"`
+	Finished    int64              `json:"finished"`
+	URL         string             `json:"url"`
+}
+
+// executionInfoFrom gets the ExecutionInfo from a types.Execution.
+func executionInfoFrom(
+	ctx context.Context,
+	execution *types.Execution,
+	pipeline *types.Pipeline,
+	repo *types.Repository,
+	urlProvider url.Provider,
+) ExecutionInfo {
+	return ExecutionInfo{
+		Number:      execution.Number,
+		Status:      execution.Status,
+		Error:       execution.Error,
+		Event:       execution.Event,
+		Action:      execution.Action,
+		Trigger:     execution.Trigger,
+		Ref:         execution.Ref,
+		Source:      execution.Source,
+		Target:      execution.Target,
+		BeforeSHA:   execution.Before,
+		SHA:         execution.After,
+		Title:       execution.Title,
+		Message:     execution.Message,
+		AuthorLogin: execution.Author,
+		AuthorName:  execution.AuthorName,
+		AuthorEmail: execution.AuthorEmail,
+		Created:     execution.Created,
+		Started:     execution.Started,
+		Finished:    execution.Finished,
+		URL:         urlProvider.GenerateUIBuildURL(ctx, repo.Path, pipeline.Identifier, execution.Number),
+	}
+}
+
 // ReferenceInfo describes a unique reference in Harness.
 // It contains both the reference name as well as the repo the reference belongs to.
 type ReferenceInfo struct {
@@ -18,6 +18,7 @@ import (
 	"context"
 
 	gitevents "github.com/harness/gitness/app/events/git"
+	pipelineevents "github.com/harness/gitness/app/events/pipeline"
 	pullreqevents "github.com/harness/gitness/app/events/pullreq"
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/app/url"
@@ -37,17 +38,20 @@ func ProvideService(ctx context.Context,
 	config Config,
 	gitReaderFactory *events.ReaderFactory[*gitevents.Reader],
 	prReaderFactory *events.ReaderFactory[*pullreqevents.Reader],
+	pipelineReaderFactory *events.ReaderFactory[*pipelineevents.Reader],
 	webhookStore store.WebhookStore,
 	webhookExecutionStore store.WebhookExecutionStore,
 	repoStore store.RepoStore,
 	pullreqStore store.PullReqStore,
+	pipelineStore store.PipelineStore,
+	executionStore store.ExecutionStore,
 	activityStore store.PullReqActivityStore,
 	urlProvider url.Provider,
 	principalStore store.PrincipalStore,
 	git git.Interface,
 	encrypter encrypt.Encrypter,
 ) (*Service, error) {
-	return NewService(ctx, config, gitReaderFactory, prReaderFactory,
-		webhookStore, webhookExecutionStore, repoStore, pullreqStore, activityStore,
+	return NewService(ctx, config, gitReaderFactory, prReaderFactory, pipelineReaderFactory,
+		webhookStore, webhookExecutionStore, repoStore, pullreqStore, pipelineStore, executionStore, activityStore,
 		urlProvider, principalStore, git, encrypter)
 }
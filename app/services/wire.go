@@ -22,10 +22,12 @@ import (
 	"github.com/harness/gitness/app/services/infraprovider"
 	"github.com/harness/gitness/app/services/instrument"
 	"github.com/harness/gitness/app/services/keywordsearch"
+	"github.com/harness/gitness/app/services/mergequeue"
 	"github.com/harness/gitness/app/services/metric"
 	"github.com/harness/gitness/app/services/notification"
 	"github.com/harness/gitness/app/services/pullreq"
 	"github.com/harness/gitness/app/services/repo"
+	"github.com/harness/gitness/app/services/reviewanalytics"
 	"github.com/harness/gitness/app/services/trigger"
 	"github.com/harness/gitness/app/services/webhook"
 	"github.com/harness/gitness/job"
@@ -46,7 +48,11 @@ type Services struct {
 	RepoSizeCalculator    *repo.SizeCalculator
 	Repo                  *repo.Service
 	Cleanup               *cleanup.Service
+	MergeQueue            *mergequeue.Service
 	Notification          *notification.Service
+	ReviewerReminder      *notification.ReviewerReminder
+	PipelineNotifier      *notification.PipelineNotifier
+	ReviewAnalytics       *reviewanalytics.Service
 	Keywordsearch         *keywordsearch.Service
 	GitspaceService       *GitspaceServices
 	Instrumentation       instrument.Service
@@ -84,7 +90,11 @@ func ProvideServices(
 	repoSizeCalculator *repo.SizeCalculator,
 	repo *repo.Service,
 	cleanupSvc *cleanup.Service,
+	mergeQueueSvc *mergequeue.Service,
 	notificationSvc *notification.Service,
+	reviewerReminder *notification.ReviewerReminder,
+	pipelineNotifier *notification.PipelineNotifier,
+	reviewAnalyticsSvc *reviewanalytics.Service,
 	keywordsearchSvc *keywordsearch.Service,
 	gitspaceSvc *GitspaceServices,
 	instrumentation instrument.Service,
@@ -100,7 +110,11 @@ func ProvideServices(
 		RepoSizeCalculator:    repoSizeCalculator,
 		Repo:                  repo,
 		Cleanup:               cleanupSvc,
+		MergeQueue:            mergeQueueSvc,
 		Notification:          notificationSvc,
+		ReviewerReminder:      reviewerReminder,
+		PipelineNotifier:      pipelineNotifier,
+		ReviewAnalytics:       reviewAnalyticsSvc,
 		Keywordsearch:         keywordsearchSvc,
 		GitspaceService:       gitspaceSvc,
 		Instrumentation:       instrumentation,
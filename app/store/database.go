@@ -407,6 +407,69 @@ type (
 		Stream(ctx context.Context, opts *types.PullReqFilter) (<-chan *types.PullReq, <-chan error)
 	}
 
+	// PullReqEditStore defines the pull request edit history data storage.
+	PullReqEditStore interface {
+		// Create records a prior version of the pull request's title and description.
+		Create(ctx context.Context, edit *types.PullReqEdit) error
+
+		// List returns the edit history of the pull request, the latest edit first.
+		List(ctx context.Context, pullReqID int64) ([]*types.PullReqEdit, error)
+	}
+
+	// NotificationSettingsStore defines the storage of principals' notification preferences.
+	NotificationSettingsStore interface {
+		// Find returns the notification settings of the principal.
+		Find(ctx context.Context, principalID int64) (*types.NotificationSettings, error)
+
+		// Upsert creates or replaces the notification settings of the principal.
+		Upsert(ctx context.Context, settings *types.NotificationSettings) error
+	}
+
+	// PullReqDependencyStore defines the storage of dependencies between pull requests.
+	PullReqDependencyStore interface {
+		// Create records that a pull request depends on another pull request.
+		Create(ctx context.Context, dependency *types.PullReqDependency) error
+
+		// Delete removes the dependency of a pull request on another pull request.
+		Delete(ctx context.Context, pullReqID, dependsOnID int64) error
+
+		// ListDependsOnIDs returns IDs of the pull requests the pull request directly depends on.
+		ListDependsOnIDs(ctx context.Context, pullReqID int64) ([]int64, error)
+
+		// ListDependentIDs returns IDs of the pull requests that directly depend on the pull request.
+		ListDependentIDs(ctx context.Context, pullReqID int64) ([]int64, error)
+	}
+
+	// PullReqFileStatsStore defines the storage of cached per-file pull request diff statistics.
+	PullReqFileStatsStore interface {
+		// Find returns the cached file statistics of the pull request.
+		Find(ctx context.Context, pullReqID int64) (*types.PullReqFileStats, error)
+
+		// Upsert creates or replaces the cached file statistics of the pull request.
+		Upsert(ctx context.Context, stats *types.PullReqFileStats) error
+	}
+
+	// PullReqMentionStore defines the storage of principals mentioned in pull request descriptions.
+	PullReqMentionStore interface {
+		// Create records the mention of a principal. Recording an existing mention is a no-op.
+		Create(ctx context.Context, mention *types.PullReqMention) error
+
+		// Delete removes the mention of a principal.
+		Delete(ctx context.Context, pullReqID, principalID int64) error
+
+		// List returns all principals mentioned in the pull request description.
+		List(ctx context.Context, pullReqID int64) ([]*types.PullReqMention, error)
+	}
+
+	// PullReqCommentRevisionStore defines the pull request comment revision history data storage.
+	PullReqCommentRevisionStore interface {
+		// Create records a prior version of a pull request comment.
+		Create(ctx context.Context, rev *types.PullReqCommentRevision) error
+
+		// List returns the revision history of the pull request comment, the latest revision first.
+		List(ctx context.Context, activityID int64) ([]*types.PullReqCommentRevision, error)
+	}
+
 	PullReqActivityStore interface {
 		// Find the pull request activity by id.
 		Find(ctx context.Context, id int64) (*types.PullReqActivity, error)
@@ -440,6 +503,12 @@ type (
 		// CountUnresolved returns number of unresolved comments.
 		CountUnresolved(ctx context.Context, prID int64) (int, error)
 
+		// ListPending returns all pending pull request activities of a principal.
+		ListPending(ctx context.Context, prID int64, principalID int64) ([]*types.PullReqActivity, error)
+
+		// PublishPending clears the pending flag of all pending pull request activities of a principal.
+		PublishPending(ctx context.Context, prID int64, principalID int64) error
+
 		// List returns a list of pull request activities in a pull request (a timeline).
 		List(ctx context.Context, prID int64, opts *types.PullReqActivityFilter) ([]*types.PullReqActivity, error)
 
@@ -472,6 +541,24 @@ type (
 		Create(ctx context.Context, v *types.PullReqReview) error
 	}
 
+	// PullReqReviewerReminderStore defines the storage of reminders sent to reviewers of pull requests.
+	PullReqReviewerReminderStore interface {
+		// ListDue returns the reviewers of open pull requests whose review is pending since before pendingBefore
+		// and who haven't been reminded about it since remindedBefore. Reviewers who opted out of reminders
+		// and reviewers whose reminder is postponed past now are excluded.
+		ListDue(
+			ctx context.Context,
+			pendingBefore, remindedBefore, now int64,
+			limit int,
+		) ([]*types.PullReqReviewer, error)
+
+		// Upsert records the time the last reminder was sent to the reviewer of the pull request.
+		Upsert(ctx context.Context, pullReqID, principalID, sent int64) error
+
+		// Postpone records that the reviewer of the pull request shouldn't be reminded before the provided time.
+		Postpone(ctx context.Context, pullReqID, principalID, next int64) error
+	}
+
 	PullReqReviewerStore interface {
 		// Find returns the pull request reviewer or an error if it doesn't exist.
 		Find(ctx context.Context, prID, principalID int64) (*types.PullReqReviewer, error)
@@ -516,6 +603,42 @@ type (
 		List(ctx context.Context, prID int64, principalID int64) ([]*types.PullReqFileView, error)
 	}
 
+	// PullReqReactionStore stores reactions to pull request comments and descriptions.
+	PullReqReactionStore interface {
+		// Create adds the reaction. Adding an already existing reaction is a no-op.
+		Create(ctx context.Context, reaction *types.PullReqReaction) error
+
+		// Delete removes the reaction of the principal from the pull request comment or description.
+		Delete(ctx context.Context, prID, activityID, principalID int64, reaction enum.PullReqReaction) error
+
+		// List lists all reactions to the pull request description and all its comments.
+		List(ctx context.Context, prID int64) ([]*types.PullReqReaction, error)
+	}
+
+	// MergeQueueStore defines database interface for merge queue entries.
+	MergeQueueStore interface {
+		// Find finds the merge queue entry by ID.
+		Find(ctx context.Context, id int64) (*types.MergeQueueEntry, error)
+
+		// FindByPullReqID finds the merge queue entry of the pull request.
+		FindByPullReqID(ctx context.Context, prID int64) (*types.MergeQueueEntry, error)
+
+		// Create adds the pull request to the merge queue.
+		Create(ctx context.Context, entry *types.MergeQueueEntry) error
+
+		// Update updates the state and the speculative merge data of the merge queue entry.
+		Update(ctx context.Context, entry *types.MergeQueueEntry) error
+
+		// Delete removes the merge queue entry.
+		Delete(ctx context.Context, id int64) error
+
+		// List returns all entries of the merge queue of the target branch in the order they were added.
+		List(ctx context.Context, repoID int64, targetBranch string) ([]*types.MergeQueueEntry, error)
+
+		// ListQueues returns all merge queues that have at least one entry.
+		ListQueues(ctx context.Context) ([]types.MergeQueueKey, error)
+	}
+
 	// RuleStore defines database interface for protection rules.
 	RuleStore interface {
 		// Find finds a protection rule by ID.
@@ -829,6 +952,13 @@ type (
 		// FindByNumber returns a execution given a pipeline and an execution number
 		FindByNumber(ctx context.Context, pipelineID int64, num int64) (*types.Execution, error)
 
+		// FindLatestByRef returns the latest completed execution of a pipeline for the given git reference.
+		FindLatestByRef(ctx context.Context, pipelineID int64, ref string) (*types.Execution, error)
+
+		// FindPreviousByRef returns the latest completed execution of a pipeline for the given git reference
+		// that precedes the execution with the provided number.
+		FindPreviousByRef(ctx context.Context, pipelineID int64, ref string, num int64) (*types.Execution, error)
+
 		// Create creates a new execution in the datastore.
 		Create(ctx context.Context, execution *types.Execution) error
 
@@ -1062,6 +1192,53 @@ type (
 		ListByFingerprint(ctx context.Context, fingerprint string) ([]types.PublicKey, error)
 	}
 
+	SavedReplyStore interface {
+		// FindByIdentifier returns a saved reply given a principal ID and an identifier.
+		FindByIdentifier(ctx context.Context, principalID int64, identifier string) (*types.SavedReply, error)
+
+		// Create creates a new saved reply.
+		Create(ctx context.Context, savedReply *types.SavedReply) error
+
+		// Update updates the identifier, the title and the content of a saved reply of the principal.
+		Update(ctx context.Context, savedReply *types.SavedReply) error
+
+		// DeleteByIdentifier deletes a saved reply.
+		DeleteByIdentifier(ctx context.Context, principalID int64, identifier string) error
+
+		// Count returns the number of saved replies for the principal that match the provided filter.
+		Count(ctx context.Context, principalID int64, filter *types.ListQueryFilter) (int, error)
+
+		// List returns the saved replies for the principal that match the provided filter.
+		List(ctx context.Context, principalID int64, filter *types.ListQueryFilter) ([]types.SavedReply, error)
+	}
+
+	// SlackMessageStore defines the storage of the slack messages posted for started pipeline executions.
+	SlackMessageStore interface {
+		// Find returns the slack message of the execution.
+		Find(ctx context.Context, executionID int64) (*types.SlackMessage, error)
+
+		// Upsert creates or replaces the slack message of the execution.
+		Upsert(ctx context.Context, msg *types.SlackMessage) error
+
+		// Delete deletes the slack message of the execution.
+		Delete(ctx context.Context, executionID int64) error
+
+		// DeleteBefore deletes the slack messages created before the provided time.
+		DeleteBefore(ctx context.Context, before int64) error
+	}
+
+	ReviewRollupStore interface {
+		// Refresh recalculates the review rollups of all days starting with the day of the provided time.
+		// The old rollups are deleted first, so the caller should run it in a transaction.
+		Refresh(ctx context.Context, since int64) error
+
+		// IsEmpty returns true if no review rollups have been calculated yet.
+		IsEmpty(ctx context.Context) (bool, error)
+
+		// Aggregate returns the review rollups matching the filter, summed up over repositories.
+		Aggregate(ctx context.Context, filter *types.ReviewRollupFilter) ([]types.ReviewRollup, error)
+	}
+
 	GitspaceEventStore interface {
 		// Create creates a new record for the given gitspace event.
 		Create(ctx context.Context, gitspaceEvent *types.GitspaceEvent) error
@@ -160,6 +160,52 @@ func (s *executionStore) FindByNumber(
 	return mapInternalToExecution(dst)
 }
 
+// FindLatestByRef returns the latest completed execution of a pipeline for the given git reference.
+func (s *executionStore) FindLatestByRef(
+	ctx context.Context,
+	pipelineID int64,
+	ref string,
+) (*types.Execution, error) {
+	const findQueryStmt = `
+	SELECT` + executionColumns + `
+	FROM executions
+	WHERE execution_pipeline_id = $1 AND execution_ref = $2
+		AND execution_status IN ('success', 'failure', 'error', 'killed')
+	ORDER BY execution_number DESC
+	LIMIT 1`
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(execution)
+	if err := db.GetContext(ctx, dst, findQueryStmt, pipelineID, ref); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to find latest execution")
+	}
+	return mapInternalToExecution(dst)
+}
+
+// FindPreviousByRef returns the latest completed execution of a pipeline for the given git reference
+// that precedes the execution with the provided number.
+func (s *executionStore) FindPreviousByRef(
+	ctx context.Context,
+	pipelineID int64,
+	ref string,
+	num int64,
+) (*types.Execution, error) {
+	const findQueryStmt = `
+	SELECT` + executionColumns + `
+	FROM executions
+	WHERE execution_pipeline_id = $1 AND execution_ref = $2 AND execution_number < $3
+		AND execution_status IN ('success', 'failure', 'error', 'killed')
+	ORDER BY execution_number DESC
+	LIMIT 1`
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := new(execution)
+	if err := db.GetContext(ctx, dst, findQueryStmt, pipelineID, ref, num); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to find previous execution")
+	}
+	return mapInternalToExecution(dst)
+}
+
 // Create creates a new execution in the datastore.
 func (s *executionStore) Create(ctx context.Context, execution *types.Execution) error {
 	const executionInsertStmt = `
@@ -0,0 +1,214 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/harness/gitness/app/store/database"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+)
+
+func TestExecutionStore_FindPreviousByRef(t *testing.T) {
+	db, teardown := setupDB(t)
+	defer teardown()
+
+	principalStore, spaceStore, spacePathStore, repoStore := setupStores(t, db)
+	pipelineStore := database.NewPipelineStore(db)
+	executionStore := database.NewExecutionStore(db)
+
+	ctx := context.Background()
+
+	createUser(ctx, t, principalStore)
+	createSpace(ctx, t, spaceStore, spacePathStore, userID, 1, 0)
+	createRepo(ctx, t, repoStore, 1, 1, 0)
+
+	pipeline := &types.Pipeline{Identifier: "build", RepoID: 1, CreatedBy: userID, ConfigPath: ".harness/build.yaml"}
+	if err := pipelineStore.Create(ctx, pipeline); err != nil {
+		t.Fatalf("failed to create pipeline %v", err)
+	}
+
+	executions := []struct {
+		ref    string
+		status enum.CIStatus
+	}{
+		{ref: "refs/heads/main", status: enum.CIStatusSuccess},
+		{ref: "refs/heads/main", status: enum.CIStatusFailure},
+		{ref: "refs/heads/dev", status: enum.CIStatusSuccess},
+		{ref: "refs/heads/main", status: enum.CIStatusRunning},
+		{ref: "refs/heads/main", status: enum.CIStatusPending},
+		{ref: "refs/heads/main", status: enum.CIStatusSuccess},
+	}
+	for i, e := range executions {
+		execution := &types.Execution{
+			PipelineID: pipeline.ID,
+			RepoID:     1,
+			CreatedBy:  userID,
+			Number:     int64(i + 1),
+			Ref:        e.ref,
+			Status:     e.status,
+		}
+		if err := executionStore.Create(ctx, execution); err != nil {
+			t.Fatalf("failed to create execution %v", err)
+		}
+	}
+
+	tests := []struct {
+		name       string
+		ref        string
+		num        int64
+		wantNumber int64
+	}{
+		{
+			name:       "skips running and pending executions",
+			ref:        "refs/heads/main",
+			num:        6,
+			wantNumber: 2,
+		},
+		{
+			name:       "skips executions of other refs",
+			ref:        "refs/heads/main",
+			num:        3,
+			wantNumber: 2,
+		},
+		{
+			name:       "ignores later executions",
+			ref:        "refs/heads/main",
+			num:        2,
+			wantNumber: 1,
+		},
+		{
+			name: "first execution of the ref",
+			ref:  "refs/heads/main",
+			num:  1,
+		},
+		{
+			name: "unknown ref",
+			ref:  "refs/heads/feature",
+			num:  7,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			previous, err := executionStore.FindPreviousByRef(ctx, pipeline.ID, test.ref, test.num)
+			if test.wantNumber == 0 {
+				if !errors.Is(err, gitness_store.ErrResourceNotFound) {
+					t.Fatalf("err = %v, want %v", err, gitness_store.ErrResourceNotFound)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to find previous execution %v", err)
+			}
+			if previous.Number != test.wantNumber {
+				t.Errorf("number = %d, want %d", previous.Number, test.wantNumber)
+			}
+		})
+	}
+}
+
+func TestExecutionStore_FindLatestByRef(t *testing.T) {
+	db, teardown := setupDB(t)
+	defer teardown()
+
+	principalStore, spaceStore, spacePathStore, repoStore := setupStores(t, db)
+	pipelineStore := database.NewPipelineStore(db)
+	executionStore := database.NewExecutionStore(db)
+
+	ctx := context.Background()
+
+	createUser(ctx, t, principalStore)
+	createSpace(ctx, t, spaceStore, spacePathStore, userID, 1, 0)
+	createRepo(ctx, t, repoStore, 1, 1, 0)
+
+	pipeline := &types.Pipeline{Identifier: "build", RepoID: 1, CreatedBy: userID, ConfigPath: ".harness/build.yaml"}
+	if err := pipelineStore.Create(ctx, pipeline); err != nil {
+		t.Fatalf("failed to create pipeline %v", err)
+	}
+
+	executions := []struct {
+		ref    string
+		status enum.CIStatus
+	}{
+		{ref: "refs/heads/main", status: enum.CIStatusSuccess},
+		{ref: "refs/heads/main", status: enum.CIStatusFailure},
+		{ref: "refs/heads/dev", status: enum.CIStatusSuccess},
+		{ref: "refs/heads/dev", status: enum.CIStatusRunning},
+		{ref: "refs/heads/main", status: enum.CIStatusRunning},
+		{ref: "refs/heads/main", status: enum.CIStatusPending},
+		{ref: "refs/heads/wip", status: enum.CIStatusPending},
+	}
+	for i, e := range executions {
+		execution := &types.Execution{
+			PipelineID: pipeline.ID,
+			RepoID:     1,
+			CreatedBy:  userID,
+			Number:     int64(i + 1),
+			Ref:        e.ref,
+			Status:     e.status,
+		}
+		if err := executionStore.Create(ctx, execution); err != nil {
+			t.Fatalf("failed to create execution %v", err)
+		}
+	}
+
+	tests := []struct {
+		name       string
+		ref        string
+		wantNumber int64
+	}{
+		{
+			name:       "skips running and pending executions",
+			ref:        "refs/heads/main",
+			wantNumber: 2,
+		},
+		{
+			name:       "skips executions of other refs",
+			ref:        "refs/heads/dev",
+			wantNumber: 3,
+		},
+		{
+			name: "no completed execution of the ref",
+			ref:  "refs/heads/wip",
+		},
+		{
+			name: "unknown ref",
+			ref:  "refs/heads/feature",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			latest, err := executionStore.FindLatestByRef(ctx, pipeline.ID, test.ref)
+			if test.wantNumber == 0 {
+				if !errors.Is(err, gitness_store.ErrResourceNotFound) {
+					t.Fatalf("err = %v, want %v", err, gitness_store.ErrResourceNotFound)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to find latest execution %v", err)
+			}
+			if latest.Number != test.wantNumber {
+				t.Errorf("number = %d, want %d", latest.Number, test.wantNumber)
+			}
+		})
+	}
+}
@@ -0,0 +1,284 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.MergeQueueStore = (*MergeQueueStore)(nil)
+
+// NewMergeQueueStore returns a new MergeQueueStore.
+func NewMergeQueueStore(db *sqlx.DB) *MergeQueueStore {
+	return &MergeQueueStore{
+		db: db,
+	}
+}
+
+// MergeQueueStore implements store.MergeQueueStore backed by a relational database.
+type MergeQueueStore struct {
+	db *sqlx.DB
+}
+
+// mergeQueueEntry is used to fetch merge queue entry data from the database.
+type mergeQueueEntry struct {
+	ID            int64                     `db:"merge_queue_entry_id"`
+	RepoID        int64                     `db:"merge_queue_entry_repo_id"`
+	PullReqID     int64                     `db:"merge_queue_entry_pullreq_id"`
+	PullReqNumber int64                     `db:"merge_queue_entry_pullreq_number"`
+	TargetBranch  string                    `db:"merge_queue_entry_target_branch"`
+	State         enum.MergeQueueEntryState `db:"merge_queue_entry_state"`
+	Method        enum.MergeMethod          `db:"merge_queue_entry_method"`
+	SourceSHA     string                    `db:"merge_queue_entry_source_sha"`
+	BaseSHA       string                    `db:"merge_queue_entry_base_sha"`
+	MergeBaseSHA  string                    `db:"merge_queue_entry_merge_base_sha"`
+	MergeSHA      string                    `db:"merge_queue_entry_merge_sha"`
+	CreatedBy     int64                     `db:"merge_queue_entry_created_by"`
+	Created       int64                     `db:"merge_queue_entry_created"`
+	Updated       int64                     `db:"merge_queue_entry_updated"`
+}
+
+const (
+	mergeQueueEntryColumns = `
+		 merge_queue_entry_id
+		,merge_queue_entry_repo_id
+		,merge_queue_entry_pullreq_id
+		,merge_queue_entry_pullreq_number
+		,merge_queue_entry_target_branch
+		,merge_queue_entry_state
+		,merge_queue_entry_method
+		,merge_queue_entry_source_sha
+		,merge_queue_entry_base_sha
+		,merge_queue_entry_merge_base_sha
+		,merge_queue_entry_merge_sha
+		,merge_queue_entry_created_by
+		,merge_queue_entry_created
+		,merge_queue_entry_updated`
+
+	mergeQueueEntrySelectBase = `
+	SELECT` + mergeQueueEntryColumns + `
+	FROM merge_queue_entries`
+)
+
+// Find finds the merge queue entry by ID.
+func (s *MergeQueueStore) Find(ctx context.Context, id int64) (*types.MergeQueueEntry, error) {
+	const sqlQuery = mergeQueueEntrySelectBase + `
+	WHERE merge_queue_entry_id = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := &mergeQueueEntry{}
+	if err := db.GetContext(ctx, dst, sqlQuery, id); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to find merge queue entry")
+	}
+
+	return mapMergeQueueEntry(dst), nil
+}
+
+// FindByPullReqID finds the merge queue entry of the pull request.
+func (s *MergeQueueStore) FindByPullReqID(ctx context.Context, prID int64) (*types.MergeQueueEntry, error) {
+	const sqlQuery = mergeQueueEntrySelectBase + `
+	WHERE merge_queue_entry_pullreq_id = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := &mergeQueueEntry{}
+	if err := db.GetContext(ctx, dst, sqlQuery, prID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to find merge queue entry by pull request ID")
+	}
+
+	return mapMergeQueueEntry(dst), nil
+}
+
+// Create adds the pull request to the merge queue.
+func (s *MergeQueueStore) Create(ctx context.Context, entry *types.MergeQueueEntry) error {
+	const sqlQuery = `
+	INSERT INTO merge_queue_entries (
+		 merge_queue_entry_repo_id
+		,merge_queue_entry_pullreq_id
+		,merge_queue_entry_pullreq_number
+		,merge_queue_entry_target_branch
+		,merge_queue_entry_state
+		,merge_queue_entry_method
+		,merge_queue_entry_source_sha
+		,merge_queue_entry_base_sha
+		,merge_queue_entry_merge_base_sha
+		,merge_queue_entry_merge_sha
+		,merge_queue_entry_created_by
+		,merge_queue_entry_created
+		,merge_queue_entry_updated
+	) values (
+		 :merge_queue_entry_repo_id
+		,:merge_queue_entry_pullreq_id
+		,:merge_queue_entry_pullreq_number
+		,:merge_queue_entry_target_branch
+		,:merge_queue_entry_state
+		,:merge_queue_entry_method
+		,:merge_queue_entry_source_sha
+		,:merge_queue_entry_base_sha
+		,:merge_queue_entry_merge_base_sha
+		,:merge_queue_entry_merge_sha
+		,:merge_queue_entry_created_by
+		,:merge_queue_entry_created
+		,:merge_queue_entry_updated
+	) RETURNING merge_queue_entry_id`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, args, err := db.BindNamed(sqlQuery, mapInternalMergeQueueEntry(entry))
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind merge queue entry object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, args...).Scan(&entry.ID); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to insert merge queue entry")
+	}
+
+	return nil
+}
+
+// Update updates the state and the speculative merge data of the merge queue entry.
+func (s *MergeQueueStore) Update(ctx context.Context, entry *types.MergeQueueEntry) error {
+	const sqlQuery = `
+	UPDATE merge_queue_entries
+	SET
+		 merge_queue_entry_state = :merge_queue_entry_state
+		,merge_queue_entry_base_sha = :merge_queue_entry_base_sha
+		,merge_queue_entry_merge_base_sha = :merge_queue_entry_merge_base_sha
+		,merge_queue_entry_merge_sha = :merge_queue_entry_merge_sha
+		,merge_queue_entry_updated = :merge_queue_entry_updated
+	WHERE merge_queue_entry_id = :merge_queue_entry_id`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dbEntry := mapInternalMergeQueueEntry(entry)
+	dbEntry.Updated = time.Now().UnixMilli()
+
+	query, args, err := db.BindNamed(sqlQuery, dbEntry)
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind merge queue entry object")
+	}
+
+	if _, err = db.ExecContext(ctx, query, args...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to update merge queue entry")
+	}
+
+	entry.Updated = dbEntry.Updated
+
+	return nil
+}
+
+// Delete removes the merge queue entry.
+func (s *MergeQueueStore) Delete(ctx context.Context, id int64) error {
+	const sqlQuery = `
+	DELETE FROM merge_queue_entries
+	WHERE merge_queue_entry_id = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, id); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to delete merge queue entry")
+	}
+
+	return nil
+}
+
+// List returns all entries of the merge queue of the target branch in the order they were added.
+func (s *MergeQueueStore) List(
+	ctx context.Context,
+	repoID int64,
+	targetBranch string,
+) ([]*types.MergeQueueEntry, error) {
+	const sqlQuery = mergeQueueEntrySelectBase + `
+	WHERE merge_queue_entry_repo_id = $1 AND merge_queue_entry_target_branch = $2
+	ORDER BY merge_queue_entry_id ASC`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := make([]*mergeQueueEntry, 0)
+	if err := db.SelectContext(ctx, &dst, sqlQuery, repoID, targetBranch); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list merge queue entries")
+	}
+
+	result := make([]*types.MergeQueueEntry, len(dst))
+	for i, entry := range dst {
+		result[i] = mapMergeQueueEntry(entry)
+	}
+
+	return result, nil
+}
+
+// ListQueues returns all merge queues that have at least one entry.
+func (s *MergeQueueStore) ListQueues(ctx context.Context) ([]types.MergeQueueKey, error) {
+	const sqlQuery = `
+	SELECT DISTINCT merge_queue_entry_repo_id, merge_queue_entry_target_branch
+	FROM merge_queue_entries`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := make([]types.MergeQueueKey, 0)
+	if err := db.SelectContext(ctx, &dst, sqlQuery); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list merge queues")
+	}
+
+	return dst, nil
+}
+
+func mapMergeQueueEntry(v *mergeQueueEntry) *types.MergeQueueEntry {
+	return &types.MergeQueueEntry{
+		ID:            v.ID,
+		RepoID:        v.RepoID,
+		PullReqID:     v.PullReqID,
+		PullReqNumber: v.PullReqNumber,
+		TargetBranch:  v.TargetBranch,
+		State:         v.State,
+		Method:        v.Method,
+		SourceSHA:     v.SourceSHA,
+		BaseSHA:       v.BaseSHA,
+		MergeBaseSHA:  v.MergeBaseSHA,
+		MergeSHA:      v.MergeSHA,
+		CreatedBy:     v.CreatedBy,
+		Created:       v.Created,
+		Updated:       v.Updated,
+	}
+}
+
+func mapInternalMergeQueueEntry(v *types.MergeQueueEntry) *mergeQueueEntry {
+	return &mergeQueueEntry{
+		ID:            v.ID,
+		RepoID:        v.RepoID,
+		PullReqID:     v.PullReqID,
+		PullReqNumber: v.PullReqNumber,
+		TargetBranch:  v.TargetBranch,
+		State:         v.State,
+		Method:        v.Method,
+		SourceSHA:     v.SourceSHA,
+		BaseSHA:       v.BaseSHA,
+		MergeBaseSHA:  v.MergeBaseSHA,
+		MergeSHA:      v.MergeSHA,
+		CreatedBy:     v.CreatedBy,
+		Created:       v.Created,
+		Updated:       v.Updated,
+	}
+}
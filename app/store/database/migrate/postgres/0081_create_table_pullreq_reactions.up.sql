@@ -0,0 +1,21 @@
+CREATE TABLE pullreq_reactions (
+ pullreq_reaction_pullreq_id INTEGER NOT NULL
+,pullreq_reaction_activity_id INTEGER NOT NULL
+,pullreq_reaction_principal_id INTEGER NOT NULL
+,pullreq_reaction_reaction TEXT NOT NULL
+,pullreq_reaction_created BIGINT NOT NULL
+
+-- activity ID is zero for reactions to the pull request description.
+-- every principal can react with each reaction at most once.
+-- this index is also used for quick lookup of all reactions of a given pr.
+,CONSTRAINT pk_pullreq_reactions PRIMARY KEY (pullreq_reaction_pullreq_id, pullreq_reaction_activity_id, pullreq_reaction_principal_id, pullreq_reaction_reaction)
+
+,CONSTRAINT fk_pullreq_reaction_pullreq_id FOREIGN KEY (pullreq_reaction_pullreq_id)
+    REFERENCES pullreqs (pullreq_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+,CONSTRAINT fk_pullreq_reaction_principal_id FOREIGN KEY (pullreq_reaction_principal_id)
+    REFERENCES principals (principal_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+ALTER TABLE pullreqs
+    DROP COLUMN pullreq_template;
@@ -0,0 +1,2 @@
+ALTER TABLE pullreqs
+    ADD COLUMN pullreq_template TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,4 @@
+DROP INDEX pullreq_activities_pullreq_id_created_by_pending;
+
+ALTER TABLE pullreq_activities
+    DROP COLUMN pullreq_activity_pending;
@@ -0,0 +1,3 @@
+DROP INDEX pullreq_activities_search;
+
+DROP INDEX pullreqs_search;
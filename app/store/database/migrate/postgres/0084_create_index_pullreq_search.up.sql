@@ -0,0 +1,5 @@
+CREATE INDEX pullreqs_search
+    ON pullreqs USING GIN (to_tsvector('simple', pullreq_title || ' ' || pullreq_description));
+
+CREATE INDEX pullreq_activities_search
+    ON pullreq_activities USING GIN (to_tsvector('simple', pullreq_activity_text));
@@ -0,0 +1,3 @@
+ALTER TABLE pullreqs DROP COLUMN pullreq_content_edited;
+
+DROP TABLE pullreq_edits;
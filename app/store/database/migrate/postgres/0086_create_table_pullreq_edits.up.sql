@@ -0,0 +1,22 @@
+CREATE TABLE pullreq_edits (
+ pullreq_edit_id SERIAL PRIMARY KEY
+,pullreq_edit_pullreq_id INTEGER NOT NULL
+,pullreq_edit_created_by INTEGER NOT NULL
+,pullreq_edit_created BIGINT NOT NULL
+,pullreq_edit_title TEXT NOT NULL
+,pullreq_edit_description TEXT NOT NULL
+
+,CONSTRAINT fk_pullreq_edit_pullreq_id FOREIGN KEY (pullreq_edit_pullreq_id)
+    REFERENCES pullreqs (pullreq_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+,CONSTRAINT fk_pullreq_edit_created_by FOREIGN KEY (pullreq_edit_created_by)
+    REFERENCES principals (principal_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE NO ACTION
+);
+
+CREATE INDEX pullreq_edits_pullreq_id_created
+    ON pullreq_edits(pullreq_edit_pullreq_id, pullreq_edit_created);
+
+ALTER TABLE pullreqs ADD COLUMN pullreq_content_edited BIGINT;
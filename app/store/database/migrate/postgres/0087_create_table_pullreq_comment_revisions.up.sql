@@ -0,0 +1,20 @@
+CREATE TABLE pullreq_comment_revisions (
+ pullreq_comment_revision_id SERIAL PRIMARY KEY
+,pullreq_comment_revision_activity_id INTEGER NOT NULL
+,pullreq_comment_revision_type TEXT NOT NULL
+,pullreq_comment_revision_created_by INTEGER NOT NULL
+,pullreq_comment_revision_created BIGINT NOT NULL
+,pullreq_comment_revision_text TEXT NOT NULL
+
+,CONSTRAINT fk_pullreq_comment_revision_activity_id FOREIGN KEY (pullreq_comment_revision_activity_id)
+    REFERENCES pullreq_activities (pullreq_activity_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+,CONSTRAINT fk_pullreq_comment_revision_created_by FOREIGN KEY (pullreq_comment_revision_created_by)
+    REFERENCES principals (principal_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE NO ACTION
+);
+
+CREATE INDEX pullreq_comment_revisions_activity_id_created
+    ON pullreq_comment_revisions(pullreq_comment_revision_activity_id, pullreq_comment_revision_created);
@@ -0,0 +1,3 @@
+ALTER TABLE pullreqs
+    DROP COLUMN pullreq_locked,
+    DROP COLUMN pullreq_lock_reason;
@@ -0,0 +1,3 @@
+ALTER TABLE pullreqs
+    ADD COLUMN pullreq_locked BIGINT,
+    ADD COLUMN pullreq_lock_reason TEXT;
@@ -0,0 +1,2 @@
+DROP TABLE pullreq_reviewer_reminders;
+DROP TABLE notification_settings;
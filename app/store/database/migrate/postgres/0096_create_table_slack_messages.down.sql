@@ -0,0 +1 @@
+DROP TABLE slack_messages;
@@ -0,0 +1 @@
+ALTER TABLE pullreqs DROP COLUMN pullreq_template;
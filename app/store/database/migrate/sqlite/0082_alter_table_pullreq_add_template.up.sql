@@ -0,0 +1 @@
+ALTER TABLE pullreqs ADD COLUMN pullreq_template TEXT NOT NULL DEFAULT '';
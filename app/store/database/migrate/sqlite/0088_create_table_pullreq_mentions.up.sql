@@ -0,0 +1,20 @@
+CREATE TABLE pullreq_mentions (
+ pullreq_mention_pullreq_id INTEGER NOT NULL
+,pullreq_mention_principal_id INTEGER NOT NULL
+,pullreq_mention_created_by INTEGER NOT NULL
+,pullreq_mention_created BIGINT NOT NULL
+
+,CONSTRAINT pk_pullreq_mentions PRIMARY KEY (pullreq_mention_pullreq_id, pullreq_mention_principal_id)
+,CONSTRAINT fk_pullreq_mention_pullreq_id FOREIGN KEY (pullreq_mention_pullreq_id)
+    REFERENCES pullreqs (pullreq_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+,CONSTRAINT fk_pullreq_mention_principal_id FOREIGN KEY (pullreq_mention_principal_id)
+    REFERENCES principals (principal_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+,CONSTRAINT fk_pullreq_mention_created_by FOREIGN KEY (pullreq_mention_created_by)
+    REFERENCES principals (principal_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE NO ACTION
+);
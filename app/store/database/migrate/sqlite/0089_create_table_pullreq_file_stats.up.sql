@@ -0,0 +1,12 @@
+CREATE TABLE pullreq_file_stats (
+ pullreq_file_stats_pullreq_id INTEGER PRIMARY KEY
+,pullreq_file_stats_source_sha TEXT NOT NULL
+,pullreq_file_stats_merge_base_sha TEXT NOT NULL
+,pullreq_file_stats_files TEXT NOT NULL
+,pullreq_file_stats_updated BIGINT NOT NULL
+
+,CONSTRAINT fk_pullreq_file_stats_pullreq_id FOREIGN KEY (pullreq_file_stats_pullreq_id)
+    REFERENCES pullreqs (pullreq_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+);
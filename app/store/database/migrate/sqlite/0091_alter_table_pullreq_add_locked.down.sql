@@ -0,0 +1,2 @@
+ALTER TABLE pullreqs DROP COLUMN pullreq_lock_reason;
+ALTER TABLE pullreqs DROP COLUMN pullreq_locked;
@@ -0,0 +1,2 @@
+ALTER TABLE pullreqs ADD COLUMN pullreq_locked INTEGER;
+ALTER TABLE pullreqs ADD COLUMN pullreq_lock_reason TEXT;
@@ -0,0 +1,31 @@
+CREATE TABLE notification_settings (
+ notification_settings_principal_id INTEGER PRIMARY KEY
+,notification_settings_reviewer_reminders_disabled BOOLEAN NOT NULL DEFAULT FALSE
+,notification_settings_quiet_hours_start INTEGER
+,notification_settings_quiet_hours_end INTEGER
+,notification_settings_timezone TEXT NOT NULL DEFAULT ''
+,notification_settings_updated BIGINT NOT NULL
+
+,CONSTRAINT fk_notification_settings_principal_id FOREIGN KEY (notification_settings_principal_id)
+    REFERENCES principals (principal_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+);
+
+CREATE TABLE pullreq_reviewer_reminders (
+ pullreq_reviewer_reminder_pullreq_id INTEGER NOT NULL
+,pullreq_reviewer_reminder_principal_id INTEGER NOT NULL
+,pullreq_reviewer_reminder_sent BIGINT NOT NULL
+,pullreq_reviewer_reminder_next BIGINT NOT NULL DEFAULT 0
+
+,CONSTRAINT pk_pullreq_reviewer_reminders
+    PRIMARY KEY (pullreq_reviewer_reminder_pullreq_id, pullreq_reviewer_reminder_principal_id)
+,CONSTRAINT fk_pullreq_reviewer_reminder_pullreq_id FOREIGN KEY (pullreq_reviewer_reminder_pullreq_id)
+    REFERENCES pullreqs (pullreq_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+,CONSTRAINT fk_pullreq_reviewer_reminder_principal_id FOREIGN KEY (pullreq_reviewer_reminder_principal_id)
+    REFERENCES principals (principal_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+);
@@ -0,0 +1,17 @@
+CREATE TABLE review_rollups (
+ review_rollup_repo_id INTEGER NOT NULL
+,review_rollup_day BIGINT NOT NULL
+,review_rollup_metric TEXT NOT NULL
+,review_rollup_principal_id INTEGER NOT NULL
+,review_rollup_count BIGINT NOT NULL
+,review_rollup_duration BIGINT NOT NULL
+,CONSTRAINT pk_review_rollups
+    PRIMARY KEY (review_rollup_repo_id, review_rollup_day, review_rollup_metric, review_rollup_principal_id)
+,CONSTRAINT fk_review_rollup_repo_id FOREIGN KEY (review_rollup_repo_id)
+    REFERENCES repositories (repo_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+);
+
+CREATE INDEX review_rollups_day
+    ON review_rollups(review_rollup_day);
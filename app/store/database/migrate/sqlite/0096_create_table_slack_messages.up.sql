@@ -0,0 +1,13 @@
+CREATE TABLE slack_messages (
+ slack_message_execution_id INTEGER PRIMARY KEY
+,slack_message_channel TEXT NOT NULL
+,slack_message_ts TEXT NOT NULL
+,slack_message_created BIGINT NOT NULL
+,CONSTRAINT fk_slack_message_execution_id FOREIGN KEY (slack_message_execution_id)
+    REFERENCES executions (execution_id) MATCH SIMPLE
+    ON UPDATE NO ACTION
+    ON DELETE CASCADE
+);
+
+CREATE INDEX slack_messages_created
+    ON slack_messages(slack_message_created);
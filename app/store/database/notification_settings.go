@@ -0,0 +1,152 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+
+	"github.com/guregu/null"
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.NotificationSettingsStore = (*NotificationSettingsStore)(nil)
+
+// NewNotificationSettingsStore returns a new NotificationSettingsStore.
+func NewNotificationSettingsStore(db *sqlx.DB) *NotificationSettingsStore {
+	return &NotificationSettingsStore{
+		db: db,
+	}
+}
+
+// NotificationSettingsStore implements store.NotificationSettingsStore backed by a relational database.
+type NotificationSettingsStore struct {
+	db *sqlx.DB
+}
+
+// notificationSettings is used to fetch notification settings from the database.
+type notificationSettings struct {
+	PrincipalID               int64    `db:"notification_settings_principal_id"`
+	ReviewerRemindersDisabled bool     `db:"notification_settings_reviewer_reminders_disabled"`
+	QuietHoursStart           null.Int `db:"notification_settings_quiet_hours_start"`
+	QuietHoursEnd             null.Int `db:"notification_settings_quiet_hours_end"`
+	Timezone                  string   `db:"notification_settings_timezone"`
+	Updated                   int64    `db:"notification_settings_updated"`
+}
+
+const (
+	notificationSettingsColumns = `
+		 notification_settings_principal_id
+		,notification_settings_reviewer_reminders_disabled
+		,notification_settings_quiet_hours_start
+		,notification_settings_quiet_hours_end
+		,notification_settings_timezone
+		,notification_settings_updated`
+)
+
+// Find returns the notification settings of the principal.
+func (s *NotificationSettingsStore) Find(ctx context.Context, principalID int64) (*types.NotificationSettings, error) {
+	const sqlQuery = `
+	SELECT` + notificationSettingsColumns + `
+	FROM notification_settings
+	WHERE notification_settings_principal_id = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := &notificationSettings{}
+	if err := db.GetContext(ctx, dst, sqlQuery, principalID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to find notification settings")
+	}
+
+	return mapNotificationSettings(dst), nil
+}
+
+// Upsert creates or replaces the notification settings of the principal.
+func (s *NotificationSettingsStore) Upsert(ctx context.Context, settings *types.NotificationSettings) error {
+	const sqlQuery = `
+	INSERT INTO notification_settings (` + notificationSettingsColumns + `
+	) values (
+		 :notification_settings_principal_id
+		,:notification_settings_reviewer_reminders_disabled
+		,:notification_settings_quiet_hours_start
+		,:notification_settings_quiet_hours_end
+		,:notification_settings_timezone
+		,:notification_settings_updated
+	)
+	ON CONFLICT (notification_settings_principal_id) DO UPDATE SET
+		 notification_settings_reviewer_reminders_disabled =
+			EXCLUDED.notification_settings_reviewer_reminders_disabled
+		,notification_settings_quiet_hours_start = EXCLUDED.notification_settings_quiet_hours_start
+		,notification_settings_quiet_hours_end = EXCLUDED.notification_settings_quiet_hours_end
+		,notification_settings_timezone = EXCLUDED.notification_settings_timezone
+		,notification_settings_updated = EXCLUDED.notification_settings_updated`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, args, err := db.BindNamed(sqlQuery, mapInternalNotificationSettings(settings))
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind notification settings object")
+	}
+
+	if _, err = db.ExecContext(ctx, query, args...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to upsert notification settings")
+	}
+
+	return nil
+}
+
+func mapNotificationSettings(settings *notificationSettings) *types.NotificationSettings {
+	var quietHoursStart, quietHoursEnd *int
+	if settings.QuietHoursStart.Valid {
+		v := int(settings.QuietHoursStart.Int64)
+		quietHoursStart = &v
+	}
+	if settings.QuietHoursEnd.Valid {
+		v := int(settings.QuietHoursEnd.Int64)
+		quietHoursEnd = &v
+	}
+
+	return &types.NotificationSettings{
+		PrincipalID:               settings.PrincipalID,
+		ReviewerRemindersDisabled: settings.ReviewerRemindersDisabled,
+		QuietHoursStart:           quietHoursStart,
+		QuietHoursEnd:             quietHoursEnd,
+		Timezone:                  settings.Timezone,
+		Updated:                   settings.Updated,
+	}
+}
+
+func mapInternalNotificationSettings(settings *types.NotificationSettings) *notificationSettings {
+	var quietHoursStart, quietHoursEnd null.Int
+	if settings.QuietHoursStart != nil {
+		quietHoursStart = null.IntFrom(int64(*settings.QuietHoursStart))
+	}
+	if settings.QuietHoursEnd != nil {
+		quietHoursEnd = null.IntFrom(int64(*settings.QuietHoursEnd))
+	}
+
+	return &notificationSettings{
+		PrincipalID:               settings.PrincipalID,
+		ReviewerRemindersDisabled: settings.ReviewerRemindersDisabled,
+		QuietHoursStart:           quietHoursStart,
+		QuietHoursEnd:             quietHoursEnd,
+		Timezone:                  settings.Timezone,
+		Updated:                   settings.Updated,
+	}
+}
@@ -132,7 +132,7 @@ func (s *PrincipalStore) FindManyByUID(ctx context.Context, uids []string) ([]*t
 	stmt := database.Builder.
 		Select(principalColumns).
 		From("principals").
-		Where(squirrel.Eq{"principal_uid_unique": uids})
+		Where(squirrel.Eq{"principal_uid_unique": uniqueUIDs})
 	db := dbtx.GetAccessor(ctx, s.db)
 
 	sqlQuery, params, err := stmt.ToSql()
@@ -64,14 +64,20 @@ type pullReq struct {
 	Edited    int64    `db:"pullreq_edited"` // TODO: Remove
 	Closed    null.Int `db:"pullreq_closed"`
 
+	ContentEdited null.Int `db:"pullreq_content_edited"`
+
 	State   enum.PullReqState `db:"pullreq_state"`
 	IsDraft bool              `db:"pullreq_is_draft"`
 
+	Locked     null.Int    `db:"pullreq_locked"`
+	LockReason null.String `db:"pullreq_lock_reason"`
+
 	CommentCount    int `db:"pullreq_comment_count"`
 	UnresolvedCount int `db:"pullreq_unresolved_count"`
 
 	Title       string `db:"pullreq_title"`
 	Description string `db:"pullreq_description"`
+	Template    string `db:"pullreq_template"`
 
 	SourceRepoID int64  `db:"pullreq_source_repo_id"`
 	SourceBranch string `db:"pullreq_source_branch"`
@@ -110,11 +116,15 @@ const (
 		,pullreq_updated
 		,pullreq_edited
 		,pullreq_closed
+		,pullreq_content_edited
 		,pullreq_state
 		,pullreq_is_draft
+		,pullreq_locked
+		,pullreq_lock_reason
 		,pullreq_comment_count
 		,pullreq_unresolved_count
 		,pullreq_title
+		,pullreq_template
 		,pullreq_source_repo_id
 		,pullreq_source_branch
 		,pullreq_source_sha
@@ -208,12 +218,16 @@ func (s *PullReqStore) Create(ctx context.Context, pr *types.PullReq) error {
 		,pullreq_updated
 		,pullreq_edited
 		,pullreq_closed
+		,pullreq_content_edited
 		,pullreq_state
 		,pullreq_is_draft
+		,pullreq_locked
+		,pullreq_lock_reason
 		,pullreq_comment_count
 		,pullreq_unresolved_count
 		,pullreq_title
 		,pullreq_description
+		,pullreq_template
 		,pullreq_source_repo_id
 		,pullreq_source_branch
 		,pullreq_source_sha
@@ -242,12 +256,16 @@ func (s *PullReqStore) Create(ctx context.Context, pr *types.PullReq) error {
 		,:pullreq_updated
 		,:pullreq_edited
 		,:pullreq_closed
+		,:pullreq_content_edited
 		,:pullreq_state
 		,:pullreq_is_draft
+		,:pullreq_locked
+		,:pullreq_lock_reason
 		,:pullreq_comment_count
 		,:pullreq_unresolved_count
 		,:pullreq_title
 		,:pullreq_description
+		,:pullreq_template
 		,:pullreq_source_repo_id
 		,:pullreq_source_branch
 		,:pullreq_source_sha
@@ -293,12 +311,16 @@ func (s *PullReqStore) Update(ctx context.Context, pr *types.PullReq) error {
 		,pullreq_updated = :pullreq_updated
 		,pullreq_edited = :pullreq_edited
 		,pullreq_closed = :pullreq_closed
+		,pullreq_content_edited = :pullreq_content_edited
 		,pullreq_state = :pullreq_state
 		,pullreq_is_draft = :pullreq_is_draft
+		,pullreq_locked = :pullreq_locked
+		,pullreq_lock_reason = :pullreq_lock_reason
 		,pullreq_comment_count = :pullreq_comment_count
 		,pullreq_unresolved_count = :pullreq_unresolved_count
 		,pullreq_title = :pullreq_title
 		,pullreq_description = :pullreq_description
+		,pullreq_template = :pullreq_template
 		,pullreq_activity_seq = :pullreq_activity_seq
 		,pullreq_source_sha = :pullreq_source_sha
 		,pullreq_merged_by = :pullreq_merged_by
@@ -685,6 +707,16 @@ func (s *PullReqStore) applyFilter(stmt *squirrel.SelectBuilder, opts *types.Pul
 		}
 	}
 
+	if opts.SearchText != "" {
+		*stmt = stmt.Where(s.searchTextCondition(opts.SearchText))
+	}
+
+	for _, labelKey := range opts.LabelKeys {
+		*stmt = stmt.Where("EXISTS ("+
+			"SELECT 1 FROM pullreq_labels JOIN labels ON label_id = pullreq_label_label_id "+
+			"WHERE pullreq_label_pullreq_id = pullreq_id AND LOWER(label_key) = ?)", strings.ToLower(labelKey))
+	}
+
 	// labels
 
 	if len(opts.LabelID) == 0 && len(opts.ValueID) == 0 {
@@ -715,6 +747,40 @@ func (s *PullReqStore) applyFilter(stmt *squirrel.SelectBuilder, opts *types.Pul
 	*stmt = stmt.Having("COUNT(pullreq_label_pullreq_id) = ?", len(opts.LabelID)+len(opts.ValueID))
 }
 
+// searchTextCondition returns the condition matching pull requests with the text
+// in the title, the description or in any of the comments.
+// PostgreSQL uses the full-text search (backed by GIN indexes), SQLite matches the text as a substring.
+func (s *PullReqStore) searchTextCondition(text string) squirrel.Sqlizer {
+	const commentKinds = "('" + string(enum.PullReqActivityKindComment) + "', '" +
+		string(enum.PullReqActivityKindChangeComment) + "')"
+
+	const commentBase = "EXISTS (SELECT 1 FROM pullreq_activities " +
+		"WHERE pullreq_activity_pullreq_id = pullreq_id " +
+		"AND pullreq_activity_deleted IS NULL " +
+		"AND pullreq_activity_pending = FALSE " +
+		"AND pullreq_activity_kind IN " + commentKinds + " "
+
+	if s.db.DriverName() == PostgresDriverName {
+		return squirrel.Expr("("+
+			"to_tsvector('simple', pullreq_title || ' ' || pullreq_description) @@ plainto_tsquery('simple', ?) OR "+
+			commentBase+"AND to_tsvector('simple', pullreq_activity_text) @@ plainto_tsquery('simple', ?)))",
+			text, text)
+	}
+
+	pattern := fmt.Sprintf("%%%s%%", escapeLikePattern(strings.ToLower(text)))
+
+	return squirrel.Expr("("+
+		`LOWER(pullreq_title) LIKE ? ESCAPE '\' OR LOWER(pullreq_description) LIKE ? ESCAPE '\' OR `+
+		commentBase+`AND LOWER(pullreq_activity_text) LIKE ? ESCAPE '\'))`,
+		pattern, pattern, pattern)
+}
+
+// escapeLikePattern escapes the LIKE wildcards in the text, so that it's matched literally.
+// The returned value must be used with the ESCAPE '\' clause.
+func escapeLikePattern(text string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(text)
+}
+
 func mapPullReq(pr *pullReq) *types.PullReq {
 	var mergeConflicts, rebaseConflicts []string
 	if pr.MergeConflicts.Valid {
@@ -733,12 +799,16 @@ func mapPullReq(pr *pullReq) *types.PullReq {
 		Updated:           pr.Updated,
 		Edited:            pr.Edited, // TODO: When we remove the DB column, make Edited equal to Updated
 		Closed:            pr.Closed.Ptr(),
+		ContentEdited:     pr.ContentEdited.Ptr(),
 		State:             pr.State,
 		IsDraft:           pr.IsDraft,
+		Locked:            pr.Locked.Ptr(),
+		LockReason:        (*enum.PullReqLockReason)(pr.LockReason.Ptr()),
 		CommentCount:      pr.CommentCount,
 		UnresolvedCount:   pr.UnresolvedCount,
 		Title:             pr.Title,
 		Description:       pr.Description,
+		Template:          pr.Template,
 		SourceRepoID:      pr.SourceRepoID,
 		SourceBranch:      pr.SourceBranch,
 		SourceSHA:         pr.SourceSHA,
@@ -782,12 +852,16 @@ func mapInternalPullReq(pr *types.PullReq) *pullReq {
 		Updated:           pr.Updated,
 		Edited:            pr.Edited, // TODO: When we remove the DB column, make Edited equal to Updated
 		Closed:            null.IntFromPtr(pr.Closed),
+		ContentEdited:     null.IntFromPtr(pr.ContentEdited),
 		State:             pr.State,
 		IsDraft:           pr.IsDraft,
+		Locked:            null.IntFromPtr(pr.Locked),
+		LockReason:        null.StringFromPtr((*string)(pr.LockReason)),
 		CommentCount:      pr.CommentCount,
 		UnresolvedCount:   pr.UnresolvedCount,
 		Title:             pr.Title,
 		Description:       pr.Description,
+		Template:          pr.Template,
 		SourceRepoID:      pr.SourceRepoID,
 		SourceBranch:      pr.SourceBranch,
 		SourceSHA:         pr.SourceSHA,
@@ -83,6 +83,8 @@ type pullReqActivity struct {
 	ResolvedBy null.Int `db:"pullreq_activity_resolved_by"`
 	Resolved   null.Int `db:"pullreq_activity_resolved"`
 
+	Pending bool `db:"pullreq_activity_pending"`
+
 	Outdated                null.Bool   `db:"pullreq_activity_outdated"`
 	CodeCommentMergeBaseSHA null.String `db:"pullreq_activity_code_comment_merge_base_sha"`
 	CodeCommentSourceSHA    null.String `db:"pullreq_activity_code_comment_source_sha"`
@@ -115,6 +117,7 @@ const (
 		,pullreq_activity_metadata
 		,pullreq_activity_resolved_by
 		,pullreq_activity_resolved
+		,pullreq_activity_pending
 		,pullreq_activity_outdated
 		,pullreq_activity_code_comment_merge_base_sha
 		,pullreq_activity_code_comment_source_sha
@@ -172,6 +175,7 @@ func (s *PullReqActivityStore) Create(ctx context.Context, act *types.PullReqAct
 		,pullreq_activity_metadata
 		,pullreq_activity_resolved_by
 		,pullreq_activity_resolved
+		,pullreq_activity_pending
 		,pullreq_activity_outdated
 		,pullreq_activity_code_comment_merge_base_sha
 		,pullreq_activity_code_comment_source_sha
@@ -200,6 +204,7 @@ func (s *PullReqActivityStore) Create(ctx context.Context, act *types.PullReqAct
 		,:pullreq_activity_metadata
 		,:pullreq_activity_resolved_by
 		,:pullreq_activity_resolved
+		,:pullreq_activity_pending
 		,:pullreq_activity_outdated
 		,:pullreq_activity_code_comment_merge_base_sha
 		,:pullreq_activity_code_comment_source_sha
@@ -382,6 +387,8 @@ func (s *PullReqActivityStore) Count(ctx context.Context,
 		stmt = stmt.Where("pullreq_activity_created < ?", opts.Before)
 	}
 
+	stmt = stmt.Where("pullreq_activity_pending = FALSE")
+
 	sql, args, err := stmt.ToSql()
 	if err != nil {
 		return 0, errors.Wrap(err, "Failed to convert query to sql")
@@ -410,7 +417,12 @@ func (s *PullReqActivityStore) List(ctx context.Context,
 
 	stmt = applyFilter(filter, stmt)
 
-	stmt = stmt.OrderBy("pullreq_activity_order asc", "pullreq_activity_sub_order asc")
+	if filter.Limit > 0 {
+		// limited lists are paginated with the (created, id) cursor, so they need to be ordered by it.
+		stmt = stmt.OrderBy("pullreq_activity_created asc", "pullreq_activity_id asc")
+	} else {
+		stmt = stmt.OrderBy("pullreq_activity_order asc", "pullreq_activity_sub_order asc")
+	}
 
 	sql, args, err := stmt.ToSql()
 	if err != nil {
@@ -457,6 +469,57 @@ func (s *PullReqActivityStore) ListAuthorIDs(ctx context.Context, prID int64, or
 	return dst, nil
 }
 
+// ListPending returns all pending pull request activities of a principal.
+func (s *PullReqActivityStore) ListPending(ctx context.Context,
+	prID int64,
+	principalID int64,
+) ([]*types.PullReqActivity, error) {
+	stmt := database.Builder.
+		Select(pullreqActivityColumns).
+		From("pullreq_activities").
+		Where("pullreq_activity_pullreq_id = ?", prID).
+		Where("pullreq_activity_created_by = ?", principalID).
+		Where("pullreq_activity_pending = TRUE").
+		OrderBy("pullreq_activity_order asc", "pullreq_activity_sub_order asc")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert pull request activity query to sql")
+	}
+
+	dst := make([]*pullReqActivity, 0)
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed executing pending pull request activity list query")
+	}
+
+	return s.mapSlicePullReqActivity(ctx, dst)
+}
+
+// PublishPending clears the pending flag of all pending pull request activities of a principal.
+func (s *PullReqActivityStore) PublishPending(ctx context.Context, prID int64, principalID int64) error {
+	const sqlQuery = `
+	UPDATE pullreq_activities
+	SET
+		 pullreq_activity_pending = FALSE
+		,pullreq_activity_version = pullreq_activity_version + 1
+		,pullreq_activity_updated = $1
+	WHERE pullreq_activity_pullreq_id = $2
+		AND pullreq_activity_created_by = $3
+		AND pullreq_activity_pending = TRUE`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	_, err := db.ExecContext(ctx, sqlQuery, time.Now().UnixMilli(), prID, principalID)
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to publish pending pull request activities")
+	}
+
+	return nil
+}
+
 func (s *PullReqActivityStore) CountUnresolved(ctx context.Context, prID int64) (int, error) {
 	stmt := database.Builder.
 		Select("count(*)").
@@ -465,6 +528,7 @@ func (s *PullReqActivityStore) CountUnresolved(ctx context.Context, prID int64)
 		Where("pullreq_activity_sub_order = 0").
 		Where("pullreq_activity_resolved IS NULL").
 		Where("pullreq_activity_deleted IS NULL").
+		Where("pullreq_activity_pending = FALSE").
 		Where("pullreq_activity_kind <> ?", enum.PullReqActivityKindSystem)
 
 	sql, args, err := stmt.ToSql()
@@ -511,6 +575,7 @@ func mapPullReqActivity(act *pullReqActivity) (*types.PullReqActivity, error) {
 		Metadata:   metadata,
 		ResolvedBy: act.ResolvedBy.Ptr(),
 		Resolved:   act.Resolved.Ptr(),
+		Pending:    act.Pending,
 		Author:     types.PrincipalInfo{},
 		Resolver:   nil,
 	}
@@ -552,6 +617,7 @@ func mapInternalPullReqActivity(act *types.PullReqActivity) (*pullReqActivity, e
 		Metadata:   nil,
 		ResolvedBy: null.IntFromPtr(act.ResolvedBy),
 		Resolved:   null.IntFromPtr(act.Resolved),
+		Pending:    act.Pending,
 	}
 	if act.IsValidCodeComment() {
 		m.Outdated = null.BoolFrom(act.CodeComment.Outdated)
@@ -658,7 +724,16 @@ func applyFilter(
 		stmt = stmt.Where(squirrel.Eq{"pullreq_activity_kind": filter.Kinds})
 	}
 
-	if filter.After != 0 {
+	if filter.AfterID != 0 {
+		// the ID breaks ties between activities created at the same time, so no activity is skipped or repeated.
+		stmt = stmt.Where(squirrel.Or{
+			squirrel.Gt{"pullreq_activity_created": filter.After},
+			squirrel.And{
+				squirrel.Eq{"pullreq_activity_created": filter.After},
+				squirrel.Gt{"pullreq_activity_id": filter.AfterID},
+			},
+		})
+	} else if filter.After != 0 {
 		stmt = stmt.Where("pullreq_activity_created > ?", filter.After)
 	}
 
@@ -666,9 +741,49 @@ func applyFilter(
 		stmt = stmt.Where("pullreq_activity_created < ?", filter.Before)
 	}
 
+	if filter.AuthorID != 0 {
+		stmt = stmt.Where("pullreq_activity_created_by = ?", filter.AuthorID)
+	}
+
+	if filter.PendingAuthorID != 0 {
+		stmt = stmt.Where(squirrel.Or{
+			squirrel.Eq{"pullreq_activity_pending": false},
+			squirrel.Eq{"pullreq_activity_created_by": filter.PendingAuthorID},
+		})
+	} else {
+		stmt = stmt.Where("pullreq_activity_pending = FALSE")
+	}
+
+	switch filter.CommentStatus {
+	case enum.PullReqCommentStatusActive:
+		stmt = stmt.Where(squirrel.Eq{"pullreq_activity_kind": []enum.PullReqActivityKind{
+			enum.PullReqActivityKindComment,
+			enum.PullReqActivityKindChangeComment,
+		}})
+		stmt = stmt.Where(threadFilter(squirrel.Eq{"thread.pullreq_activity_resolved": nil}))
+	case enum.PullReqCommentStatusResolved:
+		stmt = stmt.Where(threadFilter(squirrel.NotEq{"thread.pullreq_activity_resolved": nil}))
+	}
+
+	if filter.Path != "" {
+		stmt = stmt.Where(threadFilter(squirrel.Eq{"thread.pullreq_activity_code_comment_path": filter.Path}))
+	}
+
 	if filter.Limit > 0 {
 		stmt = stmt.Limit(database.Limit(filter.Limit))
 	}
 
 	return stmt
 }
+
+// threadFilter returns a condition that matches activities
+// whose thread's top-level activity satisfies the provided condition.
+func threadFilter(cond squirrel.Sqlizer) squirrel.Sqlizer {
+	return squirrel.Expr("EXISTS (?)", squirrel.
+		Select("1").
+		From("pullreq_activities AS thread").
+		Where("thread.pullreq_activity_pullreq_id = pullreq_activities.pullreq_activity_pullreq_id").
+		Where("thread.pullreq_activity_order = pullreq_activities.pullreq_activity_order").
+		Where("thread.pullreq_activity_sub_order = 0").
+		Where(cond))
+}
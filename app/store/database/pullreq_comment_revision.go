@@ -0,0 +1,166 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.PullReqCommentRevisionStore = (*PullReqCommentRevisionStore)(nil)
+
+// NewPullReqCommentRevisionStore returns a new PullReqCommentRevisionStore.
+func NewPullReqCommentRevisionStore(
+	db *sqlx.DB,
+	pCache store.PrincipalInfoCache,
+) *PullReqCommentRevisionStore {
+	return &PullReqCommentRevisionStore{
+		db:     db,
+		pCache: pCache,
+	}
+}
+
+// PullReqCommentRevisionStore implements store.PullReqCommentRevisionStore backed by a relational database.
+type PullReqCommentRevisionStore struct {
+	db     *sqlx.DB
+	pCache store.PrincipalInfoCache
+}
+
+// pullReqCommentRevision is used to fetch pull request comment revision data from the database.
+type pullReqCommentRevision struct {
+	ID         int64                           `db:"pullreq_comment_revision_id"`
+	ActivityID int64                           `db:"pullreq_comment_revision_activity_id"`
+	Type       enum.PullReqCommentRevisionType `db:"pullreq_comment_revision_type"`
+	CreatedBy  int64                           `db:"pullreq_comment_revision_created_by"`
+	Created    int64                           `db:"pullreq_comment_revision_created"`
+	Text       string                          `db:"pullreq_comment_revision_text"`
+}
+
+const (
+	pullReqCommentRevisionColumns = `
+		 pullreq_comment_revision_id
+		,pullreq_comment_revision_activity_id
+		,pullreq_comment_revision_type
+		,pullreq_comment_revision_created_by
+		,pullreq_comment_revision_created
+		,pullreq_comment_revision_text`
+)
+
+// Create records a prior version of a pull request comment.
+func (s *PullReqCommentRevisionStore) Create(ctx context.Context, rev *types.PullReqCommentRevision) error {
+	const sqlQuery = `
+	INSERT INTO pullreq_comment_revisions (
+		 pullreq_comment_revision_activity_id
+		,pullreq_comment_revision_type
+		,pullreq_comment_revision_created_by
+		,pullreq_comment_revision_created
+		,pullreq_comment_revision_text
+	) values (
+		 :pullreq_comment_revision_activity_id
+		,:pullreq_comment_revision_type
+		,:pullreq_comment_revision_created_by
+		,:pullreq_comment_revision_created
+		,:pullreq_comment_revision_text
+	) RETURNING pullreq_comment_revision_id`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, args, err := db.BindNamed(sqlQuery, mapInternalPullReqCommentRevision(rev))
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind pull request comment revision object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, args...).Scan(&rev.ID); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to insert pull request comment revision")
+	}
+
+	return nil
+}
+
+// List returns the revision history of the pull request comment, the latest revision first.
+func (s *PullReqCommentRevisionStore) List(
+	ctx context.Context,
+	activityID int64,
+) ([]*types.PullReqCommentRevision, error) {
+	const sqlQuery = `
+	SELECT` + pullReqCommentRevisionColumns + `
+	FROM pullreq_comment_revisions
+	WHERE pullreq_comment_revision_activity_id = $1
+	ORDER BY pullreq_comment_revision_created DESC, pullreq_comment_revision_id DESC`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := make([]*pullReqCommentRevision, 0)
+	if err := db.SelectContext(ctx, &dst, sqlQuery, activityID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list pull request comment revisions")
+	}
+
+	return s.mapSlicePullReqCommentRevision(ctx, dst)
+}
+
+func mapPullReqCommentRevision(rev *pullReqCommentRevision) *types.PullReqCommentRevision {
+	return &types.PullReqCommentRevision{
+		ID:         rev.ID,
+		ActivityID: rev.ActivityID,
+		Type:       rev.Type,
+		CreatedBy:  rev.CreatedBy,
+		Created:    rev.Created,
+		Text:       rev.Text,
+	}
+}
+
+func mapInternalPullReqCommentRevision(rev *types.PullReqCommentRevision) *pullReqCommentRevision {
+	return &pullReqCommentRevision{
+		ID:         rev.ID,
+		ActivityID: rev.ActivityID,
+		Type:       rev.Type,
+		CreatedBy:  rev.CreatedBy,
+		Created:    rev.Created,
+		Text:       rev.Text,
+	}
+}
+
+func (s *PullReqCommentRevisionStore) mapSlicePullReqCommentRevision(
+	ctx context.Context,
+	revs []*pullReqCommentRevision,
+) ([]*types.PullReqCommentRevision, error) {
+	ids := make([]int64, len(revs))
+	for i, rev := range revs {
+		ids[i] = rev.CreatedBy
+	}
+
+	infoMap, err := s.pCache.Map(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load pull request comment revision author infos: %w", err)
+	}
+
+	m := make([]*types.PullReqCommentRevision, len(revs))
+	for i, rev := range revs {
+		m[i] = mapPullReqCommentRevision(rev)
+		if author, ok := infoMap[rev.CreatedBy]; ok {
+			m[i].Author = *author
+		}
+	}
+
+	return m, nil
+}
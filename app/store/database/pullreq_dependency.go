@@ -0,0 +1,133 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.PullReqDependencyStore = (*PullReqDependencyStore)(nil)
+
+// NewPullReqDependencyStore returns a new PullReqDependencyStore.
+func NewPullReqDependencyStore(db *sqlx.DB) *PullReqDependencyStore {
+	return &PullReqDependencyStore{
+		db: db,
+	}
+}
+
+// PullReqDependencyStore implements store.PullReqDependencyStore backed by a relational database.
+type PullReqDependencyStore struct {
+	db *sqlx.DB
+}
+
+// pullReqDependency is used to store pull request dependency data in the database.
+type pullReqDependency struct {
+	PullReqID   int64 `db:"pullreq_dependency_pullreq_id"`
+	DependsOnID int64 `db:"pullreq_dependency_depends_on_id"`
+	CreatedBy   int64 `db:"pullreq_dependency_created_by"`
+	Created     int64 `db:"pullreq_dependency_created"`
+}
+
+// Create records that a pull request depends on another pull request.
+func (s *PullReqDependencyStore) Create(ctx context.Context, dependency *types.PullReqDependency) error {
+	const sqlQuery = `
+	INSERT INTO pullreq_dependencies (
+		 pullreq_dependency_pullreq_id
+		,pullreq_dependency_depends_on_id
+		,pullreq_dependency_created_by
+		,pullreq_dependency_created
+	) values (
+		 :pullreq_dependency_pullreq_id
+		,:pullreq_dependency_depends_on_id
+		,:pullreq_dependency_created_by
+		,:pullreq_dependency_created
+	)`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, args, err := db.BindNamed(sqlQuery, &pullReqDependency{
+		PullReqID:   dependency.PullReqID,
+		DependsOnID: dependency.DependsOnID,
+		CreatedBy:   dependency.CreatedBy,
+		Created:     dependency.Created,
+	})
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind pull request dependency object")
+	}
+
+	if _, err = db.ExecContext(ctx, query, args...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to insert pull request dependency")
+	}
+
+	return nil
+}
+
+// Delete removes the dependency of a pull request on another pull request.
+func (s *PullReqDependencyStore) Delete(ctx context.Context, pullReqID, dependsOnID int64) error {
+	const sqlQuery = `
+	DELETE FROM pullreq_dependencies
+	WHERE pullreq_dependency_pullreq_id = $1 AND pullreq_dependency_depends_on_id = $2`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, pullReqID, dependsOnID); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to delete pull request dependency")
+	}
+
+	return nil
+}
+
+// ListDependsOnIDs returns IDs of the pull requests the pull request directly depends on.
+func (s *PullReqDependencyStore) ListDependsOnIDs(ctx context.Context, pullReqID int64) ([]int64, error) {
+	const sqlQuery = `
+	SELECT pullreq_dependency_depends_on_id
+	FROM pullreq_dependencies
+	WHERE pullreq_dependency_pullreq_id = $1
+	ORDER BY pullreq_dependency_created ASC, pullreq_dependency_depends_on_id ASC`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	ids := make([]int64, 0)
+	if err := db.SelectContext(ctx, &ids, sqlQuery, pullReqID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list pull request dependencies")
+	}
+
+	return ids, nil
+}
+
+// ListDependentIDs returns IDs of the pull requests that directly depend on the pull request.
+func (s *PullReqDependencyStore) ListDependentIDs(ctx context.Context, pullReqID int64) ([]int64, error) {
+	const sqlQuery = `
+	SELECT pullreq_dependency_pullreq_id
+	FROM pullreq_dependencies
+	WHERE pullreq_dependency_depends_on_id = $1
+	ORDER BY pullreq_dependency_created ASC, pullreq_dependency_pullreq_id ASC`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	ids := make([]int64, 0)
+	if err := db.SelectContext(ctx, &ids, sqlQuery, pullReqID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list pull request dependents")
+	}
+
+	return ids, nil
+}
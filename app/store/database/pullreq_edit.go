@@ -0,0 +1,159 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.PullReqEditStore = (*PullReqEditStore)(nil)
+
+// NewPullReqEditStore returns a new PullReqEditStore.
+func NewPullReqEditStore(db *sqlx.DB, pCache store.PrincipalInfoCache) *PullReqEditStore {
+	return &PullReqEditStore{
+		db:     db,
+		pCache: pCache,
+	}
+}
+
+// PullReqEditStore implements store.PullReqEditStore backed by a relational database.
+type PullReqEditStore struct {
+	db     *sqlx.DB
+	pCache store.PrincipalInfoCache
+}
+
+// pullReqEdit is used to fetch pull request edit data from the database.
+type pullReqEdit struct {
+	ID          int64  `db:"pullreq_edit_id"`
+	PullReqID   int64  `db:"pullreq_edit_pullreq_id"`
+	CreatedBy   int64  `db:"pullreq_edit_created_by"`
+	Created     int64  `db:"pullreq_edit_created"`
+	Title       string `db:"pullreq_edit_title"`
+	Description string `db:"pullreq_edit_description"`
+}
+
+const (
+	pullReqEditColumns = `
+		 pullreq_edit_id
+		,pullreq_edit_pullreq_id
+		,pullreq_edit_created_by
+		,pullreq_edit_created
+		,pullreq_edit_title
+		,pullreq_edit_description`
+)
+
+// Create records a prior version of the pull request's title and description.
+func (s *PullReqEditStore) Create(ctx context.Context, edit *types.PullReqEdit) error {
+	const sqlQuery = `
+	INSERT INTO pullreq_edits (
+		 pullreq_edit_pullreq_id
+		,pullreq_edit_created_by
+		,pullreq_edit_created
+		,pullreq_edit_title
+		,pullreq_edit_description
+	) values (
+		 :pullreq_edit_pullreq_id
+		,:pullreq_edit_created_by
+		,:pullreq_edit_created
+		,:pullreq_edit_title
+		,:pullreq_edit_description
+	) RETURNING pullreq_edit_id`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, args, err := db.BindNamed(sqlQuery, mapInternalPullReqEdit(edit))
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind pull request edit object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, args...).Scan(&edit.ID); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to insert pull request edit")
+	}
+
+	return nil
+}
+
+// List returns the edit history of the pull request, the latest edit first.
+func (s *PullReqEditStore) List(ctx context.Context, pullReqID int64) ([]*types.PullReqEdit, error) {
+	const sqlQuery = `
+	SELECT` + pullReqEditColumns + `
+	FROM pullreq_edits
+	WHERE pullreq_edit_pullreq_id = $1
+	ORDER BY pullreq_edit_created DESC, pullreq_edit_id DESC`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := make([]*pullReqEdit, 0)
+	if err := db.SelectContext(ctx, &dst, sqlQuery, pullReqID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list pull request edits")
+	}
+
+	return s.mapSlicePullReqEdit(ctx, dst)
+}
+
+func mapPullReqEdit(edit *pullReqEdit) *types.PullReqEdit {
+	return &types.PullReqEdit{
+		ID:          edit.ID,
+		PullReqID:   edit.PullReqID,
+		CreatedBy:   edit.CreatedBy,
+		Created:     edit.Created,
+		Title:       edit.Title,
+		Description: edit.Description,
+	}
+}
+
+func mapInternalPullReqEdit(edit *types.PullReqEdit) *pullReqEdit {
+	return &pullReqEdit{
+		ID:          edit.ID,
+		PullReqID:   edit.PullReqID,
+		CreatedBy:   edit.CreatedBy,
+		Created:     edit.Created,
+		Title:       edit.Title,
+		Description: edit.Description,
+	}
+}
+
+func (s *PullReqEditStore) mapSlicePullReqEdit(
+	ctx context.Context,
+	edits []*pullReqEdit,
+) ([]*types.PullReqEdit, error) {
+	ids := make([]int64, len(edits))
+	for i, edit := range edits {
+		ids[i] = edit.CreatedBy
+	}
+
+	infoMap, err := s.pCache.Map(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load pull request editor infos: %w", err)
+	}
+
+	m := make([]*types.PullReqEdit, len(edits))
+	for i, edit := range edits {
+		m[i] = mapPullReqEdit(edit)
+		if editor, ok := infoMap[edit.CreatedBy]; ok {
+			m[i].Editor = *editor
+		}
+	}
+
+	return m, nil
+}
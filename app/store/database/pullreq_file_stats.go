@@ -0,0 +1,148 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.PullReqFileStatsStore = (*PullReqFileStatsStore)(nil)
+
+// NewPullReqFileStatsStore returns a new PullReqFileStatsStore.
+func NewPullReqFileStatsStore(db *sqlx.DB) *PullReqFileStatsStore {
+	return &PullReqFileStatsStore{
+		db: db,
+	}
+}
+
+// PullReqFileStatsStore implements store.PullReqFileStatsStore backed by a relational database.
+type PullReqFileStatsStore struct {
+	db *sqlx.DB
+}
+
+// pullReqFileStats is used to fetch pull request file statistics from the database.
+type pullReqFileStats struct {
+	PullReqID    int64           `db:"pullreq_file_stats_pullreq_id"`
+	SourceSHA    string          `db:"pullreq_file_stats_source_sha"`
+	MergeBaseSHA string          `db:"pullreq_file_stats_merge_base_sha"`
+	Files        json.RawMessage `db:"pullreq_file_stats_files"`
+	Updated      int64           `db:"pullreq_file_stats_updated"`
+}
+
+const (
+	pullReqFileStatsColumns = `
+		 pullreq_file_stats_pullreq_id
+		,pullreq_file_stats_source_sha
+		,pullreq_file_stats_merge_base_sha
+		,pullreq_file_stats_files
+		,pullreq_file_stats_updated`
+)
+
+// Find returns the cached file statistics of the pull request.
+func (s *PullReqFileStatsStore) Find(ctx context.Context, pullReqID int64) (*types.PullReqFileStats, error) {
+	const sqlQuery = `
+	SELECT` + pullReqFileStatsColumns + `
+	FROM pullreq_file_stats
+	WHERE pullreq_file_stats_pullreq_id = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := &pullReqFileStats{}
+	if err := db.GetContext(ctx, dst, sqlQuery, pullReqID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to find pull request file stats")
+	}
+
+	return mapPullReqFileStats(dst)
+}
+
+// Upsert creates or replaces the cached file statistics of the pull request.
+func (s *PullReqFileStatsStore) Upsert(ctx context.Context, stats *types.PullReqFileStats) error {
+	const sqlQuery = `
+	INSERT INTO pullreq_file_stats (` + pullReqFileStatsColumns + `
+	) values (
+		 :pullreq_file_stats_pullreq_id
+		,:pullreq_file_stats_source_sha
+		,:pullreq_file_stats_merge_base_sha
+		,:pullreq_file_stats_files
+		,:pullreq_file_stats_updated
+	)
+	ON CONFLICT (pullreq_file_stats_pullreq_id) DO UPDATE SET
+		 pullreq_file_stats_source_sha = EXCLUDED.pullreq_file_stats_source_sha
+		,pullreq_file_stats_merge_base_sha = EXCLUDED.pullreq_file_stats_merge_base_sha
+		,pullreq_file_stats_files = EXCLUDED.pullreq_file_stats_files
+		,pullreq_file_stats_updated = EXCLUDED.pullreq_file_stats_updated`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dbStats, err := mapInternalPullReqFileStats(stats)
+	if err != nil {
+		return err
+	}
+
+	query, args, err := db.BindNamed(sqlQuery, dbStats)
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind pull request file stats object")
+	}
+
+	if _, err = db.ExecContext(ctx, query, args...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to upsert pull request file stats")
+	}
+
+	return nil
+}
+
+func mapPullReqFileStats(stats *pullReqFileStats) (*types.PullReqFileStats, error) {
+	var files []types.CommitFileStats
+	if err := json.Unmarshal(stats.Files, &files); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal pull request file stats: %w", err)
+	}
+
+	return &types.PullReqFileStats{
+		PullReqID:    stats.PullReqID,
+		SourceSHA:    stats.SourceSHA,
+		MergeBaseSHA: stats.MergeBaseSHA,
+		Files:        files,
+		Updated:      stats.Updated,
+	}, nil
+}
+
+func mapInternalPullReqFileStats(stats *types.PullReqFileStats) (*pullReqFileStats, error) {
+	files := stats.Files
+	if files == nil {
+		files = []types.CommitFileStats{}
+	}
+
+	filesJSON, err := json.Marshal(files)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal pull request file stats: %w", err)
+	}
+
+	return &pullReqFileStats{
+		PullReqID:    stats.PullReqID,
+		SourceSHA:    stats.SourceSHA,
+		MergeBaseSHA: stats.MergeBaseSHA,
+		Files:        filesJSON,
+		Updated:      stats.Updated,
+	}, nil
+}
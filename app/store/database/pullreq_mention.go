@@ -0,0 +1,138 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.PullReqMentionStore = (*PullReqMentionStore)(nil)
+
+// NewPullReqMentionStore returns a new PullReqMentionStore.
+func NewPullReqMentionStore(db *sqlx.DB) *PullReqMentionStore {
+	return &PullReqMentionStore{
+		db: db,
+	}
+}
+
+// PullReqMentionStore implements store.PullReqMentionStore backed by a relational database.
+type PullReqMentionStore struct {
+	db *sqlx.DB
+}
+
+// pullReqMention is used to fetch pull request mention data from the database.
+type pullReqMention struct {
+	PullReqID   int64 `db:"pullreq_mention_pullreq_id"`
+	PrincipalID int64 `db:"pullreq_mention_principal_id"`
+	CreatedBy   int64 `db:"pullreq_mention_created_by"`
+	Created     int64 `db:"pullreq_mention_created"`
+}
+
+const (
+	pullReqMentionColumns = `
+		 pullreq_mention_pullreq_id
+		,pullreq_mention_principal_id
+		,pullreq_mention_created_by
+		,pullreq_mention_created`
+)
+
+// Create records the mention of a principal. Recording an existing mention is a no-op.
+func (s *PullReqMentionStore) Create(ctx context.Context, mention *types.PullReqMention) error {
+	const sqlQuery = `
+	INSERT INTO pullreq_mentions (` + pullReqMentionColumns + `
+	) values (
+		 :pullreq_mention_pullreq_id
+		,:pullreq_mention_principal_id
+		,:pullreq_mention_created_by
+		,:pullreq_mention_created
+	)
+	ON CONFLICT (pullreq_mention_pullreq_id, pullreq_mention_principal_id) DO NOTHING`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, args, err := db.BindNamed(sqlQuery, mapInternalPullReqMention(mention))
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind pull request mention object")
+	}
+
+	if _, err = db.ExecContext(ctx, query, args...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to insert pull request mention")
+	}
+
+	return nil
+}
+
+// Delete removes the mention of a principal.
+func (s *PullReqMentionStore) Delete(ctx context.Context, pullReqID, principalID int64) error {
+	const sqlQuery = `
+	DELETE FROM pullreq_mentions
+	WHERE pullreq_mention_pullreq_id = $1 AND pullreq_mention_principal_id = $2`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, pullReqID, principalID); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to delete pull request mention")
+	}
+
+	return nil
+}
+
+// List returns all principals mentioned in the pull request description.
+func (s *PullReqMentionStore) List(ctx context.Context, pullReqID int64) ([]*types.PullReqMention, error) {
+	const sqlQuery = `
+	SELECT` + pullReqMentionColumns + `
+	FROM pullreq_mentions
+	WHERE pullreq_mention_pullreq_id = $1
+	ORDER BY pullreq_mention_created ASC, pullreq_mention_principal_id ASC`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := make([]*pullReqMention, 0)
+	if err := db.SelectContext(ctx, &dst, sqlQuery, pullReqID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to list pull request mentions")
+	}
+
+	result := make([]*types.PullReqMention, len(dst))
+	for i, m := range dst {
+		result[i] = mapPullReqMention(m)
+	}
+
+	return result, nil
+}
+
+func mapPullReqMention(m *pullReqMention) *types.PullReqMention {
+	return &types.PullReqMention{
+		PullReqID:   m.PullReqID,
+		PrincipalID: m.PrincipalID,
+		CreatedBy:   m.CreatedBy,
+		Created:     m.Created,
+	}
+}
+
+func mapInternalPullReqMention(m *types.PullReqMention) *pullReqMention {
+	return &pullReqMention{
+		PullReqID:   m.PullReqID,
+		PrincipalID: m.PrincipalID,
+		CreatedBy:   m.CreatedBy,
+		Created:     m.Created,
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+var _ store.PullReqReactionStore = (*PullReqReactionStore)(nil)
+
+// NewPullReqReactionStore returns a new PullReqReactionStore.
+func NewPullReqReactionStore(
+	db *sqlx.DB,
+) *PullReqReactionStore {
+	return &PullReqReactionStore{
+		db: db,
+	}
+}
+
+// PullReqReactionStore implements store.PullReqReactionStore backed by a relational database.
+type PullReqReactionStore struct {
+	db *sqlx.DB
+}
+
+type pullReqReaction struct {
+	PullReqID   int64 `db:"pullreq_reaction_pullreq_id"`
+	ActivityID  int64 `db:"pullreq_reaction_activity_id"`
+	PrincipalID int64 `db:"pullreq_reaction_principal_id"`
+
+	Reaction enum.PullReqReaction `db:"pullreq_reaction_reaction"`
+
+	Created int64 `db:"pullreq_reaction_created"`
+}
+
+const (
+	pullReqReactionsColumns = `
+		 pullreq_reaction_pullreq_id
+		,pullreq_reaction_activity_id
+		,pullreq_reaction_principal_id
+		,pullreq_reaction_reaction
+		,pullreq_reaction_created`
+)
+
+// Create adds the reaction. Adding an already existing reaction is a no-op.
+func (s *PullReqReactionStore) Create(ctx context.Context, reaction *types.PullReqReaction) error {
+	const sqlQuery = `
+	INSERT INTO pullreq_reactions (
+		 pullreq_reaction_pullreq_id
+		,pullreq_reaction_activity_id
+		,pullreq_reaction_principal_id
+		,pullreq_reaction_reaction
+		,pullreq_reaction_created
+	) VALUES (
+		 :pullreq_reaction_pullreq_id
+		,:pullreq_reaction_activity_id
+		,:pullreq_reaction_principal_id
+		,:pullreq_reaction_reaction
+		,:pullreq_reaction_created
+	)
+	ON CONFLICT DO NOTHING`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	query, arg, err := db.BindNamed(sqlQuery, mapToInternalPullReqReaction(reaction))
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind pullreq reaction object")
+	}
+
+	if _, err = db.ExecContext(ctx, query, arg...); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Insert query failed")
+	}
+
+	return nil
+}
+
+// Delete removes the reaction of the principal from the pull request comment or description.
+func (s *PullReqReactionStore) Delete(
+	ctx context.Context,
+	prID int64,
+	activityID int64,
+	principalID int64,
+	reaction enum.PullReqReaction,
+) error {
+	const sqlQuery = `
+	DELETE FROM pullreq_reactions
+	WHERE pullreq_reaction_pullreq_id = $1 AND
+		  pullreq_reaction_activity_id = $2 AND
+		  pullreq_reaction_principal_id = $3 AND
+		  pullreq_reaction_reaction = $4`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, prID, activityID, principalID, reaction); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "delete query failed")
+	}
+
+	return nil
+}
+
+// List lists all reactions to the pull request description and all its comments.
+func (s *PullReqReactionStore) List(ctx context.Context, prID int64) ([]*types.PullReqReaction, error) {
+	stmt := database.Builder.
+		Select(pullReqReactionsColumns).
+		From("pullreq_reactions").
+		Where("pullreq_reaction_pullreq_id = ?", prID).
+		OrderBy("pullreq_reaction_created ASC")
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert query to sql")
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	var dst []*pullReqReaction
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to execute list query")
+	}
+
+	return mapToPullReqReactions(dst), nil
+}
+
+func mapToInternalPullReqReaction(reaction *types.PullReqReaction) *pullReqReaction {
+	return &pullReqReaction{
+		PullReqID:   reaction.PullReqID,
+		ActivityID:  reaction.ActivityID,
+		PrincipalID: reaction.PrincipalID,
+		Reaction:    reaction.Reaction,
+		Created:     reaction.Created,
+	}
+}
+
+func mapToPullReqReaction(reaction *pullReqReaction) *types.PullReqReaction {
+	return &types.PullReqReaction{
+		PullReqID:   reaction.PullReqID,
+		ActivityID:  reaction.ActivityID,
+		PrincipalID: reaction.PrincipalID,
+		Reaction:    reaction.Reaction,
+		Created:     reaction.Created,
+	}
+}
+
+func mapToPullReqReactions(reactions []*pullReqReaction) []*types.PullReqReaction {
+	m := make([]*types.PullReqReaction, len(reactions))
+	for i, reaction := range reactions {
+		m[i] = mapToPullReqReaction(reaction)
+	}
+	return m
+}
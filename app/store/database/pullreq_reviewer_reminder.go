@@ -0,0 +1,133 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+)
+
+var _ store.PullReqReviewerReminderStore = (*PullReqReviewerReminderStore)(nil)
+
+// NewPullReqReviewerReminderStore returns a new PullReqReviewerReminderStore.
+func NewPullReqReviewerReminderStore(db *sqlx.DB) *PullReqReviewerReminderStore {
+	return &PullReqReviewerReminderStore{
+		db: db,
+	}
+}
+
+// PullReqReviewerReminderStore implements store.PullReqReviewerReminderStore backed by a relational database.
+type PullReqReviewerReminderStore struct {
+	db *sqlx.DB
+}
+
+// ListDue returns the reviewers of open pull requests whose review is pending since before pendingBefore
+// and who haven't been reminded about it since remindedBefore. Reviewers who opted out of reminders
+// and reviewers whose reminder is postponed past now are excluded.
+func (s *PullReqReviewerReminderStore) ListDue(
+	ctx context.Context,
+	pendingBefore int64,
+	remindedBefore int64,
+	now int64,
+	limit int,
+) ([]*types.PullReqReviewer, error) {
+	stmt := database.Builder.
+		Select(pullreqReviewerColumns).
+		From("pullreq_reviewers").
+		InnerJoin("pullreqs ON pullreq_id = pullreq_reviewer_pullreq_id").
+		LeftJoin("pullreq_reviewer_reminders ON "+
+			"pullreq_reviewer_reminder_pullreq_id = pullreq_reviewer_pullreq_id AND "+
+			"pullreq_reviewer_reminder_principal_id = pullreq_reviewer_principal_id").
+		LeftJoin("notification_settings ON notification_settings_principal_id = pullreq_reviewer_principal_id").
+		Where("pullreq_state = ?", enum.PullReqStateOpen).
+		Where("pullreq_is_draft = ?", false).
+		Where("pullreq_reviewer_review_decision = ?", enum.PullReqReviewDecisionPending).
+		Where("pullreq_reviewer_created < ?", pendingBefore).
+		Where("(pullreq_reviewer_reminder_sent IS NULL OR pullreq_reviewer_reminder_sent < ?)", remindedBefore).
+		Where("(pullreq_reviewer_reminder_next IS NULL OR pullreq_reviewer_reminder_next <= ?)", now).
+		Where("(notification_settings_reviewer_reminders_disabled IS NULL OR "+
+			"notification_settings_reviewer_reminders_disabled = ?)", false).
+		OrderBy("pullreq_reviewer_created asc").
+		Limit(uint64(limit)) //nolint:gosec
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to convert due reviewer reminders query to sql")
+	}
+
+	dst := make([]*pullReqReviewer, 0)
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed executing due reviewer reminders query")
+	}
+
+	result := make([]*types.PullReqReviewer, len(dst))
+	for i, v := range dst {
+		result[i] = mapPullReqReviewer(v)
+	}
+
+	return result, nil
+}
+
+// Upsert records the time the last reminder was sent to the reviewer of the pull request.
+func (s *PullReqReviewerReminderStore) Upsert(ctx context.Context, pullReqID, principalID, sent int64) error {
+	const sqlQuery = `
+	INSERT INTO pullreq_reviewer_reminders (
+		 pullreq_reviewer_reminder_pullreq_id
+		,pullreq_reviewer_reminder_principal_id
+		,pullreq_reviewer_reminder_sent
+	) values ($1, $2, $3)
+	ON CONFLICT (pullreq_reviewer_reminder_pullreq_id, pullreq_reviewer_reminder_principal_id) DO UPDATE SET
+		pullreq_reviewer_reminder_sent = EXCLUDED.pullreq_reviewer_reminder_sent`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, pullReqID, principalID, sent); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to upsert pull request reviewer reminder")
+	}
+
+	return nil
+}
+
+// Postpone records that the reviewer of the pull request shouldn't be reminded before the provided time.
+func (s *PullReqReviewerReminderStore) Postpone(ctx context.Context, pullReqID, principalID, next int64) error {
+	const sqlQuery = `
+	INSERT INTO pullreq_reviewer_reminders (
+		 pullreq_reviewer_reminder_pullreq_id
+		,pullreq_reviewer_reminder_principal_id
+		,pullreq_reviewer_reminder_sent
+		,pullreq_reviewer_reminder_next
+	) values ($1, $2, 0, $3)
+	ON CONFLICT (pullreq_reviewer_reminder_pullreq_id, pullreq_reviewer_reminder_principal_id) DO UPDATE SET
+		pullreq_reviewer_reminder_next = EXCLUDED.pullreq_reviewer_reminder_next`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, pullReqID, principalID, next); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to postpone pull request reviewer reminder")
+	}
+
+	return nil
+}
@@ -0,0 +1,241 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.ReviewRollupStore = (*ReviewRollupStore)(nil)
+
+// NewReviewRollupStore returns a new ReviewRollupStore.
+func NewReviewRollupStore(db *sqlx.DB) *ReviewRollupStore {
+	return &ReviewRollupStore{
+		db: db,
+	}
+}
+
+// ReviewRollupStore implements store.ReviewRollupStore backed by a relational database.
+type ReviewRollupStore struct {
+	db *sqlx.DB
+}
+
+type reviewRollup struct {
+	RepoID      int64                   `db:"review_rollup_repo_id"`
+	Day         int64                   `db:"review_rollup_day"`
+	Metric      enum.ReviewRollupMetric `db:"review_rollup_metric"`
+	PrincipalID int64                   `db:"review_rollup_principal_id"`
+	Count       int64                   `db:"review_rollup_count"`
+	Duration    int64                   `db:"review_rollup_duration"`
+}
+
+const (
+	dayMillis = int64(24 * time.Hour / time.Millisecond)
+
+	reviewRollupInsert = `
+	INSERT INTO review_rollups (
+		 review_rollup_repo_id
+		,review_rollup_day
+		,review_rollup_metric
+		,review_rollup_principal_id
+		,review_rollup_count
+		,review_rollup_duration
+	)`
+)
+
+// reviewRollupQueries calculate the rollups of every metric, all starting with the day given as the parameter $1.
+// Every metric is attributed to the day on which the event (e.g. the merge or the first review) happened.
+var reviewRollupQueries = map[enum.ReviewRollupMetric]string{
+	enum.ReviewRollupMetricOpened: fmt.Sprintf(`
+	SELECT
+		 pullreq_target_repo_id
+		,(pullreq_created / %[1]d) * %[1]d
+		,'%[2]s'
+		,0
+		,COUNT(*)
+		,0
+	FROM pullreqs
+	WHERE pullreq_created >= $1
+	GROUP BY pullreq_target_repo_id, (pullreq_created / %[1]d) * %[1]d`,
+		dayMillis, enum.ReviewRollupMetricOpened),
+
+	enum.ReviewRollupMetricMerged: fmt.Sprintf(`
+	SELECT
+		 pullreq_target_repo_id
+		,(pullreq_merged / %[1]d) * %[1]d
+		,'%[2]s'
+		,0
+		,COUNT(*)
+		,SUM(pullreq_merged - pullreq_created)
+	FROM pullreqs
+	WHERE pullreq_merged >= $1
+	GROUP BY pullreq_target_repo_id, (pullreq_merged / %[1]d) * %[1]d`,
+		dayMillis, enum.ReviewRollupMetricMerged),
+
+	enum.ReviewRollupMetricFirstReview: fmt.Sprintf(`
+	SELECT
+		 first_reviews.repo_id
+		,(first_reviews.first_review / %[1]d) * %[1]d
+		,'%[2]s'
+		,0
+		,COUNT(*)
+		,SUM(first_reviews.first_review - first_reviews.created)
+	FROM (
+		SELECT
+			 pullreq_target_repo_id AS repo_id
+			,pullreq_created AS created
+			,MIN(pullreq_review_created) AS first_review
+		FROM pullreqs
+		INNER JOIN pullreq_reviews ON pullreq_review_pullreq_id = pullreq_id
+		WHERE pullreq_review_created_by <> pullreq_created_by
+		GROUP BY pullreq_id, pullreq_target_repo_id, pullreq_created
+	) AS first_reviews
+	WHERE first_reviews.first_review >= $1
+	GROUP BY first_reviews.repo_id, (first_reviews.first_review / %[1]d) * %[1]d`,
+		dayMillis, enum.ReviewRollupMetricFirstReview),
+
+	enum.ReviewRollupMetricReviewed: reviewerRollupQuery(enum.ReviewRollupMetricReviewed, ""),
+	enum.ReviewRollupMetricApproved: reviewerRollupQuery(enum.ReviewRollupMetricApproved,
+		enum.PullReqReviewDecisionApproved),
+	enum.ReviewRollupMetricChangeReq: reviewerRollupQuery(enum.ReviewRollupMetricChangeReq,
+		enum.PullReqReviewDecisionChangeReq),
+}
+
+func reviewerRollupQuery(metric enum.ReviewRollupMetric, decision enum.PullReqReviewDecision) string {
+	var decisionCond string
+	if decision != "" {
+		decisionCond = fmt.Sprintf("AND pullreq_review_decision = '%s'", decision)
+	}
+
+	return fmt.Sprintf(`
+	SELECT
+		 pullreq_target_repo_id
+		,(pullreq_review_created / %[1]d) * %[1]d
+		,'%[2]s'
+		,pullreq_review_created_by
+		,COUNT(*)
+		,0
+	FROM pullreq_reviews
+	INNER JOIN pullreqs ON pullreq_id = pullreq_review_pullreq_id
+	WHERE pullreq_review_created >= $1 %[3]s
+	GROUP BY pullreq_target_repo_id, (pullreq_review_created / %[1]d) * %[1]d, pullreq_review_created_by`,
+		dayMillis, metric, decisionCond)
+}
+
+// Refresh recalculates the review rollups of all days starting with the day of the provided time.
+// The old rollups are deleted first, so the caller should run it in a transaction.
+func (s *ReviewRollupStore) Refresh(ctx context.Context, since int64) error {
+	day := (since / dayMillis) * dayMillis
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	const sqlDelete = `
+	DELETE FROM review_rollups
+	WHERE review_rollup_day >= $1`
+
+	if _, err := db.ExecContext(ctx, sqlDelete, day); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to delete review rollups")
+	}
+
+	metrics, _ := enum.GetAllReviewRollupMetrics()
+	for _, metric := range metrics {
+		if _, err := db.ExecContext(ctx, reviewRollupInsert+reviewRollupQueries[metric], day); err != nil {
+			return database.ProcessSQLErrorf(ctx, err, "Failed to calculate %s review rollups", metric)
+		}
+	}
+
+	return nil
+}
+
+// IsEmpty returns true if no review rollups have been calculated yet.
+func (s *ReviewRollupStore) IsEmpty(ctx context.Context) (bool, error) {
+	const sqlQuery = `SELECT EXISTS(SELECT 1 FROM review_rollups)`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	var exists bool
+	if err := db.QueryRowContext(ctx, sqlQuery).Scan(&exists); err != nil {
+		return false, database.ProcessSQLErrorf(ctx, err, "Failed to check existence of review rollups")
+	}
+
+	return !exists, nil
+}
+
+// Aggregate returns the review rollups matching the filter, summed up over repositories.
+func (s *ReviewRollupStore) Aggregate(
+	ctx context.Context,
+	filter *types.ReviewRollupFilter,
+) ([]types.ReviewRollup, error) {
+	stmt := database.Builder.
+		Select(`
+			 review_rollup_day
+			,review_rollup_metric
+			,review_rollup_principal_id
+			,SUM(review_rollup_count) AS review_rollup_count
+			,SUM(review_rollup_duration) AS review_rollup_duration`).
+		From("review_rollups").
+		Where("review_rollup_day >= ?", (filter.From/dayMillis)*dayMillis).
+		Where("review_rollup_day < ?", filter.To).
+		GroupBy("review_rollup_day", "review_rollup_metric", "review_rollup_principal_id").
+		OrderBy("review_rollup_day")
+
+	if filter.RepoID != 0 {
+		stmt = stmt.Where("review_rollup_repo_id = ?", filter.RepoID)
+	}
+
+	if len(filter.SpaceIDs) > 0 {
+		stmt = stmt.
+			InnerJoin("repositories ON repo_id = review_rollup_repo_id").
+			Where(squirrel.Eq{"repo_parent_id": filter.SpaceIDs}).
+			Where("repo_deleted IS NULL")
+	}
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert review rollups query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := make([]reviewRollup, 0)
+	if err = db.SelectContext(ctx, &dst, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to aggregate review rollups")
+	}
+
+	result := make([]types.ReviewRollup, len(dst))
+	for i, r := range dst {
+		result[i] = types.ReviewRollup{
+			RepoID:      filter.RepoID,
+			Day:         r.Day,
+			Metric:      r.Metric,
+			PrincipalID: r.PrincipalID,
+			Count:       r.Count,
+			Duration:    r.Duration,
+		}
+	}
+
+	return result, nil
+}
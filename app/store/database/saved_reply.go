@@ -0,0 +1,294 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/errors"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.SavedReplyStore = SavedReplyStore{}
+
+// NewSavedReplyStore returns a new SavedReplyStore.
+func NewSavedReplyStore(db *sqlx.DB) SavedReplyStore {
+	return SavedReplyStore{
+		db: db,
+	}
+}
+
+// SavedReplyStore implements a store.SavedReplyStore backed by a relational database.
+type SavedReplyStore struct {
+	db *sqlx.DB
+}
+
+type savedReply struct {
+	ID          int64  `db:"saved_reply_id"`
+	PrincipalID int64  `db:"saved_reply_principal_id"`
+	Identifier  string `db:"saved_reply_identifier"`
+	Title       string `db:"saved_reply_title"`
+	Content     string `db:"saved_reply_content"`
+	Created     int64  `db:"saved_reply_created"`
+	Updated     int64  `db:"saved_reply_updated"`
+}
+
+const (
+	savedReplyColumns = `
+		 saved_reply_id
+		,saved_reply_principal_id
+		,saved_reply_identifier
+		,saved_reply_title
+		,saved_reply_content
+		,saved_reply_created
+		,saved_reply_updated`
+
+	savedReplySelectBase = `
+		SELECT` + savedReplyColumns + `
+		FROM saved_replies`
+)
+
+// FindByIdentifier returns a saved reply given a principal ID and an identifier.
+func (s SavedReplyStore) FindByIdentifier(
+	ctx context.Context,
+	principalID int64,
+	identifier string,
+) (*types.SavedReply, error) {
+	const sqlQuery = savedReplySelectBase + `
+	WHERE saved_reply_principal_id = $1 and LOWER(saved_reply_identifier) = $2`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	result := &savedReply{}
+	if err := db.GetContext(ctx, result, sqlQuery, principalID, strings.ToLower(identifier)); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to find saved reply by principal and identifier")
+	}
+
+	reply := mapToSavedReply(result)
+
+	return &reply, nil
+}
+
+// Create creates a new saved reply.
+func (s SavedReplyStore) Create(ctx context.Context, reply *types.SavedReply) error {
+	const sqlQuery = `
+		INSERT INTO saved_replies (
+			 saved_reply_principal_id
+			,saved_reply_identifier
+			,saved_reply_title
+			,saved_reply_content
+			,saved_reply_created
+			,saved_reply_updated
+		) values (
+			 :saved_reply_principal_id
+			,:saved_reply_identifier
+			,:saved_reply_title
+			,:saved_reply_content
+			,:saved_reply_created
+			,:saved_reply_updated
+		) RETURNING saved_reply_id`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dbReply := mapToInternalSavedReply(reply)
+
+	query, arg, err := db.BindNamed(sqlQuery, &dbReply)
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind saved reply object")
+	}
+
+	if err = db.QueryRowContext(ctx, query, arg...).Scan(&dbReply.ID); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Insert saved reply query failed")
+	}
+
+	reply.ID = dbReply.ID
+
+	return nil
+}
+
+// Update updates the identifier, the title and the content of a saved reply of the principal.
+func (s SavedReplyStore) Update(ctx context.Context, reply *types.SavedReply) error {
+	const sqlQuery = `
+		UPDATE saved_replies
+		SET
+			 saved_reply_identifier = :saved_reply_identifier
+			,saved_reply_title = :saved_reply_title
+			,saved_reply_content = :saved_reply_content
+			,saved_reply_updated = :saved_reply_updated
+		WHERE saved_reply_id = :saved_reply_id AND saved_reply_principal_id = :saved_reply_principal_id`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dbReply := mapToInternalSavedReply(reply)
+
+	query, arg, err := db.BindNamed(sqlQuery, &dbReply)
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to bind saved reply object")
+	}
+
+	result, err := db.ExecContext(ctx, query, arg...)
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Update saved reply query failed")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "RowsAffected after update of saved reply failed")
+	}
+
+	if count == 0 {
+		return errors.NotFound("Saved reply not found")
+	}
+
+	return nil
+}
+
+// DeleteByIdentifier deletes a saved reply.
+func (s SavedReplyStore) DeleteByIdentifier(ctx context.Context, principalID int64, identifier string) error {
+	const sqlQuery = `
+		DELETE FROM saved_replies
+		WHERE saved_reply_principal_id = $1 and LOWER(saved_reply_identifier) = $2`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	result, err := db.ExecContext(ctx, sqlQuery, principalID, strings.ToLower(identifier))
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Delete saved reply query failed")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "RowsAffected after delete of saved reply failed")
+	}
+
+	if count == 0 {
+		return errors.NotFound("Saved reply not found")
+	}
+
+	return nil
+}
+
+// Count returns the number of saved replies for the principal that match the provided filter.
+func (s SavedReplyStore) Count(
+	ctx context.Context,
+	principalID int64,
+	filter *types.ListQueryFilter,
+) (int, error) {
+	stmt := database.Builder.
+		Select("count(*)").
+		From("saved_replies").
+		Where("saved_reply_principal_id = ?", principalID)
+
+	stmt = s.applyQueryFilter(stmt, filter)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	var count int
+
+	if err := db.QueryRowContext(ctx, sql, args...).Scan(&count); err != nil {
+		return 0, database.ProcessSQLErrorf(ctx, err, "failed to execute count saved replies query")
+	}
+
+	return count, nil
+}
+
+// List returns the saved replies for the principal that match the provided filter.
+func (s SavedReplyStore) List(
+	ctx context.Context,
+	principalID int64,
+	filter *types.ListQueryFilter,
+) ([]types.SavedReply, error) {
+	stmt := database.Builder.
+		Select(savedReplyColumns).
+		From("saved_replies").
+		Where("saved_reply_principal_id = ?", principalID).
+		OrderBy("saved_reply_identifier ASC").
+		Limit(database.Limit(filter.Size)).
+		Offset(database.Offset(filter.Page, filter.Size))
+
+	stmt = s.applyQueryFilter(stmt, filter)
+
+	sql, args, err := stmt.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert query to sql: %w", err)
+	}
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	replies := make([]savedReply, 0)
+	if err = db.SelectContext(ctx, &replies, sql, args...); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "failed to execute list saved replies query")
+	}
+
+	return mapToSavedReplies(replies), nil
+}
+
+func (SavedReplyStore) applyQueryFilter(
+	stmt squirrel.SelectBuilder,
+	filter *types.ListQueryFilter,
+) squirrel.SelectBuilder {
+	if filter.Query != "" {
+		query := fmt.Sprintf("%%%s%%", strings.ToLower(filter.Query))
+		stmt = stmt.Where("(LOWER(saved_reply_identifier) LIKE ? OR LOWER(saved_reply_title) LIKE ?)",
+			query, query)
+	}
+
+	return stmt
+}
+
+func mapToInternalSavedReply(in *types.SavedReply) savedReply {
+	return savedReply{
+		ID:          in.ID,
+		PrincipalID: in.PrincipalID,
+		Identifier:  in.Identifier,
+		Title:       in.Title,
+		Content:     in.Content,
+		Created:     in.Created,
+		Updated:     in.Updated,
+	}
+}
+
+func mapToSavedReply(in *savedReply) types.SavedReply {
+	return types.SavedReply{
+		ID:          in.ID,
+		PrincipalID: in.PrincipalID,
+		Identifier:  in.Identifier,
+		Title:       in.Title,
+		Content:     in.Content,
+		Created:     in.Created,
+		Updated:     in.Updated,
+	}
+}
+
+func mapToSavedReplies(replies []savedReply) []types.SavedReply {
+	res := make([]types.SavedReply, len(replies))
+	for i := 0; i < len(replies); i++ {
+		res[i] = mapToSavedReply(&replies[i])
+	}
+	return res
+}
@@ -0,0 +1,200 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/harness/gitness/app/store/database"
+	gitness_errors "github.com/harness/gitness/errors"
+	gitness_store "github.com/harness/gitness/store"
+	"github.com/harness/gitness/types"
+)
+
+// nolint:gocognit // it's a unit test
+func TestSavedReplyStore(t *testing.T) {
+	db, teardown := setupDB(t)
+	defer teardown()
+
+	principalStore, _, _, _ := setupStores(t, db)
+	replyStore := database.NewSavedReplyStore(db)
+
+	ctx := context.Background()
+
+	otherUserID := userID + 1
+
+	createUser(ctx, t, principalStore)
+	if err := principalStore.CreateUser(ctx,
+		&types.User{ID: otherUserID, UID: "user_other", Email: "other@example.com"}); err != nil {
+		t.Fatalf("failed to create user %v", err)
+	}
+
+	replies := []*types.SavedReply{
+		{PrincipalID: userID, Identifier: "lgtm", Title: "Looks good", Content: "LGTM!"},
+		{PrincipalID: userID, Identifier: "nit", Title: "Nitpick", Content: "Nit: "},
+		{PrincipalID: userID, Identifier: "tests", Title: "Missing tests", Content: "Please add tests."},
+		{PrincipalID: otherUserID, Identifier: "lgtm", Title: "Ship it", Content: "Ship it!"},
+	}
+	for _, reply := range replies {
+		if err := replyStore.Create(ctx, reply); err != nil {
+			t.Fatalf("failed to create saved reply %v", err)
+		}
+	}
+
+	t.Run("identifiers are unique per principal", func(t *testing.T) {
+		err := replyStore.Create(ctx, &types.SavedReply{
+			PrincipalID: userID, Identifier: "LGTM", Title: "Duplicate", Content: "Duplicate",
+		})
+		if !errors.Is(err, gitness_store.ErrDuplicate) {
+			t.Fatalf("err = %v, want %v", err, gitness_store.ErrDuplicate)
+		}
+	})
+
+	t.Run("find is scoped to the principal", func(t *testing.T) {
+		reply, err := replyStore.FindByIdentifier(ctx, otherUserID, "LGTM")
+		if err != nil {
+			t.Fatalf("failed to find saved reply %v", err)
+		}
+		if reply.ID != replies[3].ID || reply.Content != "Ship it!" {
+			t.Errorf("found saved reply %+v, want %+v", reply, replies[3])
+		}
+
+		_, err = replyStore.FindByIdentifier(ctx, otherUserID, "nit")
+		if !errors.Is(err, gitness_store.ErrResourceNotFound) {
+			t.Fatalf("err = %v, want %v", err, gitness_store.ErrResourceNotFound)
+		}
+	})
+
+	t.Run("list and count are scoped to the principal", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			filter  types.ListQueryFilter
+			want    []string
+			wantCnt int
+		}{
+			{
+				name:    "all",
+				filter:  types.ListQueryFilter{Pagination: types.Pagination{Page: 1, Size: 10}},
+				want:    []string{"lgtm", "nit", "tests"},
+				wantCnt: 3,
+			},
+			{
+				name:    "paginated",
+				filter:  types.ListQueryFilter{Pagination: types.Pagination{Page: 2, Size: 2}},
+				want:    []string{"tests"},
+				wantCnt: 3,
+			},
+			{
+				name: "query matches the title",
+				filter: types.ListQueryFilter{
+					Pagination: types.Pagination{Page: 1, Size: 10},
+					Query:      "missing",
+				},
+				want:    []string{"tests"},
+				wantCnt: 1,
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				list, err := replyStore.List(ctx, userID, &test.filter)
+				if err != nil {
+					t.Fatalf("failed to list saved replies %v", err)
+				}
+
+				identifiers := make([]string, len(list))
+				for i, reply := range list {
+					identifiers[i] = reply.Identifier
+					if reply.PrincipalID != userID {
+						t.Errorf("listed saved reply %q of principal %d", reply.Identifier, reply.PrincipalID)
+					}
+				}
+				if len(identifiers) != len(test.want) {
+					t.Fatalf("identifiers = %v, want %v", identifiers, test.want)
+				}
+				for i := range identifiers {
+					if identifiers[i] != test.want[i] {
+						t.Fatalf("identifiers = %v, want %v", identifiers, test.want)
+					}
+				}
+
+				count, err := replyStore.Count(ctx, userID, &test.filter)
+				if err != nil {
+					t.Fatalf("failed to count saved replies %v", err)
+				}
+				if count != test.wantCnt {
+					t.Errorf("count = %d, want %d", count, test.wantCnt)
+				}
+			})
+		}
+	})
+
+	t.Run("update requires the owner", func(t *testing.T) {
+		reply := *replies[1]
+		reply.PrincipalID = otherUserID
+		reply.Content = "Hijacked"
+
+		err := replyStore.Update(ctx, &reply)
+		if !gitness_errors.IsNotFound(err) {
+			t.Fatalf("err = %v, want not found", err)
+		}
+
+		reply.PrincipalID = userID
+		reply.Identifier = "Nitpick"
+		reply.Content = "Nit (non-blocking): "
+		if err = replyStore.Update(ctx, &reply); err != nil {
+			t.Fatalf("failed to update saved reply %v", err)
+		}
+
+		updated, err := replyStore.FindByIdentifier(ctx, userID, "nitpick")
+		if err != nil {
+			t.Fatalf("failed to find saved reply %v", err)
+		}
+		if updated.Content != "Nit (non-blocking): " {
+			t.Errorf("content = %q, want %q", updated.Content, "Nit (non-blocking): ")
+		}
+	})
+
+	t.Run("update to an existing identifier", func(t *testing.T) {
+		reply := *replies[2]
+		reply.Identifier = "lgtm"
+
+		err := replyStore.Update(ctx, &reply)
+		if !errors.Is(err, gitness_store.ErrDuplicate) {
+			t.Fatalf("err = %v, want %v", err, gitness_store.ErrDuplicate)
+		}
+	})
+
+	t.Run("delete is scoped to the principal", func(t *testing.T) {
+		err := replyStore.DeleteByIdentifier(ctx, otherUserID, "tests")
+		if !gitness_errors.IsNotFound(err) {
+			t.Fatalf("err = %v, want not found", err)
+		}
+
+		if err = replyStore.DeleteByIdentifier(ctx, otherUserID, "LGTM"); err != nil {
+			t.Fatalf("failed to delete saved reply %v", err)
+		}
+
+		_, err = replyStore.FindByIdentifier(ctx, otherUserID, "lgtm")
+		if !errors.Is(err, gitness_store.ErrResourceNotFound) {
+			t.Fatalf("err = %v, want %v", err, gitness_store.ErrResourceNotFound)
+		}
+		if _, err = replyStore.FindByIdentifier(ctx, userID, "lgtm"); err != nil {
+			t.Fatalf("saved reply of another principal got deleted: %v", err)
+		}
+	})
+}
@@ -0,0 +1,131 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+
+	"github.com/harness/gitness/app/store"
+	"github.com/harness/gitness/store/database"
+	"github.com/harness/gitness/store/database/dbtx"
+	"github.com/harness/gitness/types"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var _ store.SlackMessageStore = (*SlackMessageStore)(nil)
+
+// NewSlackMessageStore returns a new SlackMessageStore.
+func NewSlackMessageStore(db *sqlx.DB) *SlackMessageStore {
+	return &SlackMessageStore{
+		db: db,
+	}
+}
+
+// SlackMessageStore implements store.SlackMessageStore backed by a relational database.
+type SlackMessageStore struct {
+	db *sqlx.DB
+}
+
+// slackMessage is used to fetch slack messages from the database.
+type slackMessage struct {
+	ExecutionID int64  `db:"slack_message_execution_id"`
+	Channel     string `db:"slack_message_channel"`
+	TS          string `db:"slack_message_ts"`
+	Created     int64  `db:"slack_message_created"`
+}
+
+const (
+	slackMessageColumns = `
+		 slack_message_execution_id
+		,slack_message_channel
+		,slack_message_ts
+		,slack_message_created`
+)
+
+// Find returns the slack message of the execution.
+func (s *SlackMessageStore) Find(ctx context.Context, executionID int64) (*types.SlackMessage, error) {
+	const sqlQuery = `
+	SELECT` + slackMessageColumns + `
+	FROM slack_messages
+	WHERE slack_message_execution_id = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	dst := &slackMessage{}
+	if err := db.GetContext(ctx, dst, sqlQuery, executionID); err != nil {
+		return nil, database.ProcessSQLErrorf(ctx, err, "Failed to find slack message")
+	}
+
+	return &types.SlackMessage{
+		ExecutionID: dst.ExecutionID,
+		Channel:     dst.Channel,
+		TS:          dst.TS,
+		Created:     dst.Created,
+	}, nil
+}
+
+// Upsert creates or replaces the slack message of the execution.
+func (s *SlackMessageStore) Upsert(ctx context.Context, msg *types.SlackMessage) error {
+	const sqlQuery = `
+	INSERT INTO slack_messages (
+		 slack_message_execution_id
+		,slack_message_channel
+		,slack_message_ts
+		,slack_message_created
+	) values ($1, $2, $3, $4)
+	ON CONFLICT (slack_message_execution_id) DO UPDATE SET
+		 slack_message_channel = EXCLUDED.slack_message_channel
+		,slack_message_ts = EXCLUDED.slack_message_ts
+		,slack_message_created = EXCLUDED.slack_message_created`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, msg.ExecutionID, msg.Channel, msg.TS, msg.Created); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to upsert slack message")
+	}
+
+	return nil
+}
+
+// Delete deletes the slack message of the execution.
+func (s *SlackMessageStore) Delete(ctx context.Context, executionID int64) error {
+	const sqlQuery = `
+	DELETE FROM slack_messages
+	WHERE slack_message_execution_id = $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, executionID); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to delete slack message")
+	}
+
+	return nil
+}
+
+// DeleteBefore deletes the slack messages created before the provided time.
+func (s *SlackMessageStore) DeleteBefore(ctx context.Context, before int64) error {
+	const sqlQuery = `
+	DELETE FROM slack_messages
+	WHERE slack_message_created < $1`
+
+	db := dbtx.GetAccessor(ctx, s.db)
+
+	if _, err := db.ExecContext(ctx, sqlQuery, before); err != nil {
+		return database.ProcessSQLErrorf(ctx, err, "Failed to delete old slack messages")
+	}
+
+	return nil
+}
@@ -16,6 +16,7 @@ package database
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -59,14 +60,15 @@ type webhook struct {
 
 	Identifier string `db:"webhook_uid"`
 	// TODO [CODE-1364]: Remove once UID/Identifier migration is completed.
-	DisplayName           string      `db:"webhook_display_name"`
-	Description           string      `db:"webhook_description"`
-	URL                   string      `db:"webhook_url"`
-	Secret                string      `db:"webhook_secret"`
-	Enabled               bool        `db:"webhook_enabled"`
-	Insecure              bool        `db:"webhook_insecure"`
-	Triggers              string      `db:"webhook_triggers"`
-	LatestExecutionResult null.String `db:"webhook_latest_execution_result"`
+	DisplayName           string          `db:"webhook_display_name"`
+	Description           string          `db:"webhook_description"`
+	URL                   string          `db:"webhook_url"`
+	Secret                string          `db:"webhook_secret"`
+	Enabled               bool            `db:"webhook_enabled"`
+	Insecure              bool            `db:"webhook_insecure"`
+	Triggers              string          `db:"webhook_triggers"`
+	ExtraHeaders          json.RawMessage `db:"webhook_extra_headers"`
+	LatestExecutionResult null.String     `db:"webhook_latest_execution_result"`
 }
 
 const (
@@ -86,6 +88,7 @@ const (
 		,webhook_enabled
 		,webhook_insecure
 		,webhook_triggers
+		,webhook_extra_headers
 		,webhook_latest_execution_result
 		,webhook_internal`
 
@@ -172,6 +175,7 @@ func (s *WebhookStore) Create(ctx context.Context, hook *types.Webhook) error {
 			,webhook_enabled
 			,webhook_insecure
 			,webhook_triggers
+			,webhook_extra_headers
 			,webhook_latest_execution_result
 			,webhook_internal
 		) values (
@@ -188,6 +192,7 @@ func (s *WebhookStore) Create(ctx context.Context, hook *types.Webhook) error {
 			,:webhook_enabled
 			,:webhook_insecure
 			,:webhook_triggers
+			,:webhook_extra_headers
 			,:webhook_latest_execution_result
 			,:webhook_internal
 		) RETURNING webhook_id`
@@ -226,6 +231,7 @@ func (s *WebhookStore) Update(ctx context.Context, hook *types.Webhook) error {
 			,webhook_enabled = :webhook_enabled
 			,webhook_insecure = :webhook_insecure
 			,webhook_triggers = :webhook_triggers
+			,webhook_extra_headers = :webhook_extra_headers
 			,webhook_latest_execution_result = :webhook_latest_execution_result
 			,webhook_internal = :webhook_internal
 		WHERE webhook_id = :webhook_id and webhook_version = :webhook_version - 1`
@@ -465,6 +471,12 @@ func mapToWebhook(hook *webhook) (*types.Webhook, error) {
 		Internal:              hook.Internal,
 	}
 
+	if len(hook.ExtraHeaders) > 0 {
+		if err := json.Unmarshal(hook.ExtraHeaders, &res.ExtraHeaders); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal extra headers of hook %d: %w", hook.ID, err)
+		}
+	}
+
 	switch {
 	case hook.RepoID.Valid && hook.SpaceID.Valid:
 		return nil, fmt.Errorf("both repoID and spaceID are set for hook %d", hook.ID)
@@ -501,6 +513,17 @@ func mapToInternalWebhook(hook *types.Webhook) (*webhook, error) {
 		Internal:              hook.Internal,
 	}
 
+	extraHeaders := hook.ExtraHeaders
+	if extraHeaders == nil {
+		extraHeaders = []types.WebhookExtraHeader{}
+	}
+
+	var err error
+	res.ExtraHeaders, err = json.Marshal(extraHeaders)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal extra headers: %w", err)
+	}
+
 	switch hook.ParentType {
 	case enum.WebhookParentRepo:
 		res.RepoID = null.IntFrom(hook.ParentID)
@@ -53,6 +53,15 @@ var WireSet = wire.NewSet(
 	ProvidePullReqReviewStore,
 	ProvidePullReqReviewerStore,
 	ProvidePullReqFileViewStore,
+	ProvidePullReqReactionStore,
+	ProvideMergeQueueStore,
+	ProvidePullReqEditStore,
+	ProvidePullReqCommentRevisionStore,
+	ProvidePullReqMentionStore,
+	ProvidePullReqFileStatsStore,
+	ProvidePullReqDependencyStore,
+	ProvidePullReqReviewerReminderStore,
+	ProvideNotificationSettingsStore,
 	ProvideWebhookStore,
 	ProvideWebhookExecutionStore,
 	ProvideSettingsStore,
@@ -63,6 +72,9 @@ var WireSet = wire.NewSet(
 	ProvideTriggerStore,
 	ProvidePluginStore,
 	ProvidePublicKeyStore,
+	ProvideSavedReplyStore,
+	ProvideReviewRollupStore,
+	ProvideSlackMessageStore,
 	ProvideInfraProviderConfigStore,
 	ProvideInfraProviderResourceStore,
 	ProvideGitspaceConfigStore,
@@ -287,6 +299,16 @@ func ProvidePullReqFileViewStore(db *sqlx.DB) store.PullReqFileViewStore {
 	return NewPullReqFileViewStore(db)
 }
 
+// ProvideMergeQueueStore provides a merge queue store.
+func ProvideMergeQueueStore(db *sqlx.DB) store.MergeQueueStore {
+	return NewMergeQueueStore(db)
+}
+
+// ProvidePullReqReactionStore provides a pull request reaction store.
+func ProvidePullReqReactionStore(db *sqlx.DB) store.PullReqReactionStore {
+	return NewPullReqReactionStore(db)
+}
+
 // ProvideWebhookStore provides a webhook store.
 func ProvideWebhookStore(db *sqlx.DB) store.WebhookStore {
 	return NewWebhookStore(db)
@@ -320,6 +342,11 @@ func ProvidePublicKeyStore(db *sqlx.DB) store.PublicKeyStore {
 	return NewPublicKeyStore(db)
 }
 
+// ProvideSavedReplyStore provides a saved reply store.
+func ProvideSavedReplyStore(db *sqlx.DB) store.SavedReplyStore {
+	return NewSavedReplyStore(db)
+}
+
 // ProvideGitspaceEventStore provides a gitspace event store.
 func ProvideGitspaceEventStore(db *sqlx.DB) store.GitspaceEventStore {
 	return NewGitspaceEventStore(db)
@@ -349,3 +376,51 @@ func ProvideInfraProviderTemplateStore(db *sqlx.DB) store.InfraProviderTemplateS
 func ProvideInfraProvisionedStore(db *sqlx.DB) store.InfraProvisionedStore {
 	return NewInfraProvisionedStore(db)
 }
+
+// ProvidePullReqEditStore provides a pull request edit history store.
+func ProvidePullReqEditStore(db *sqlx.DB, pCache store.PrincipalInfoCache) store.PullReqEditStore {
+	return NewPullReqEditStore(db, pCache)
+}
+
+// ProvidePullReqCommentRevisionStore provides a pull request comment revision history store.
+func ProvidePullReqCommentRevisionStore(
+	db *sqlx.DB,
+	pCache store.PrincipalInfoCache,
+) store.PullReqCommentRevisionStore {
+	return NewPullReqCommentRevisionStore(db, pCache)
+}
+
+// ProvidePullReqMentionStore provides a pull request mention store.
+func ProvidePullReqMentionStore(db *sqlx.DB) store.PullReqMentionStore {
+	return NewPullReqMentionStore(db)
+}
+
+// ProvidePullReqFileStatsStore provides a pull request file statistics store.
+func ProvidePullReqFileStatsStore(db *sqlx.DB) store.PullReqFileStatsStore {
+	return NewPullReqFileStatsStore(db)
+}
+
+// ProvidePullReqDependencyStore provides a pull request dependency store.
+func ProvidePullReqDependencyStore(db *sqlx.DB) store.PullReqDependencyStore {
+	return NewPullReqDependencyStore(db)
+}
+
+// ProvidePullReqReviewerReminderStore provides a pull request reviewer reminder store.
+func ProvidePullReqReviewerReminderStore(db *sqlx.DB) store.PullReqReviewerReminderStore {
+	return NewPullReqReviewerReminderStore(db)
+}
+
+// ProvideNotificationSettingsStore provides a notification settings store.
+func ProvideNotificationSettingsStore(db *sqlx.DB) store.NotificationSettingsStore {
+	return NewNotificationSettingsStore(db)
+}
+
+// ProvideReviewRollupStore provides a review rollup store.
+func ProvideReviewRollupStore(db *sqlx.DB) store.ReviewRollupStore {
+	return NewReviewRollupStore(db)
+}
+
+// ProvideSlackMessageStore provides a slack message store.
+func ProvideSlackMessageStore(db *sqlx.DB) store.SlackMessageStore {
+	return NewSlackMessageStore(db)
+}
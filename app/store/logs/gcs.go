@@ -0,0 +1,78 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/harness/gitness/app/store"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/option"
+)
+
+// NewGCSLogStore returns a new GCS log store.
+func NewGCSLogStore(ctx context.Context, bucket, prefix, keyPath string) (store.LogStore, error) {
+	var opts []option.ClientOption
+	if keyPath != "" {
+		opts = append(opts, option.WithCredentialsFile(keyPath))
+	}
+
+	client, err := storage.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCS client: %w", err)
+	}
+
+	return &gcsstore{
+		bucket: bucket,
+		prefix: prefix,
+		client: client,
+	}, nil
+}
+
+type gcsstore struct {
+	bucket string
+	prefix string
+	client *storage.Client
+}
+
+func (s *gcsstore) Find(ctx context.Context, step int64) (io.ReadCloser, error) {
+	return s.client.Bucket(s.bucket).Object(s.key(step)).NewReader(ctx)
+}
+
+func (s *gcsstore) Create(ctx context.Context, step int64, r io.Reader) error {
+	w := s.client.Bucket(s.bucket).Object(s.key(step)).NewWriter(ctx)
+	if _, err := io.Copy(w, r); err != nil {
+		_ = w.Close()
+		return err
+	}
+	// the object is only committed to the bucket once the writer is closed.
+	return w.Close()
+}
+
+func (s *gcsstore) Update(ctx context.Context, step int64, r io.Reader) error {
+	return s.Create(ctx, step, r)
+}
+
+func (s *gcsstore) Delete(ctx context.Context, step int64) error {
+	return s.client.Bucket(s.bucket).Object(s.key(step)).Delete(ctx)
+}
+
+func (s *gcsstore) key(step int64) string {
+	return path.Join(s.prefix, fmt.Sprint(step))
+}
@@ -15,6 +15,8 @@
 package logs
 
 import (
+	"context"
+
 	"github.com/harness/gitness/app/store"
 	"github.com/harness/gitness/types"
 
@@ -27,7 +29,7 @@ var WireSet = wire.NewSet(
 	ProvideLogStore,
 )
 
-func ProvideLogStore(db *sqlx.DB, config *types.Config) store.LogStore {
+func ProvideLogStore(ctx context.Context, db *sqlx.DB, config *types.Config) (store.LogStore, error) {
 	s := NewDatabaseLogStore(db)
 	if config.Logs.S3.Bucket != "" {
 		p := NewS3LogStore(
@@ -36,7 +38,19 @@ func ProvideLogStore(db *sqlx.DB, config *types.Config) store.LogStore {
 			config.Logs.S3.Endpoint,
 			config.Logs.S3.PathStyle,
 		)
-		return NewCombined(p, s)
+		return NewCombined(p, s), nil
+	}
+	if config.Logs.GCS.Bucket != "" {
+		p, err := NewGCSLogStore(
+			ctx,
+			config.Logs.GCS.Bucket,
+			config.Logs.GCS.Prefix,
+			config.Logs.GCS.KeyPath,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return NewCombined(p, s), nil
 	}
-	return s
+	return s, nil
 }
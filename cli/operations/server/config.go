@@ -41,6 +41,7 @@ import (
 	"github.com/harness/gitness/pubsub"
 	"github.com/harness/gitness/store/database"
 	"github.com/harness/gitness/types"
+	"github.com/harness/gitness/types/enum"
 
 	"github.com/kelseyhightower/envconfig"
 	"golang.org/x/text/runes"
@@ -342,6 +343,226 @@ func ProvideNotificationConfig(config *types.Config) notification.Config {
 	}
 }
 
+// ProvideReviewerReminderConfig loads the reviewer reminder job config from the main config.
+func ProvideReviewerReminderConfig(config *types.Config) notification.ReviewerReminderConfig {
+	return notification.ReviewerReminderConfig{
+		Enabled:     config.Notification.ReviewerReminder.Enabled,
+		CRON:        config.Notification.ReviewerReminder.CRON,
+		MaxDuration: config.Notification.ReviewerReminder.MaxDuration,
+		SLA:         config.Notification.ReviewerReminder.SLA,
+		Interval:    config.Notification.ReviewerReminder.Interval,
+		BatchSize:   config.Notification.ReviewerReminder.BatchSize,
+	}
+}
+
+// ProvidePipelineNotificationConfig loads the pipeline notification config from the main config.
+// It fails if the configured message templates are invalid.
+func ProvidePipelineNotificationConfig(config *types.Config) (notification.PipelineConfig, error) {
+	pipelineConfig := config.Notification.Pipeline
+
+	templateConfig := notification.PipelineTemplateConfig{
+		Title: pipelineConfig.Template.Title,
+		Body:  pipelineConfig.Template.Body,
+	}
+	if err := notification.ValidatePipelineTemplates(templateConfig); err != nil {
+		return notification.PipelineConfig{}, fmt.Errorf("invalid pipeline notification template: %w", err)
+	}
+
+	defaultSeverity := strings.ToLower(pipelineConfig.PagerDuty.DefaultSeverity)
+	if !isPagerDutySeverity(defaultSeverity) {
+		defaultSeverity = "error"
+	}
+
+	return notification.PipelineConfig{
+		EventReaderName: config.InstanceID,
+		Concurrency:     config.Notification.Concurrency,
+		MaxRetries:      config.Notification.MaxRetries,
+		Statuses:        parseCIStatuses(pipelineConfig.Statuses),
+		NotifyAuthor:    pipelineConfig.NotifyAuthor,
+		Recipients:      pipelineConfig.Recipients,
+		Filter:          parsePipelineFilter(pipelineConfig.PipelineNotificationFilter),
+		Telegram: notification.TelegramConfig{
+			APIURL:        pipelineConfig.Telegram.APIURL,
+			BotToken:      pipelineConfig.Telegram.BotToken,
+			ChatID:        pipelineConfig.Telegram.ChatID,
+			Statuses:      parseCIStatuses(pipelineConfig.Telegram.Statuses),
+			SilentSuccess: pipelineConfig.Telegram.SilentSuccess,
+			Filter:        parsePipelineFilter(pipelineConfig.Telegram.PipelineNotificationFilter),
+		},
+		PagerDuty: notification.PagerDutyConfig{
+			EventsURL:       pipelineConfig.PagerDuty.EventsURL,
+			RoutingKey:      pipelineConfig.PagerDuty.RoutingKey,
+			Branches:        parsePagerDutyBranches(pipelineConfig.PagerDuty.Branches, defaultSeverity),
+			DefaultSeverity: defaultSeverity,
+			Filter: parsePipelineFilter(types.PipelineNotificationFilter{
+				OnChange: pipelineConfig.PagerDuty.OnChange,
+				Events:   pipelineConfig.PagerDuty.Events,
+				Repos:    pipelineConfig.PagerDuty.Repos,
+			}),
+		},
+		Ntfy: notification.NtfyConfig{
+			URL:      pipelineConfig.Ntfy.URL,
+			Topic:    pipelineConfig.Ntfy.Topic,
+			Token:    pipelineConfig.Ntfy.Token,
+			Statuses: parseCIStatuses(pipelineConfig.Ntfy.Statuses),
+			Filter:   parsePipelineFilter(pipelineConfig.Ntfy.PipelineNotificationFilter),
+		},
+		Gotify: notification.GotifyConfig{
+			URL:      pipelineConfig.Gotify.URL,
+			Token:    pipelineConfig.Gotify.Token,
+			Statuses: parseCIStatuses(pipelineConfig.Gotify.Statuses),
+			Filter:   parsePipelineFilter(pipelineConfig.Gotify.PipelineNotificationFilter),
+		},
+		IRC: notification.IRCConfig{
+			Server:       pipelineConfig.IRC.Server,
+			TLS:          pipelineConfig.IRC.TLS,
+			Nick:         pipelineConfig.IRC.Nick,
+			Channels:     pipelineConfig.IRC.Channels,
+			SASLUsername: pipelineConfig.IRC.SASLUsername,
+			SASLPassword: pipelineConfig.IRC.SASLPassword,
+			Statuses:     parseCIStatuses(pipelineConfig.IRC.Statuses),
+			Filter:       parsePipelineFilter(pipelineConfig.IRC.PipelineNotificationFilter),
+		},
+		Mattermost: notification.MattermostConfig{
+			WebhookURL: pipelineConfig.Mattermost.WebhookURL,
+			Channel:    pipelineConfig.Mattermost.Channel,
+			Username:   pipelineConfig.Mattermost.Username,
+			Statuses:   parseCIStatuses(pipelineConfig.Mattermost.Statuses),
+			Filter:     parsePipelineFilter(pipelineConfig.Mattermost.PipelineNotificationFilter),
+		},
+		RocketChat: notification.RocketChatConfig{
+			WebhookURL: pipelineConfig.RocketChat.WebhookURL,
+			Channel:    pipelineConfig.RocketChat.Channel,
+			Alias:      pipelineConfig.RocketChat.Alias,
+			Statuses:   parseCIStatuses(pipelineConfig.RocketChat.Statuses),
+			Filter:     parsePipelineFilter(pipelineConfig.RocketChat.PipelineNotificationFilter),
+		},
+		Pushover: notification.PushoverConfig{
+			APIURL:          pipelineConfig.Pushover.APIURL,
+			AppToken:        pipelineConfig.Pushover.AppToken,
+			UserKeys:        pipelineConfig.Pushover.UserKeys,
+			Statuses:        parseCIStatuses(pipelineConfig.Pushover.Statuses),
+			SuccessPriority: clampPushoverPriority(pipelineConfig.Pushover.SuccessPriority),
+			FailurePriority: clampPushoverPriority(pipelineConfig.Pushover.FailurePriority),
+			Retry:           pipelineConfig.Pushover.Retry,
+			Expire:          pipelineConfig.Pushover.Expire,
+			Filter:          parsePipelineFilter(pipelineConfig.Pushover.PipelineNotificationFilter),
+		},
+		SNS: notification.SNSConfig{
+			TopicARN:        pipelineConfig.SNS.TopicARN,
+			Region:          pipelineConfig.SNS.Region,
+			Endpoint:        pipelineConfig.SNS.Endpoint,
+			AccessKeyID:     pipelineConfig.SNS.AccessKeyID,
+			SecretAccessKey: pipelineConfig.SNS.SecretAccessKey,
+			Statuses:        parseCIStatuses(pipelineConfig.SNS.Statuses),
+			Filter:          parsePipelineFilter(pipelineConfig.SNS.PipelineNotificationFilter),
+		},
+		Twilio: notification.TwilioConfig{
+			APIURL:          pipelineConfig.Twilio.APIURL,
+			AccountSID:      pipelineConfig.Twilio.AccountSID,
+			AuthToken:       pipelineConfig.Twilio.AuthToken,
+			From:            pipelineConfig.Twilio.From,
+			To:              pipelineConfig.Twilio.To,
+			Statuses:        parseCIStatuses(pipelineConfig.Twilio.Statuses),
+			Steps:           pipelineConfig.Twilio.Steps,
+			RateLimit:       pipelineConfig.Twilio.RateLimit,
+			RateLimitWindow: pipelineConfig.Twilio.RateLimitWindow,
+			Filter:          parsePipelineFilter(pipelineConfig.Twilio.PipelineNotificationFilter),
+		},
+		Slack: notification.SlackConfig{
+			WebhookURL: pipelineConfig.Slack.WebhookURL,
+			APIURL:     pipelineConfig.Slack.APIURL,
+			BotToken:   pipelineConfig.Slack.BotToken,
+			Channel:    pipelineConfig.Slack.Channel,
+			Thread:     pipelineConfig.Slack.Thread,
+			Statuses:   parseCIStatuses(pipelineConfig.Slack.Statuses),
+			Filter:     parsePipelineFilter(pipelineConfig.Slack.PipelineNotificationFilter),
+		},
+		Template: templateConfig,
+	}, nil
+}
+
+// parsePagerDutyBranches parses the "pattern=severity" entries of the pagerduty branch configuration.
+// Entries without or with an unknown severity use the default severity.
+func parsePagerDutyBranches(raw []string, defaultSeverity string) []notification.PagerDutyBranch {
+	branches := make([]notification.PagerDutyBranch, 0, len(raw))
+	for _, entry := range raw {
+		pattern, severity, _ := strings.Cut(strings.TrimSpace(entry), "=")
+		if pattern == "" {
+			continue
+		}
+
+		severity = strings.ToLower(strings.TrimSpace(severity))
+		if !isPagerDutySeverity(severity) {
+			severity = defaultSeverity
+		}
+
+		branches = append(branches, notification.PagerDutyBranch{Pattern: pattern, Severity: severity})
+	}
+
+	return branches
+}
+
+// clampPushoverPriority ensures the priority is within the range supported by pushover.
+func clampPushoverPriority(priority int) int {
+	const (
+		minPriority = -2
+		maxPriority = 2
+	)
+
+	return max(minPriority, min(maxPriority, priority))
+}
+
+func isPagerDutySeverity(severity string) bool {
+	switch severity {
+	case "critical", "error", "warning", "info":
+		return true
+	default:
+		return false
+	}
+}
+
+// parseCIStatuses converts the configured statuses to CIStatus values, invalid values are ignored.
+func parseCIStatuses(raw []string) []enum.CIStatus {
+	statuses := make([]enum.CIStatus, 0, len(raw))
+	for _, status := range raw {
+		status = strings.ToLower(strings.TrimSpace(status))
+		if status == "" {
+			continue
+		}
+		ciStatus, ok := enum.CIStatus(status).Sanitize()
+		if !ok {
+			continue
+		}
+		statuses = append(statuses, ciStatus)
+	}
+
+	return statuses
+}
+
+// parsePipelineFilter returns the filter of a pipeline notification channel, invalid events are skipped.
+func parsePipelineFilter(filter types.PipelineNotificationFilter) notification.PipelineFilterConfig {
+	events := make([]enum.TriggerEvent, 0, len(filter.Events))
+	for _, event := range filter.Events {
+		event = strings.ToLower(strings.TrimSpace(event))
+		if event == "" {
+			continue
+		}
+		triggerEvent, ok := enum.TriggerEvent(event).Sanitize()
+		if !ok {
+			continue
+		}
+		events = append(events, triggerEvent)
+	}
+
+	return notification.PipelineFilterConfig{
+		OnChange: filter.OnChange,
+		Branches: filter.Branches,
+		Events:   events,
+		Repos:    filter.Repos,
+	}
+}
+
 // ProvideTriggerConfig loads the trigger service config from the main config.
 func ProvideTriggerConfig(config *types.Config) trigger.Config {
 	return trigger.Config{
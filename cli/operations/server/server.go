@@ -105,6 +105,21 @@ func (c *command) run(*kingpin.ParseContext) error {
 			return err
 		}
 
+		if err := system.services.MergeQueue.Register(gCtx); err != nil {
+			log.Error().Err(err).Msg("failed to register merge queue service")
+			return err
+		}
+
+		if err := system.services.ReviewerReminder.Register(gCtx); err != nil {
+			log.Error().Err(err).Msg("failed to register reviewer reminder job")
+			return err
+		}
+
+		if err := system.services.ReviewAnalytics.Register(gCtx); err != nil {
+			log.Error().Err(err).Msg("failed to register review analytics service")
+			return err
+		}
+
 		return system.services.JobScheduler.Run(gCtx)
 	})
 
@@ -94,6 +94,7 @@ import (
 	"github.com/harness/gitness/app/services/keywordsearch"
 	svclabel "github.com/harness/gitness/app/services/label"
 	locker "github.com/harness/gitness/app/services/locker"
+	"github.com/harness/gitness/app/services/mergequeue"
 	messagingservice "github.com/harness/gitness/app/services/messaging"
 	"github.com/harness/gitness/app/services/metric"
 	migrateservice "github.com/harness/gitness/app/services/migrate"
@@ -104,6 +105,7 @@ import (
 	"github.com/harness/gitness/app/services/publickey"
 	pullreqservice "github.com/harness/gitness/app/services/pullreq"
 	reposervice "github.com/harness/gitness/app/services/repo"
+	"github.com/harness/gitness/app/services/reviewanalytics"
 	secretservice "github.com/harness/gitness/app/services/secret"
 	"github.com/harness/gitness/app/services/settings"
 	systemsvc "github.com/harness/gitness/app/services/system"
@@ -194,6 +196,8 @@ func initSystem(ctx context.Context, config *types.Config) (*cliserver.System, e
 		events.WireSet,
 		cliserver.ProvideWebhookConfig,
 		cliserver.ProvideNotificationConfig,
+		cliserver.ProvideReviewerReminderConfig,
+		cliserver.ProvidePipelineNotificationConfig,
 		webhook.WireSet,
 		cliserver.ProvideTriggerConfig,
 		trigger.WireSet,
@@ -208,6 +212,8 @@ func initSystem(ctx context.Context, config *types.Config) (*cliserver.System, e
 		job.WireSet,
 		cliserver.ProvideCleanupConfig,
 		cleanup.WireSet,
+		mergequeue.WireSet,
+		reviewanalytics.WireSet,
 		codecomments.WireSet,
 		protection.WireSet,
 		checkcontroller.WireSet,
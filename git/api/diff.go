@@ -27,6 +27,7 @@ import (
 
 	"github.com/harness/gitness/errors"
 	"github.com/harness/gitness/git/command"
+	"github.com/harness/gitness/git/enum"
 	"github.com/harness/gitness/git/parser"
 	"github.com/harness/gitness/git/sha"
 	"github.com/harness/gitness/types"
@@ -35,11 +36,39 @@ import (
 type FileDiffRequest struct {
 	Path      string `json:"path"`
 	StartLine int    `json:"start_line"`
-	EndLine   int    `json:"-"` // warning: changes are possible and this field may not exist in the future
+	EndLine   int    `json:"end_line,omitempty"`
 }
 
 type FileDiffRequests []FileDiffRequest
 
+// DiffOptions holds the options affecting how a diff is generated.
+type DiffOptions struct {
+	IgnoreWhitespace enum.DiffIgnoreWhitespace
+	// ContextLines is the number of context lines around each change. Nil means the git default (3 lines).
+	ContextLines *int
+}
+
+// flags returns the git diff flags for the options.
+func (o DiffOptions) flags() []string {
+	var flags []string
+
+	switch o.IgnoreWhitespace {
+	case enum.DiffIgnoreWhitespaceAll:
+		flags = append(flags, "--ignore-all-space")
+	case enum.DiffIgnoreWhitespaceChange:
+		flags = append(flags, "--ignore-space-change")
+	case enum.DiffIgnoreWhitespaceEOL:
+		flags = append(flags, "--ignore-space-at-eol")
+	case enum.DiffIgnoreWhitespaceNone:
+	}
+
+	if o.ContextLines != nil {
+		flags = append(flags, "-U"+strconv.Itoa(*o.ContextLines))
+	}
+
+	return flags
+}
+
 type DiffShortStat struct {
 	Files     int
 	Additions int
@@ -163,6 +192,7 @@ func (g *Git) RawDiff(
 	baseRef string,
 	headRef string,
 	mergeBase bool,
+	opts DiffOptions,
 	alternates []string,
 	files ...FileDiffRequest,
 ) error {
@@ -182,12 +212,16 @@ func (g *Git) RawDiff(
 
 	cmd := command.New("diff",
 		command.WithFlag("-M"),
+		command.WithFlag("-C"),
 		command.WithFlag("--full-index"),
 		command.WithAlternateObjectDirs(alternates...),
 	)
 	if mergeBase {
 		cmd.Add(command.WithFlag("--merge-base"))
 	}
+	for _, flag := range opts.flags() {
+		cmd.Add(command.WithFlag(flag))
+	}
 
 	perFileDiffRequired := false
 	paths := make([]string, 0, len(files))
@@ -286,6 +320,40 @@ func (g *Git) CommitDiff(
 	return nil
 }
 
+// FormatPatch writes the commits reachable from the headRef, but not from the baseRef,
+// to the writer as a series of patches in the mbox format.
+func (g *Git) FormatPatch(
+	ctx context.Context,
+	repoPath string,
+	baseRef string,
+	headRef string,
+	alternates []string,
+	w io.Writer,
+) error {
+	if repoPath == "" {
+		return ErrRepositoryPathEmpty
+	}
+	if baseRef == "" || headRef == "" {
+		return errors.InvalidArgument("git revisions cannot be empty")
+	}
+
+	cmd := command.New("format-patch",
+		command.WithFlag("--stdout"),
+		command.WithFlag("--full-index"),
+		command.WithFlag("--binary"),
+		command.WithAlternateObjectDirs(alternates...),
+		command.WithArg(baseRef+".."+headRef),
+	)
+
+	if err := cmd.Run(ctx,
+		command.WithDir(repoPath),
+		command.WithStdout(w),
+	); err != nil {
+		return processGitErrorf(err, "format patch error")
+	}
+	return nil
+}
+
 func (g *Git) DiffShortStat(
 	ctx context.Context,
 	repoPath string,
@@ -341,6 +409,7 @@ func (g *Git) GetDiffHunkHeaders(
 			command.WithFlag("--full-index"),
 			command.WithFlag("--no-color"),
 			command.WithFlag("--unified=0"),
+			command.WithFlag("--find-renames"),
 			command.WithArg(sourceRef),
 			command.WithArg(targetRef),
 		)
@@ -629,6 +698,151 @@ func (g *Git) DiffFileName(ctx context.Context,
 	return parseLinesToSlice(stdout.Bytes()), nil
 }
 
+// DiffFileStat holds the change statistics of a single file of a diff.
+type DiffFileStat struct {
+	Path       string
+	OldPath    string
+	SHA        string
+	OldSHA     string
+	Status     enum.FileDiffStatus
+	Similarity int
+	Additions  int64
+	Deletions  int64
+	IsBinary   bool
+}
+
+// DiffFileStats returns the per-file change statistics between the two revisions. Renames and copies are detected.
+// Unlike a full diff, only the number of added and deleted lines is computed, no patch is generated.
+func (g *Git) DiffFileStats(ctx context.Context,
+	repoPath string,
+	baseRef string,
+	headRef string,
+	mergeBase bool,
+) ([]DiffFileStat, error) {
+	if repoPath == "" {
+		return nil, ErrRepositoryPathEmpty
+	}
+
+	// The output contains a record for every file in the raw format, followed by
+	// a record for every file in the numstat format. Both lists are in the same order.
+	cmd := command.New("diff",
+		command.WithFlag("--raw"),
+		command.WithFlag("--numstat"),
+		command.WithFlag("--no-abbrev"),
+		command.WithFlag("-M"),
+		command.WithFlag("-C"),
+		command.WithFlag("-z"),
+	)
+	if mergeBase {
+		cmd.Add(command.WithFlag("--merge-base"))
+	}
+	if baseRef == "" || baseRef == types.NilSHA {
+		baseRef = sha.EmptyTree.String()
+	}
+	cmd.Add(command.WithArg(baseRef, headRef))
+
+	stdout := &bytes.Buffer{}
+	err := cmd.Run(ctx,
+		command.WithDir(repoPath),
+		command.WithStdout(stdout),
+	)
+	if err != nil {
+		return nil, processGitErrorf(err, "failed to trigger diff command")
+	}
+
+	return parseDiffFileStats(stdout.Bytes())
+}
+
+// parseDiffFileStats parses the NUL separated output of git diff --raw --numstat -z.
+func parseDiffFileStats(output []byte) ([]DiffFileStat, error) {
+	tokens := strings.Split(strings.TrimSuffix(string(output), "\x00"), "\x00")
+	if len(tokens) == 1 && tokens[0] == "" {
+		return []DiffFileStat{}, nil
+	}
+
+	stats := make([]DiffFileStat, 0)
+
+	i := 0
+	for ; i < len(tokens) && strings.HasPrefix(tokens[i], ":"); i++ {
+		// ":<old mode> <new mode> <old sha> <new sha> <status>", followed by the path(s).
+		fields := strings.Fields(tokens[i][1:])
+		if len(fields) != 5 {
+			return nil, fmt.Errorf("failed to parse raw diff record %q", tokens[i])
+		}
+
+		stat := DiffFileStat{
+			OldSHA: fields[2],
+			SHA:    fields[3],
+		}
+
+		status := fields[4]
+		switch status[0] {
+		case 'A':
+			stat.Status = enum.FileDiffStatusAdded
+		case 'D':
+			stat.Status = enum.FileDiffStatusDeleted
+		case 'R':
+			stat.Status = enum.FileDiffStatusRenamed
+		case 'C':
+			stat.Status = enum.FileDiffStatusCopied
+		default:
+			stat.Status = enum.FileDiffStatusModified
+		}
+
+		if stat.Status == enum.FileDiffStatusRenamed || stat.Status == enum.FileDiffStatusCopied {
+			stat.Similarity, _ = strconv.Atoi(status[1:])
+			if i+2 >= len(tokens) {
+				return nil, fmt.Errorf("missing paths of raw diff record %q", tokens[i])
+			}
+			stat.OldPath = tokens[i+1]
+			stat.Path = tokens[i+2]
+			i += 2
+		} else {
+			if i+1 >= len(tokens) {
+				return nil, fmt.Errorf("missing path of raw diff record %q", tokens[i])
+			}
+			stat.Path = tokens[i+1]
+			i++
+		}
+
+		stats = append(stats, stat)
+	}
+
+	for idx := range stats {
+		if i >= len(tokens) {
+			return nil, fmt.Errorf("missing numstat record for %q", stats[idx].Path)
+		}
+
+		// "<added>\t<deleted>\t<path>", or "<added>\t<deleted>\t" followed by the old and the new path.
+		record := tokens[i]
+		fields := strings.SplitN(record, "\t", 3)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("failed to parse numstat record %q", record)
+		}
+		i++
+		if fields[2] == "" {
+			i += 2
+		}
+
+		if fields[0] == "-" && fields[1] == "-" {
+			stats[idx].IsBinary = true
+			continue
+		}
+
+		var err error
+		stats[idx].Additions, err = strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse number of added lines in %q: %w", record, err)
+		}
+		stats[idx].Deletions, err = strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse number of deleted lines in %q: %w", record, err)
+		}
+	}
+
+	return stats, nil
+}
+
 // GetDiffShortStat counts number of changed files, number of additions and deletions.
 func GetDiffShortStat(
 	ctx context.Context,
@@ -21,6 +21,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/harness/gitness/git/enum"
 	"github.com/harness/gitness/git/parser"
 
 	"github.com/google/go-cmp/cmp"
@@ -243,3 +244,107 @@ some content
 		})
 	}
 }
+
+func Test_parseDiffFileStats(t *testing.T) {
+	const (
+		sha1 = "587be6b4c3f93f93c489c0111bba5596147a26cb"
+		sha2 = "bdc955b7b2e610ad5a72302b139a2e6cb325519a"
+		sha3 = "8835708590a9afa236e1bbad18df9d23de82ccd3"
+		sha4 = "96cc558853a03c5d901661af837fceb7a81f58f6"
+		sha5 = "1c5a36f5d2e2f5293d62440acef04fbbd683e447"
+		sha6 = "3e757656cf36eca53338e520d134963a44f793f8"
+		zero = "0000000000000000000000000000000000000000"
+	)
+
+	tests := []struct {
+		name    string
+		output  string
+		want    []DiffFileStat
+		wantErr bool
+	}{
+		{
+			name:   "empty",
+			output: "",
+			want:   []DiffFileStat{},
+		},
+		{
+			name: "all change types",
+			output: ":100644 000000 " + sha1 + " " + zero + " D\x00b.txt\x00" +
+				":100644 100644 " + sha2 + " " + sha3 + " M\x00bin\x00" +
+				":100644 100644 " + sha4 + " " + sha5 + " R097\x00a.txt\x00c.txt\x00" +
+				":000000 100644 " + zero + " " + sha6 + " A\x00d.txt\x00" +
+				"0\t1\tb.txt\x00" +
+				"-\t-\tbin\x00" +
+				"1\t0\t\x00a.txt\x00c.txt\x00" +
+				"1\t0\td.txt\x00",
+			want: []DiffFileStat{
+				{Path: "b.txt", OldSHA: sha1, SHA: zero, Status: enum.FileDiffStatusDeleted, Deletions: 1},
+				{Path: "bin", OldSHA: sha2, SHA: sha3, Status: enum.FileDiffStatusModified, IsBinary: true},
+				{
+					Path:       "c.txt",
+					OldPath:    "a.txt",
+					OldSHA:     sha4,
+					SHA:        sha5,
+					Status:     enum.FileDiffStatusRenamed,
+					Similarity: 97,
+					Additions:  1,
+				},
+				{Path: "d.txt", OldSHA: zero, SHA: sha6, Status: enum.FileDiffStatusAdded, Additions: 1},
+			},
+		},
+		{
+			name:    "missing numstat record",
+			output:  ":100644 100644 " + sha2 + " " + sha3 + " M\x00file\x00",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDiffFileStats([]byte(tt.output))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseDiffFileStats() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("parseDiffFileStats() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffOptions_flags(t *testing.T) {
+	zero := 0
+	five := 5
+
+	tests := []struct {
+		name string
+		opts DiffOptions
+		want []string
+	}{
+		{
+			name: "defaults",
+			opts: DiffOptions{},
+			want: nil,
+		},
+		{
+			name: "zero context lines",
+			opts: DiffOptions{ContextLines: &zero},
+			want: []string{"-U0"},
+		},
+		{
+			name: "context lines and whitespace",
+			opts: DiffOptions{IgnoreWhitespace: enum.DiffIgnoreWhitespaceAll, ContextLines: &five},
+			want: []string{"--ignore-all-space", "-U5"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.opts.flags(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("flags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harness/gitness/errors"
+	"github.com/harness/gitness/git/api"
+	"github.com/harness/gitness/git/hook"
+	"github.com/harness/gitness/git/merge"
+	"github.com/harness/gitness/git/sha"
+)
+
+// CherryPickParams is input structure object for the cherry-pick operation.
+type CherryPickParams struct {
+	WriteParams
+
+	// CommitSHA is the commit which changes are applied.
+	CommitSHA sha.SHA
+	// ParentSHA is the commit against which the changes of the CommitSHA are calculated.
+	// (optional, default: the first parent of the CommitSHA)
+	ParentSHA sha.SHA
+
+	// Branch is the branch on top of which the changes are applied.
+	Branch string
+	// NewBranch is the name of the branch that is created for the new commit.
+	// (optional, default: the Branch is updated)
+	NewBranch string
+
+	Title   string
+	Message string
+
+	// Committer overwrites the git committer used for committing the files
+	// (optional, default: actor)
+	Committer *Identity
+	// CommitterDate overwrites the git committer date used for committing the files
+	// (optional, default: current time on server)
+	CommitterDate *time.Time
+	// Author overwrites the git author used for committing the files
+	// (optional, default: committer)
+	Author *Identity
+	// AuthorDate overwrites the git author date used for committing the files
+	// (optional, default: committer date)
+	AuthorDate *time.Time
+}
+
+func (p *CherryPickParams) Validate() error {
+	if err := p.WriteParams.Validate(); err != nil {
+		return err
+	}
+
+	if p.CommitSHA.IsEmpty() {
+		return errors.InvalidArgument("commit SHA is mandatory")
+	}
+
+	if p.Branch == "" {
+		return errors.InvalidArgument("branch is mandatory")
+	}
+
+	if strings.TrimSpace(p.Title) == "" {
+		return errors.InvalidArgument("commit title is mandatory")
+	}
+
+	return nil
+}
+
+// CherryPickOutput is result object of the cherry-pick operation.
+type CherryPickOutput struct {
+	// BranchSHA is the sha of the latest commit on the branch on top of which the changes were applied.
+	BranchSHA sha.SHA
+	// CommitSHA is the sha of the new commit.
+	CommitSHA sha.SHA
+
+	ConflictFiles []string
+}
+
+// CherryPick creates a new commit, on top of the branch, containing the changes of the provided commit.
+// If the params.NewBranch is provided, the new commit is pushed to a new branch and the branch remains unchanged.
+// In case of conflicts no commit is created and the list of conflicting files is returned.
+func (s *Service) CherryPick(ctx context.Context, params *CherryPickParams) (CherryPickOutput, error) {
+	return s.applyCommit(ctx, params, false)
+}
+
+// RevertParams is input structure object for the revert operation.
+// The fields have the same meaning as for the cherry-pick operation.
+type RevertParams CherryPickParams
+
+// RevertOutput is result object of the revert operation.
+type RevertOutput CherryPickOutput
+
+// Revert creates a new commit, on top of the branch, that reverts the changes of the provided commit.
+// If the params.NewBranch is provided, the new commit is pushed to a new branch and the branch remains unchanged.
+// In case of conflicts no commit is created and the list of conflicting files is returned.
+func (s *Service) Revert(ctx context.Context, params *RevertParams) (RevertOutput, error) {
+	out, err := s.applyCommit(ctx, (*CherryPickParams)(params), true)
+	return RevertOutput(out), err
+}
+
+// applyCommit applies (or if revert is set, reverts) the changes of a commit on top of a branch.
+//
+//nolint:gocognit // refactor if needed.
+func (s *Service) applyCommit(ctx context.Context, params *CherryPickParams, revert bool) (CherryPickOutput, error) {
+	if err := params.Validate(); err != nil {
+		return CherryPickOutput{}, fmt.Errorf("params not valid: %w", err)
+	}
+
+	repoPath := getFullPathForRepo(s.reposRoot, params.RepoUID)
+
+	branch, err := s.git.GetBranch(ctx, repoPath, params.Branch)
+	if err != nil {
+		return CherryPickOutput{}, fmt.Errorf("failed to get branch '%s': %w", params.Branch, err)
+	}
+
+	branchSHA := branch.Commit.SHA
+
+	parentSHA := params.ParentSHA
+	if parentSHA.IsEmpty() {
+		commit, err := s.git.GetCommit(ctx, repoPath, params.CommitSHA.String())
+		if err != nil {
+			return CherryPickOutput{}, fmt.Errorf("failed to get commit %s: %w", params.CommitSHA, err)
+		}
+
+		if len(commit.ParentSHAs) == 0 {
+			return CherryPickOutput{}, errors.InvalidArgument("Commit %s doesn't have a parent.", params.CommitSHA)
+		}
+
+		parentSHA = commit.ParentSHAs[0]
+	}
+
+	// set up the target reference
+
+	refPath := api.GetReferenceFromBranchName(params.Branch)
+	refOldValue := branchSHA
+
+	if params.NewBranch != "" {
+		existingBranch, err := s.git.GetBranch(ctx, repoPath, params.NewBranch)
+		if existingBranch != nil {
+			return CherryPickOutput{}, errors.Conflict("branch %s already exists", existingBranch.Name)
+		}
+		if err != nil && !errors.IsNotFound(err) {
+			return CherryPickOutput{}, fmt.Errorf("failed to create new branch '%s': %w", params.NewBranch, err)
+		}
+
+		refPath = api.GetReferenceFromBranchName(params.NewBranch)
+		refOldValue = sha.Nil
+	}
+
+	// author and committer
+
+	now := time.Now().UTC()
+
+	committer := api.Signature{Identity: api.Identity(params.Actor), When: now}
+
+	if params.Committer != nil {
+		committer.Identity = api.Identity(*params.Committer)
+	}
+	if params.CommitterDate != nil {
+		committer.When = *params.CommitterDate
+	}
+
+	author := committer
+
+	if params.Author != nil {
+		author.Identity = api.Identity(*params.Author)
+	}
+	if params.AuthorDate != nil {
+		author.When = *params.AuthorDate
+	}
+
+	// commit message
+
+	message := strings.TrimSpace(params.Title)
+	if len(params.Message) > 0 {
+		message += "\n\n" + strings.TrimSpace(params.Message)
+	}
+
+	// cherry-pick: a revert is a cherry-pick from the commit to its parent.
+
+	fromSHA, toSHA := parentSHA, params.CommitSHA
+	if revert {
+		fromSHA, toSHA = params.CommitSHA, parentSHA
+	}
+
+	refUpdater, err := hook.CreateRefUpdater(s.hookClientFactory, params.EnvVars, repoPath, refPath)
+	if err != nil {
+		return CherryPickOutput{}, errors.Internal(err, "failed to create ref updater object")
+	}
+
+	if err := refUpdater.InitOld(ctx, refOldValue); err != nil {
+		return CherryPickOutput{}, errors.Internal(err, "failed to set old reference value for ref updater")
+	}
+
+	commitSHA, conflicts, err := merge.CherryPick(
+		ctx,
+		refUpdater,
+		repoPath, s.tmpDir,
+		&author, &committer,
+		message,
+		fromSHA, branchSHA, toSHA)
+	if errors.IsInvalidArgument(err) {
+		return CherryPickOutput{}, err
+	}
+	if err != nil {
+		return CherryPickOutput{}, errors.Internal(err, "failed to apply %s (revert=%t) to %q in %q",
+			params.CommitSHA, revert, params.Branch, params.RepoUID)
+	}
+
+	return CherryPickOutput{
+		BranchSHA:     branchSHA,
+		CommitSHA:     commitSHA,
+		ConflictFiles: conflicts,
+	}, nil
+}
@@ -0,0 +1,254 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/harness/gitness/errors"
+	"github.com/harness/gitness/git/api"
+	"github.com/harness/gitness/git/hook"
+	"github.com/harness/gitness/git/sha"
+	"github.com/harness/gitness/git/types"
+)
+
+const testRepoUID = "cherrypicktest"
+
+// noopHookClientFactory creates hook clients that accept every reference update.
+type noopHookClientFactory struct{}
+
+func (noopHookClientFactory) NewClient(map[string]string) (hook.Client, error) {
+	return hook.NewNoopClient(nil), nil
+}
+
+// testRepo is a bare repository managed by the service and a clone used to create its history.
+type testRepo struct {
+	repoPath string
+	workDir  string
+}
+
+func newTestService(t *testing.T) (*Service, *testRepo) {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git binary not available")
+	}
+
+	// the changes are applied with merge-tree --merge-base, which requires git 2.40.
+	help, _ := exec.Command("git", "merge-tree", "-h").CombinedOutput()
+	if !strings.Contains(string(help), "--merge-base") {
+		t.Skip("git merge-tree doesn't support --merge-base")
+	}
+
+	root := t.TempDir()
+	tmpDir := filepath.Join(root, "tmp")
+	if err := os.MkdirAll(tmpDir, 0o700); err != nil {
+		t.Fatalf("failed to create tmp dir: %v", err)
+	}
+
+	adapter, err := api.New(types.Config{}, nil, noopHookClientFactory{})
+	if err != nil {
+		t.Fatalf("failed to create git adapter: %v", err)
+	}
+
+	s, err := New(types.Config{Root: root, TmpDir: tmpDir}, adapter, noopHookClientFactory{}, nil)
+	if err != nil {
+		t.Fatalf("failed to create git service: %v", err)
+	}
+
+	repo := &testRepo{
+		repoPath: getFullPathForRepo(s.reposRoot, testRepoUID),
+		workDir:  filepath.Join(root, "work"),
+	}
+
+	repo.git(t, "", "init", "--bare", "--initial-branch=main", repo.repoPath)
+	repo.git(t, "", "clone", repo.repoPath, repo.workDir)
+	repo.git(t, repo.workDir, "checkout", "-b", "main")
+
+	return s, repo
+}
+
+func (r *testRepo) git(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_CONFIG_GLOBAL=/dev/null",
+		"GIT_CONFIG_NOSYSTEM=1",
+		"GIT_AUTHOR_NAME=Test",
+		"GIT_AUTHOR_EMAIL=test@example.com",
+		"GIT_COMMITTER_NAME=Test",
+		"GIT_COMMITTER_EMAIL=test@example.com",
+	)
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %s failed: %v\n%s", strings.Join(args, " "), err, out)
+	}
+
+	return strings.TrimSpace(string(out))
+}
+
+// commit writes the files in the work dir, commits them and returns the sha of the new commit.
+func (r *testRepo) commit(t *testing.T, message string, files map[string]string) sha.SHA {
+	t.Helper()
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(r.workDir, name), []byte(content), 0o600); err != nil {
+			t.Fatalf("failed to write file %s: %v", name, err)
+		}
+	}
+
+	r.git(t, r.workDir, "add", "--all")
+	r.git(t, r.workDir, "commit", "--message", message)
+
+	return r.head(t)
+}
+
+func (r *testRepo) head(t *testing.T) sha.SHA {
+	t.Helper()
+	return sha.Must(r.git(t, r.workDir, "rev-parse", "HEAD"))
+}
+
+func (r *testRepo) push(t *testing.T, branches ...string) {
+	t.Helper()
+	r.git(t, r.workDir, append([]string{"push", "--force", "origin"}, branches...)...)
+}
+
+// file returns the content of the file at the revision of the bare repository.
+func (r *testRepo) file(t *testing.T, rev, name string) string {
+	t.Helper()
+	return r.git(t, r.repoPath, "show", rev+":"+name)
+}
+
+func (r *testRepo) ref(t *testing.T, rev string) sha.SHA {
+	t.Helper()
+	return sha.Must(r.git(t, r.repoPath, "rev-parse", rev))
+}
+
+func revertParams(commitSHA sha.SHA, branch, newBranch string) *RevertParams {
+	return &RevertParams{
+		WriteParams: WriteParams{
+			RepoUID: testRepoUID,
+			Actor:   Identity{Name: "Reverter", Email: "reverter@example.com"},
+		},
+		CommitSHA: commitSHA,
+		Branch:    branch,
+		NewBranch: newBranch,
+		Title:     "Revert " + commitSHA.String(),
+	}
+}
+
+// nolint:gocognit // it's a unit test
+func TestService_Revert(t *testing.T) {
+	ctx := context.Background()
+
+	s, repo := newTestService(t)
+
+	repo.commit(t, "initial", map[string]string{"a.txt": "a\n", "b.txt": "b\n"})
+	changeA := repo.commit(t, "change a", map[string]string{"a.txt": "a1\n"})
+
+	repo.git(t, repo.workDir, "checkout", "-b", "feature")
+	repo.commit(t, "change b", map[string]string{"b.txt": "b-feature\n"})
+	repo.git(t, repo.workDir, "checkout", "main")
+	repo.git(t, repo.workDir, "merge", "--no-ff", "--message", "merge feature", "feature")
+	mergeCommit := repo.head(t)
+
+	repo.commit(t, "change a again", map[string]string{"a.txt": "a2\n"})
+	repo.push(t, "main", "feature")
+
+	mainSHA := repo.ref(t, "main")
+
+	t.Run("conflict", func(t *testing.T) {
+		out, err := s.Revert(ctx, revertParams(changeA, "main", ""))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if want := []string{"a.txt"}; !reflect.DeepEqual(out.ConflictFiles, want) {
+			t.Errorf("conflict files = %v, want %v", out.ConflictFiles, want)
+		}
+		if !out.CommitSHA.IsEmpty() {
+			t.Errorf("commit %s created despite the conflict", out.CommitSHA)
+		}
+		if !out.BranchSHA.Equal(mainSHA) {
+			t.Errorf("branch sha = %s, want %s", out.BranchSHA, mainSHA)
+		}
+		if got := repo.ref(t, "main"); !got.Equal(mainSHA) {
+			t.Errorf("main moved to %s", got)
+		}
+	})
+
+	t.Run("merge commit", func(t *testing.T) {
+		// like for reverted pull requests, the changes are calculated against the first parent of the merge.
+		params := revertParams(mergeCommit, "main", "revert-feature")
+		params.ParentSHA = changeA
+
+		out, err := s.Revert(ctx, params)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(out.ConflictFiles) > 0 {
+			t.Fatalf("unexpected conflicts: %v", out.ConflictFiles)
+		}
+		if got := repo.ref(t, "revert-feature"); !got.Equal(out.CommitSHA) {
+			t.Errorf("revert-feature = %s, want %s", got, out.CommitSHA)
+		}
+		if got := repo.ref(t, "main"); !got.Equal(mainSHA) {
+			t.Errorf("main moved to %s", got)
+		}
+
+		// the changes of the merged branch are reverted, the changes made on main after the merge are kept.
+		if got := repo.file(t, "revert-feature", "b.txt"); got != "b" {
+			t.Errorf("b.txt = %q, want %q", got, "b")
+		}
+		if got := repo.file(t, "revert-feature", "a.txt"); got != "a2" {
+			t.Errorf("a.txt = %q, want %q", got, "a2")
+		}
+
+		// the revert is a regular commit on top of main.
+		if got := repo.git(t, repo.repoPath, "rev-parse", "revert-feature^@"); got != mainSHA.String() {
+			t.Errorf("parents of the revert commit = %q, want %q", got, mainSHA)
+		}
+	})
+
+	t.Run("no effective changes", func(t *testing.T) {
+		revertSHA := repo.ref(t, "revert-feature")
+
+		_, err := s.Revert(ctx, revertParams(mergeCommit, "revert-feature", ""))
+		if !errors.IsInvalidArgument(err) {
+			t.Fatalf("err = %v, want invalid argument", err)
+		}
+
+		if got := repo.ref(t, "revert-feature"); !got.Equal(revertSHA) {
+			t.Errorf("revert-feature moved to %s", got)
+		}
+	})
+
+	t.Run("existing branch", func(t *testing.T) {
+		_, err := s.Revert(ctx, revertParams(mergeCommit, "main", "feature"))
+		if !errors.IsConflict(err) {
+			t.Fatalf("err = %v, want conflict", err)
+		}
+	})
+}
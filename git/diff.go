@@ -31,12 +31,19 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
+// maxDiffContextLines is the maximum number of context lines that can be requested for a diff.
+const maxDiffContextLines = 1000
+
 type DiffParams struct {
 	ReadParams
 	BaseRef      string
 	HeadRef      string
 	MergeBase    bool
 	IncludePatch bool
+
+	IgnoreWhitespace enum.DiffIgnoreWhitespace
+	// ContextLines is the number of context lines around each change. Nil means the git default (3 lines).
+	ContextLines *int
 }
 
 func (p DiffParams) Validate() error {
@@ -47,6 +54,15 @@ func (p DiffParams) Validate() error {
 	if p.HeadRef == "" {
 		return errors.InvalidArgument("head ref cannot be empty")
 	}
+
+	if !p.IgnoreWhitespace.Validate() {
+		return errors.InvalidArgument("invalid ignore whitespace option %q", p.IgnoreWhitespace)
+	}
+
+	if p.ContextLines != nil && (*p.ContextLines < 0 || *p.ContextLines > maxDiffContextLines) {
+		return errors.InvalidArgument("context lines must be between 0 and %d", maxDiffContextLines)
+	}
+
 	return nil
 }
 
@@ -72,6 +88,10 @@ func (s *Service) rawDiff(ctx context.Context, w io.Writer, params *DiffParams,
 		params.BaseRef,
 		params.HeadRef,
 		params.MergeBase,
+		api.DiffOptions{
+			IgnoreWhitespace: params.IgnoreWhitespace,
+			ContextLines:     params.ContextLines,
+		},
 		params.AlternateObjectDirs,
 		files...,
 	)
@@ -90,6 +110,18 @@ func (s *Service) CommitDiff(ctx context.Context, params *GetCommitParams, out i
 	return nil
 }
 
+// FormatPatch writes the commits between the params.BaseRef and the params.HeadRef
+// to the writer as a series of patches in the mbox format.
+func (s *Service) FormatPatch(ctx context.Context, params *DiffParams, out io.Writer) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	repoPath := getFullPathForRepo(s.reposRoot, params.RepoUID)
+
+	return s.git.FormatPatch(ctx, repoPath, params.BaseRef, params.HeadRef, params.AlternateObjectDirs, out)
+}
+
 type DiffShortStatOutput struct {
 	Files     int
 	Additions int
@@ -317,6 +349,7 @@ type FileDiff struct {
 	Path        string              `json:"path"`
 	OldPath     string              `json:"old_path,omitempty"`
 	Status      enum.FileDiffStatus `json:"status"`
+	Similarity  int                 `json:"similarity,omitempty"`
 	Additions   int64               `json:"additions"`
 	Deletions   int64               `json:"deletions"`
 	Changes     int64               `json:"changes"`
@@ -335,6 +368,8 @@ func parseFileDiffStatus(ftype diff.FileType) enum.FileDiffStatus {
 		return enum.FileDiffStatusModified
 	case diff.FileRename:
 		return enum.FileDiffStatusRenamed
+	case diff.FileCopy:
+		return enum.FileDiffStatusCopied
 	default:
 		return enum.FileDiffStatusUndefined
 	}
@@ -386,6 +421,7 @@ func (s *Service) Diff(
 				Path:        f.Path,
 				OldPath:     f.OldPath,
 				Status:      parseFileDiffStatus(f.Type),
+				Similarity:  f.Similarity,
 				Additions:   int64(f.NumAdditions()),
 				Deletions:   int64(f.NumDeletions()),
 				Changes:     int64(f.NumChanges()),
@@ -434,3 +470,29 @@ func (s *Service) DiffFileNames(ctx context.Context, params *DiffParams) (DiffFi
 		Files: fileNames,
 	}, nil
 }
+
+type DiffFileStatsOutput struct {
+	Files []api.DiffFileStat
+}
+
+// DiffFileStats returns the number of added and deleted lines of every changed file, without generating the patch.
+func (s *Service) DiffFileStats(ctx context.Context, params *DiffParams) (DiffFileStatsOutput, error) {
+	if err := params.Validate(); err != nil {
+		return DiffFileStatsOutput{}, err
+	}
+	repoPath := getFullPathForRepo(s.reposRoot, params.RepoUID)
+	files, err := s.git.DiffFileStats(
+		ctx,
+		repoPath,
+		params.BaseRef,
+		params.HeadRef,
+		params.MergeBase,
+	)
+	if err != nil {
+		return DiffFileStatsOutput{}, fmt.Errorf("failed to get diff file stats between '%s' and '%s': %w",
+			params.BaseRef, params.HeadRef, err)
+	}
+	return DiffFileStatsOutput{
+		Files: files,
+	}, nil
+}
@@ -54,6 +54,7 @@ const (
 	FileChange
 	FileDelete
 	FileRename
+	FileCopy
 )
 
 // Line represents a line in diff.
@@ -137,6 +138,8 @@ type File struct {
 	SHA string
 	// OldSHA is the old index (SHA1 hash) of the file.
 	OldSHA string
+	// Similarity is the similarity index (percentage) of a renamed or copied file.
+	Similarity int
 	// The sections in the file.
 	Sections []*Section
 
@@ -159,6 +162,8 @@ func (f *File) Status() string {
 		return "deleted"
 	case f.Type == FileRename:
 		return "renamed"
+	case f.Type == FileCopy:
+		return "copied"
 	case f.Type == FileChange:
 		return "changed"
 	default:
@@ -332,9 +337,14 @@ checkType:
 			file.Type = FileRename
 			file.OldPath = a
 			file.Path = b
-
-			// No need to look for index if it's a pure rename
-			if strings.HasSuffix(subLine, "100%") {
+			file.Similarity, _ = strconv.Atoi(strings.TrimSuffix(
+				strings.TrimSpace(subLine[len(enum.DiffExtHeaderSimilarity):]), "%"))
+		case strings.HasPrefix(subLine, enum.DiffExtHeaderCopyFrom):
+			file.Type = FileCopy
+		case strings.HasPrefix(subLine, enum.DiffExtHeaderRenameTo),
+			strings.HasPrefix(subLine, enum.DiffExtHeaderCopyTo):
+			// No need to look for index if it's a pure rename or copy
+			if file.Similarity == 100 {
 				break checkType
 			}
 		case strings.HasPrefix(subLine, enum.DiffExtHeaderNewMode):
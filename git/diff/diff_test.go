@@ -0,0 +1,164 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+)
+
+func TestParser_Parse(t *testing.T) {
+	type wantFile struct {
+		path       string
+		oldPath    string
+		fileType   FileType
+		similarity int
+		sha        string
+		oldSHA     string
+		sections   int
+		additions  int
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  []wantFile
+	}{
+		{
+			name: "copy",
+			input: "diff --git a/a.txt b/b.txt\n" +
+				"similarity index 94%\n" +
+				"copy from a.txt\n" +
+				"copy to b.txt\n" +
+				"index 0ff3bbb..d4de868 100644\n" +
+				"--- a/a.txt\n" +
+				"+++ b/b.txt\n" +
+				"@@ -18,3 +18,4 @@\n" +
+				" 18\n" +
+				" 19\n" +
+				" 20\n" +
+				"+21\n",
+			want: []wantFile{
+				{
+					path:       "b.txt",
+					oldPath:    "a.txt",
+					fileType:   FileCopy,
+					similarity: 94,
+					sha:        "d4de868",
+					oldSHA:     "0ff3bbb",
+					sections:   1,
+					additions:  1,
+				},
+			},
+		},
+		{
+			name: "rename with changes",
+			input: "diff --git a/r.txt b/r2.txt\n" +
+				"similarity index 94%\n" +
+				"rename from r.txt\n" +
+				"rename to r2.txt\n" +
+				"index 0ff3bbb..d4de868 100644\n" +
+				"--- a/r.txt\n" +
+				"+++ b/r2.txt\n" +
+				"@@ -18,3 +18,4 @@\n" +
+				" 18\n" +
+				" 19\n" +
+				" 20\n" +
+				"+21\n",
+			want: []wantFile{
+				{
+					path:       "r2.txt",
+					oldPath:    "r.txt",
+					fileType:   FileRename,
+					similarity: 94,
+					sha:        "d4de868",
+					oldSHA:     "0ff3bbb",
+					sections:   1,
+					additions:  1,
+				},
+			},
+		},
+		{
+			name: "pure rename",
+			input: "diff --git a/p.txt b/p2.txt\n" +
+				"similarity index 100%\n" +
+				"rename from p.txt\n" +
+				"rename to p2.txt\n" +
+				"diff --git a/a.txt b/a.txt\n" +
+				"index 0ff3bbb..d4de868 100644\n" +
+				"--- a/a.txt\n" +
+				"+++ b/a.txt\n" +
+				"@@ -18,3 +18,4 @@\n" +
+				" 18\n" +
+				" 19\n" +
+				" 20\n" +
+				"+21\n",
+			want: []wantFile{
+				{
+					path:       "p2.txt",
+					oldPath:    "p.txt",
+					fileType:   FileRename,
+					similarity: 100,
+				},
+				{
+					path:      "a.txt",
+					oldPath:   "a.txt",
+					fileType:  FileChange,
+					sha:       "d4de868",
+					oldSHA:    "0ff3bbb",
+					sections:  1,
+					additions: 1,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parser := &Parser{
+				Reader: bufio.NewReader(strings.NewReader(tt.input)),
+			}
+
+			var got []wantFile
+			err := parser.Parse(func(f *File) error {
+				got = append(got, wantFile{
+					path:       f.Path,
+					oldPath:    f.OldPath,
+					fileType:   f.Type,
+					similarity: f.Similarity,
+					sha:        f.SHA,
+					oldSHA:     f.OldSHA,
+					sections:   f.NumSections(),
+					additions:  f.NumAdditions(),
+				})
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("Parse() failed: %v", err)
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("Parse() returned %d files, want %d: %+v", len(got), len(tt.want), got)
+			}
+
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("file %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
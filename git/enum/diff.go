@@ -27,3 +27,26 @@ const (
 	FileDiffStatusRenamed   FileDiffStatus = "RENAMED"
 	FileDiffStatusCopied    FileDiffStatus = "COPIED"
 )
+
+// DiffIgnoreWhitespace defines which whitespace changes are ignored when generating a diff.
+type DiffIgnoreWhitespace string
+
+const (
+	// DiffIgnoreWhitespaceNone doesn't ignore any whitespace changes.
+	DiffIgnoreWhitespaceNone DiffIgnoreWhitespace = ""
+	// DiffIgnoreWhitespaceAll ignores all whitespace when comparing lines (git diff -w).
+	DiffIgnoreWhitespaceAll DiffIgnoreWhitespace = "all"
+	// DiffIgnoreWhitespaceChange ignores changes in the amount of whitespace (git diff -b).
+	DiffIgnoreWhitespaceChange DiffIgnoreWhitespace = "change"
+	// DiffIgnoreWhitespaceEOL ignores whitespace changes at the end of lines (git diff --ignore-space-at-eol).
+	DiffIgnoreWhitespaceEOL DiffIgnoreWhitespace = "eol"
+)
+
+func (w DiffIgnoreWhitespace) Validate() bool {
+	switch w {
+	case DiffIgnoreWhitespaceNone, DiffIgnoreWhitespaceAll, DiffIgnoreWhitespaceChange, DiffIgnoreWhitespaceEOL:
+		return true
+	default:
+		return false
+	}
+}
@@ -78,7 +78,9 @@ type Interface interface {
 	RawDiff(ctx context.Context, w io.Writer, in *DiffParams, files ...api.FileDiffRequest) error
 	Diff(ctx context.Context, in *DiffParams, files ...api.FileDiffRequest) (<-chan *FileDiff, <-chan error)
 	DiffFileNames(ctx context.Context, in *DiffParams) (DiffFileNamesOutput, error)
+	DiffFileStats(ctx context.Context, in *DiffParams) (DiffFileStatsOutput, error)
 	CommitDiff(ctx context.Context, params *GetCommitParams, w io.Writer) error
+	FormatPatch(ctx context.Context, params *DiffParams, w io.Writer) error
 	DiffShortStat(ctx context.Context, params *DiffParams) (DiffShortStatOutput, error)
 	DiffStats(ctx context.Context, params *DiffParams) (DiffStatsOutput, error)
 
@@ -89,6 +91,8 @@ type Interface interface {
 	 * Merge services
 	 */
 	Merge(ctx context.Context, in *MergeParams) (MergeOutput, error)
+	CherryPick(ctx context.Context, params *CherryPickParams) (CherryPickOutput, error)
+	Revert(ctx context.Context, params *RevertParams) (RevertOutput, error)
 
 	/*
 	 * Blame services
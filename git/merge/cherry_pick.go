@@ -0,0 +1,79 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harness/gitness/errors"
+	"github.com/harness/gitness/git/api"
+	"github.com/harness/gitness/git/hook"
+	"github.com/harness/gitness/git/sha"
+	"github.com/harness/gitness/git/sharedrepo"
+)
+
+// CherryPick applies the changes between the two commits (mergeBaseSHA and sourceSHA)
+// on top of the targetSHA commit. The resulting commit has targetSHA as its only parent.
+// Providing the commits in reverse order (the commit as mergeBaseSHA and its parent as sourceSHA)
+// reverts the changes of the commit.
+func CherryPick(
+	ctx context.Context,
+	refUpdater *hook.RefUpdater,
+	repoPath, tmpDir string,
+	author, committer *api.Signature,
+	message string,
+	mergeBaseSHA, targetSHA, sourceSHA sha.SHA,
+) (commitSHA sha.SHA, conflicts []string, err error) {
+	err = sharedrepo.Run(ctx, refUpdater, tmpDir, repoPath, func(s *sharedrepo.SharedRepo) error {
+		var err error
+
+		var treeSHA sha.SHA
+
+		treeSHA, conflicts, err = s.MergeTree(ctx, mergeBaseSHA, targetSHA, sourceSHA)
+		if err != nil {
+			return fmt.Errorf("merge tree failed: %w", err)
+		}
+
+		if len(conflicts) > 0 {
+			return errConflict
+		}
+
+		targetTreeSHA, err := s.GetTreeSHA(ctx, targetSHA.String())
+		if err != nil {
+			return fmt.Errorf("failed to get tree sha for target: %w", err)
+		}
+
+		if treeSHA.Equal(targetTreeSHA) {
+			return errors.InvalidArgument("No effective changes.")
+		}
+
+		commitSHA, err = s.CommitTree(ctx, author, committer, treeSHA, message, false, targetSHA)
+		if err != nil {
+			return fmt.Errorf("commit tree failed: %w", err)
+		}
+
+		if err := refUpdater.InitNew(ctx, commitSHA); err != nil {
+			return fmt.Errorf("refUpdater.InitNew failed: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, errConflict) {
+		return sha.None, nil, fmt.Errorf("cherry-pick: %w", err)
+	}
+
+	return commitSHA, conflicts, nil
+}
@@ -173,6 +173,60 @@ type Config struct {
 		// In that case, GITNESS_URL_CONTAINER should also be changed
 		// (eg to http://<gitness_container_name>:<port>).
 		ContainerNetworks []string `envconfig:"GITNESS_CI_CONTAINER_NETWORKS"`
+
+		// ExecutionTimeout is the maximum duration a single pipeline stage is allowed to run
+		// before the runner cancels it and kills all remaining containers.
+		ExecutionTimeout time.Duration `envconfig:"GITNESS_CI_EXECUTION_TIMEOUT" default:"10h"`
+
+		// RepoConcurrencyLimit is the maximum number of stages that can run concurrently per repository.
+		// Any further stages are queued until running stages complete. Zero means no limit.
+		RepoConcurrencyLimit int `envconfig:"GITNESS_CI_REPO_CONCURRENCY_LIMIT"`
+
+		// TrustAllRepos marks all repositories as trusted, which allows pipelines to run privileged
+		// containers, mount host volumes and use extra capabilities.
+		// Disable it on shared machines and list the repositories that are trusted in TrustedRepos.
+		TrustAllRepos bool `envconfig:"GITNESS_CI_TRUST_ALL_REPOS" default:"true"`
+
+		// TrustedRepos is a list of repository paths that are trusted in case TrustAllRepos is disabled.
+		TrustedRepos []string `envconfig:"GITNESS_CI_TRUSTED_REPOS"`
+
+		// NetrcCloneOnly restricts the repository credentials (netrc) to the clone step.
+		// By default, the netrc file is injected into every pipeline container.
+		NetrcCloneOnly bool `envconfig:"GITNESS_CI_NETRC_CLONE_ONLY"`
+
+		// RegistryConfig is an optional path to a docker config.json file that provides
+		// credentials for pulling private images of pipeline steps and plugins.
+		// The credentials are only used by the runner and never exposed to the containers.
+		RegistryConfig string `envconfig:"GITNESS_CI_REGISTRY_CONFIG"`
+
+		// Resources defines the default resource constraints applied to all pipeline containers.
+		// Memory limits declared on a step in the pipeline yaml (mem_limit, memswap_limit, shm_size)
+		// take precedence over the configured defaults.
+		Resources struct {
+			// Memory is the memory limit in bytes.
+			Memory int64 `envconfig:"GITNESS_CI_RESOURCES_MEMORY"`
+			// MemorySwap is the total memory limit (memory + swap) in bytes.
+			MemorySwap int64 `envconfig:"GITNESS_CI_RESOURCES_MEMORY_SWAP"`
+			// CPUQuota is the CPU CFS (Completely Fair Scheduler) quota in microseconds.
+			CPUQuota int64 `envconfig:"GITNESS_CI_RESOURCES_CPU_QUOTA"`
+			// CPUPeriod is the CPU CFS (Completely Fair Scheduler) period in microseconds.
+			CPUPeriod int64 `envconfig:"GITNESS_CI_RESOURCES_CPU_PERIOD"`
+			// CPUShares is the relative CPU weight of the containers.
+			CPUShares int64 `envconfig:"GITNESS_CI_RESOURCES_CPU_SHARES"`
+			// CPUSet is the list of CPUs in which to allow execution (e.g. 0-3 or 0,1).
+			CPUSet []string `envconfig:"GITNESS_CI_RESOURCES_CPU_SET"`
+			// ShmSize is the size of /dev/shm in bytes.
+			ShmSize int64 `envconfig:"GITNESS_CI_RESOURCES_SHM_SIZE"`
+		}
+
+		// SecretProvider configures an optional external secret provider (e.g. drone-vault)
+		// which resolves secrets declared in the pipeline yaml by path and key when the step runs.
+		// Secrets fetched this way are never stored in the database.
+		SecretProvider struct {
+			Endpoint   string `envconfig:"GITNESS_CI_SECRET_PROVIDER_ENDPOINT"`
+			Token      string `envconfig:"GITNESS_CI_SECRET_PROVIDER_TOKEN"`
+			SkipVerify bool   `envconfig:"GITNESS_CI_SECRET_PROVIDER_SKIP_VERIFY"`
+		}
 	}
 
 	// Database defines the database configuration parameters.
@@ -198,6 +252,20 @@ type Config struct {
 		ImpersonationLifetime time.Duration `envconfig:"GITNESS_BLOBSTORE_IMPERSONATION_LIFETIME" default:"12h"`
 	}
 
+	// Uploads defines the limits of files uploaded to repositories, e.g. attachments of comments.
+	Uploads struct {
+		// MaxFileSize is the maximum size of an uploaded file in bytes.
+		MaxFileSize int64 `envconfig:"GITNESS_UPLOADS_MAX_FILE_SIZE" default:"10485760"` // 10 MiB
+
+		// AllowedTypes lists the MIME types of files that can be uploaded, e.g. "image/*" allows all images.
+		AllowedTypes []string `envconfig:"GITNESS_UPLOADS_ALLOWED_TYPES" default:"image/*,video/*,text/plain,application/pdf"` //nolint:lll // struct tags can't be multiline
+
+		// ScanCommand is an optional command (e.g. "clamdscan --fdpass -") that receives every uploaded file
+		// on its standard input before the file is stored. Exit code 1 rejects the file, any other non-zero
+		// exit code fails the upload.
+		ScanCommand string `envconfig:"GITNESS_UPLOADS_SCAN_COMMAND"`
+	}
+
 	// Token defines token configuration parameters.
 	Token struct {
 		CookieName string        `envconfig:"GITNESS_TOKEN_COOKIE_NAME" default:"token"`
@@ -212,6 +280,16 @@ type Config struct {
 			Endpoint  string `envconfig:"GITNESS_LOGS_S3_ENDPOINT"`
 			PathStyle bool   `envconfig:"GITNESS_LOGS_S3_PATH_STYLE"`
 		}
+
+		// GCS provides optional storage option for logs.
+		// It is only used in case no S3 bucket is configured.
+		GCS struct {
+			Bucket string `envconfig:"GITNESS_LOGS_GCS_BUCKET"`
+			Prefix string `envconfig:"GITNESS_LOGS_GCS_PREFIX"`
+			// KeyPath is the path to the service account key file.
+			// If not set, the application default credentials are used.
+			KeyPath string `envconfig:"GITNESS_LOGS_GCS_KEY_PATH"`
+		}
 	}
 
 	// Cors defines http cors parameters
@@ -359,6 +437,19 @@ type Config struct {
 		NumWorkers  int           `envconfig:"GITNESS_REPO_SIZE_NUM_WORKERS" default:"5"`
 	}
 
+	MergeQueue struct {
+		CRON        string        `envconfig:"GITNESS_MERGE_QUEUE_CRON" default:"* * * * *"`
+		MaxDuration time.Duration `envconfig:"GITNESS_MERGE_QUEUE_MAX_DURATION" default:"5m"`
+	}
+
+	ReviewAnalytics struct {
+		Enabled     bool          `envconfig:"GITNESS_REVIEW_ANALYTICS_ENABLED" default:"true"`
+		CRON        string        `envconfig:"GITNESS_REVIEW_ANALYTICS_CRON" default:"*/15 * * * *"`
+		MaxDuration time.Duration `envconfig:"GITNESS_REVIEW_ANALYTICS_MAX_DURATION" default:"10m"`
+		// Lookback is how far back the daily review rollups get recalculated on every run.
+		Lookback time.Duration `envconfig:"GITNESS_REVIEW_ANALYTICS_LOOKBACK" default:"48h"`
+	}
+
 	CodeOwners struct {
 		FilePaths []string `envconfig:"GITNESS_CODEOWNERS_FILEPATH" default:"CODEOWNERS,.harness/CODEOWNERS"`
 	}
@@ -370,12 +461,197 @@ type Config struct {
 		Password string `envconfig:"GITNESS_SMTP_PASSWORD"`
 		FromMail string `envconfig:"GITNESS_SMTP_FROM_MAIL"`
 		Insecure bool   `envconfig:"GITNESS_SMTP_INSECURE"`
+		// TLSMode defines how the connection is secured: "tls" (implicit TLS), "starttls" (mandatory STARTTLS)
+		// or "none". By default implicit TLS is used on port 465 and STARTTLS is used when supported otherwise.
+		TLSMode string `envconfig:"GITNESS_SMTP_TLS_MODE"`
 	}
 
+	// Notification has an explicit prefix for the keys of the embedded PipelineNotificationFilter.
 	Notification struct {
 		MaxRetries  int `envconfig:"GITNESS_NOTIFICATION_MAX_RETRIES" default:"3"`
 		Concurrency int `envconfig:"GITNESS_NOTIFICATION_CONCURRENCY" default:"4"`
-	}
+
+		// ReviewerReminder configures the job that reminds reviewers about pending pull request reviews.
+		ReviewerReminder struct {
+			Enabled     bool          `envconfig:"GITNESS_NOTIFICATION_REVIEWER_REMINDER_ENABLED" default:"true"`
+			CRON        string        `envconfig:"GITNESS_NOTIFICATION_REVIEWER_REMINDER_CRON" default:"*/30 * * * *"`
+			MaxDuration time.Duration `envconfig:"GITNESS_NOTIFICATION_REVIEWER_REMINDER_MAX_DURATION" default:"10m"`
+			// SLA is the duration a review request can be pending before the reviewer gets reminded.
+			SLA time.Duration `envconfig:"GITNESS_NOTIFICATION_REVIEWER_REMINDER_SLA" default:"48h"`
+			// Interval is the minimum duration between two reminders for the same review request.
+			Interval time.Duration `envconfig:"GITNESS_NOTIFICATION_REVIEWER_REMINDER_INTERVAL" default:"24h"`
+			// BatchSize is the maximum number of reminders sent in a single job run.
+			BatchSize int `envconfig:"GITNESS_NOTIFICATION_REVIEWER_REMINDER_BATCH_SIZE" default:"500"`
+		}
+
+		// Pipeline configures the emails sent once a pipeline execution finished.
+		//
+		// The configuration is server-wide. Emails are only sent to registered users with access to the repository.
+		// The channels below can't check access, they only receive the executions of the repositories
+		// an admin opted in with their Repos filter (e.g. GITNESS_NOTIFICATION_PIPELINE_SLACK_REPOS).
+		Pipeline struct {
+			// Statuses is the list of execution statuses that trigger an email (empty disables the emails).
+			Statuses []string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_STATUSES" default:"failure,error"`
+			// NotifyAuthor controls whether the author of the commit that triggered the execution is notified.
+			// The commit author email has to belong to a registered user.
+			NotifyAuthor bool `envconfig:"GITNESS_NOTIFICATION_PIPELINE_NOTIFY_AUTHOR" default:"true"`
+			// Recipients is a list of additional email addresses of registered users that are notified
+			// about the executions of the repositories they have access to.
+			Recipients []string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_RECIPIENTS"`
+			// PipelineNotificationFilter limits the emails to the matching executions, the same filter can be
+			// configured for every channel below (e.g. GITNESS_NOTIFICATION_PIPELINE_TELEGRAM_ON_CHANGE).
+			PipelineNotificationFilter
+
+			// Telegram posts the execution results to a telegram chat (enabled if bot token and chat id are set).
+			Telegram struct {
+				APIURL   string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_TELEGRAM_API_URL" default:"https://api.telegram.org"`
+				BotToken string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_TELEGRAM_BOT_TOKEN"`
+				ChatID   string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_TELEGRAM_CHAT_ID"`
+				Statuses []string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_TELEGRAM_STATUSES" default:"success,failure,error,killed"` //nolint:lll
+				// SilentSuccess sends messages of successful executions without notification sound.
+				SilentSuccess bool `envconfig:"GITNESS_NOTIFICATION_PIPELINE_TELEGRAM_SILENT_SUCCESS" default:"true"`
+
+				PipelineNotificationFilter
+			}
+
+			// PagerDuty triggers incidents for failed executions on protected branches (enabled if routing key is set).
+			PagerDuty struct {
+				EventsURL  string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_PAGERDUTY_EVENTS_URL" default:"https://events.pagerduty.com/v2/enqueue"` //nolint:lll
+				RoutingKey string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_PAGERDUTY_ROUTING_KEY"`
+				// Branches is a list of "pattern=severity" entries defining the severity of the incidents of
+				// protected branches (e.g. "main=critical"). A branch is protected if any branch rule applies to it.
+				// Protected branches matching none of the patterns use the DefaultSeverity.
+				Branches        []string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_PAGERDUTY_BRANCHES"`
+				DefaultSeverity string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_PAGERDUTY_DEFAULT_SEVERITY" default:"error"`
+
+				// OnChange, Events and Repos are declared explicitly as Branches has a different meaning for PagerDuty.
+				OnChange bool     `envconfig:"GITNESS_NOTIFICATION_PIPELINE_PAGERDUTY_ON_CHANGE"`
+				Events   []string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_PAGERDUTY_EVENTS"`
+				Repos    []string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_PAGERDUTY_REPOS"`
+			}
+
+			// Ntfy publishes the execution results to a topic of a ntfy server (enabled if url and topic are set).
+			Ntfy struct {
+				URL      string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_NTFY_URL"`
+				Topic    string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_NTFY_TOPIC"`
+				Token    string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_NTFY_TOKEN"`
+				Statuses []string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_NTFY_STATUSES" default:"success,failure,error,killed"`
+
+				PipelineNotificationFilter
+			}
+
+			// Gotify sends the execution results to a gotify server (enabled if url and application token are set).
+			Gotify struct {
+				URL      string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_GOTIFY_URL"`
+				Token    string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_GOTIFY_TOKEN"`
+				Statuses []string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_GOTIFY_STATUSES" default:"success,failure,error,killed"` //nolint:lll
+
+				PipelineNotificationFilter
+			}
+
+			// IRC announces the execution results in irc channels (enabled if server and channels are set).
+			IRC struct {
+				// Server is the address of the irc server in the format host:port.
+				Server       string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_IRC_SERVER"`
+				TLS          bool     `envconfig:"GITNESS_NOTIFICATION_PIPELINE_IRC_TLS" default:"true"`
+				Nick         string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_IRC_NICK" default:"gitness"`
+				Channels     []string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_IRC_CHANNELS"`
+				SASLUsername string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_IRC_SASL_USERNAME"`
+				SASLPassword string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_IRC_SASL_PASSWORD"`
+				Statuses     []string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_IRC_STATUSES" default:"success,failure,error,killed"` //nolint:lll
+
+				PipelineNotificationFilter
+			}
+
+			// Mattermost posts the execution results to a mattermost incoming webhook (enabled if url is set).
+			Mattermost struct {
+				WebhookURL string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_MATTERMOST_WEBHOOK_URL"`
+				Channel    string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_MATTERMOST_CHANNEL"`
+				Username   string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_MATTERMOST_USERNAME"`
+				Statuses   []string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_MATTERMOST_STATUSES" default:"success,failure,error,killed"` //nolint:lll
+
+				PipelineNotificationFilter
+			}
+
+			// RocketChat posts the execution results to a rocket.chat incoming webhook (enabled if url is set).
+			RocketChat struct {
+				WebhookURL string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_ROCKETCHAT_WEBHOOK_URL"`
+				Channel    string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_ROCKETCHAT_CHANNEL"`
+				Alias      string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_ROCKETCHAT_ALIAS"`
+				Statuses   []string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_ROCKETCHAT_STATUSES" default:"success,failure,error,killed"` //nolint:lll
+
+				PipelineNotificationFilter
+			}
+
+			// Pushover sends the execution results to pushover users (enabled if app token and user keys are set).
+			Pushover struct {
+				APIURL   string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_PUSHOVER_API_URL" default:"https://api.pushover.net/1/messages.json"` //nolint:lll
+				AppToken string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_PUSHOVER_APP_TOKEN"`
+				UserKeys []string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_PUSHOVER_USER_KEYS"`
+				Statuses []string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_PUSHOVER_STATUSES" default:"success,failure,error,killed"` //nolint:lll
+				// SuccessPriority and FailurePriority are the pushover priorities (-2 to 2) of the notifications.
+				// Notifications with emergency priority (2) are repeated until acknowledged or expired.
+				SuccessPriority int           `envconfig:"GITNESS_NOTIFICATION_PIPELINE_PUSHOVER_SUCCESS_PRIORITY" default:"-1"`
+				FailurePriority int           `envconfig:"GITNESS_NOTIFICATION_PIPELINE_PUSHOVER_FAILURE_PRIORITY" default:"2"`
+				Retry           time.Duration `envconfig:"GITNESS_NOTIFICATION_PIPELINE_PUSHOVER_RETRY" default:"5m"`
+				Expire          time.Duration `envconfig:"GITNESS_NOTIFICATION_PIPELINE_PUSHOVER_EXPIRE" default:"1h"`
+
+				PipelineNotificationFilter
+			}
+
+			// SNS publishes the execution results as json to an AWS SNS topic (enabled if topic ARN is set).
+			// If no access key is configured, the default AWS credential chain (e.g. IAM role) is used.
+			SNS struct {
+				TopicARN        string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_SNS_TOPIC_ARN"`
+				Region          string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_SNS_REGION"`
+				Endpoint        string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_SNS_ENDPOINT"`
+				AccessKeyID     string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_SNS_ACCESS_KEY_ID"`
+				SecretAccessKey string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_SNS_SECRET_ACCESS_KEY"`
+				Statuses        []string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_SNS_STATUSES" default:"success,failure,error,killed"` //nolint:lll
+
+				PipelineNotificationFilter
+			}
+
+			// Twilio sends the execution results as sms (enabled if account sid and recipients are set).
+			Twilio struct {
+				APIURL     string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_TWILIO_API_URL" default:"https://api.twilio.com"`
+				AccountSID string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_TWILIO_ACCOUNT_SID"`
+				AuthToken  string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_TWILIO_AUTH_TOKEN"`
+				From       string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_TWILIO_FROM"`
+				To         []string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_TWILIO_TO"`
+				Statuses   []string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_TWILIO_STATUSES" default:"failure,error"`
+				// Steps are the glob patterns of the step names (e.g. "deploy*") the messages are limited to.
+				Steps []string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_TWILIO_STEPS"`
+				// RateLimit is the max number of messages per pipeline and ref within the RateLimitWindow (0 = unlimited).
+				RateLimit       int           `envconfig:"GITNESS_NOTIFICATION_PIPELINE_TWILIO_RATE_LIMIT" default:"3"`
+				RateLimitWindow time.Duration `envconfig:"GITNESS_NOTIFICATION_PIPELINE_TWILIO_RATE_LIMIT_WINDOW" default:"1h"`
+
+				PipelineNotificationFilter
+			}
+
+			// Slack posts the execution results to slack using an incoming webhook (enabled if webhook url is set),
+			// or using a bot (enabled if bot token and channel are set) that posts a message once an execution
+			// started and updates it (or replies in its thread) once the execution finished.
+			Slack struct {
+				WebhookURL string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_SLACK_WEBHOOK_URL"`
+				APIURL     string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_SLACK_API_URL" default:"https://slack.com/api/"`
+				BotToken   string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_SLACK_BOT_TOKEN"`
+				Channel    string   `envconfig:"GITNESS_NOTIFICATION_PIPELINE_SLACK_CHANNEL"`
+				Thread     bool     `envconfig:"GITNESS_NOTIFICATION_PIPELINE_SLACK_THREAD"`
+				Statuses   []string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_SLACK_STATUSES" default:"success,failure,error,killed"` //nolint:lll
+
+				PipelineNotificationFilter
+			}
+
+			// Template contains go templates to customize the title and body of the notifications of all channels.
+			// The templates have access to the repo, pipeline, execution and its stages and steps.
+			// Invalid templates fail the server start.
+			Template struct {
+				Title string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_TEMPLATE_TITLE"`
+				Body  string `envconfig:"GITNESS_NOTIFICATION_PIPELINE_TEMPLATE_BODY"`
+			}
+		}
+	} `envconfig:"GITNESS_NOTIFICATION"`
 
 	KeywordSearch struct {
 		Concurrency int `envconfig:"GITNESS_KEYWORD_SEARCH_CONCURRENCY" default:"4"`
@@ -487,3 +763,17 @@ type Config struct {
 		Cron   string `envconfig:"GITNESS_INSTRUMENTATION_CRON" default:"0 0 * * *"`
 	}
 }
+
+// PipelineNotificationFilter configures which pipeline executions are notified by a channel.
+// The env variables are named after the channel, e.g. GITNESS_NOTIFICATION_PIPELINE_SLACK_BRANCHES.
+type PipelineNotificationFilter struct {
+	// OnChange limits the notifications to executions whose result differs from the previous execution of the ref.
+	OnChange bool `split_words:"true"`
+	// Branches limits the notifications to the matching branches (glob patterns).
+	Branches []string
+	// Events limits the notifications to the matching trigger events.
+	Events []string
+	// Repos limits the notifications to the repositories with a matching path (glob patterns, e.g. "space/**").
+	// Channels other than email are disabled if it's empty, as they can't check access to the repositories.
+	Repos []string
+}
@@ -0,0 +1,68 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enum
+
+// MergeQueueEntryState defines the state of a pull request in the merge queue.
+type MergeQueueEntryState string
+
+func (MergeQueueEntryState) Enum() []interface{} { return toInterfaceSlice(mergeQueueEntryStates) }
+
+func (s MergeQueueEntryState) Sanitize() (MergeQueueEntryState, bool) {
+	return Sanitize(s, GetAllMergeQueueEntryStates)
+}
+
+func GetAllMergeQueueEntryStates() ([]MergeQueueEntryState, MergeQueueEntryState) {
+	return mergeQueueEntryStates, "" // No default value
+}
+
+// MergeQueueEntryState enumeration.
+const (
+	// MergeQueueEntryStateQueued means that the speculative merge commit is yet to be created.
+	MergeQueueEntryStateQueued MergeQueueEntryState = "queued"
+	// MergeQueueEntryStateChecking means that the speculative merge commit
+	// has been created and is waiting for the status checks to complete.
+	MergeQueueEntryStateChecking MergeQueueEntryState = "checking"
+)
+
+var mergeQueueEntryStates = sortEnum([]MergeQueueEntryState{
+	MergeQueueEntryStateQueued,
+	MergeQueueEntryStateChecking,
+})
+
+// MergeQueueAction defines the merge queue related action recorded in the pull request activity.
+type MergeQueueAction string
+
+func (MergeQueueAction) Enum() []interface{} { return toInterfaceSlice(mergeQueueActions) }
+
+func (a MergeQueueAction) Sanitize() (MergeQueueAction, bool) {
+	return Sanitize(a, GetAllMergeQueueActions)
+}
+
+func GetAllMergeQueueActions() ([]MergeQueueAction, MergeQueueAction) {
+	return mergeQueueActions, "" // No default value
+}
+
+// MergeQueueAction enumeration.
+const (
+	MergeQueueActionAdded   MergeQueueAction = "added"
+	MergeQueueActionRemoved MergeQueueAction = "removed"
+	MergeQueueActionFailed  MergeQueueAction = "failed"
+)
+
+var mergeQueueActions = sortEnum([]MergeQueueAction{
+	MergeQueueActionAdded,
+	MergeQueueActionRemoved,
+	MergeQueueActionFailed,
+})
@@ -78,18 +78,26 @@ func GetAllPullReqActivityTypes() ([]PullReqActivityType, PullReqActivityType) {
 
 // PullReqActivityType enumeration.
 const (
-	PullReqActivityTypeComment        PullReqActivityType = "comment"
-	PullReqActivityTypeCodeComment    PullReqActivityType = "code-comment"
-	PullReqActivityTypeTitleChange    PullReqActivityType = "title-change"
-	PullReqActivityTypeStateChange    PullReqActivityType = "state-change"
-	PullReqActivityTypeReviewSubmit   PullReqActivityType = "review-submit"
-	PullReqActivityTypeReviewerAdd    PullReqActivityType = "reviewer-add"
-	PullReqActivityTypeReviewerDelete PullReqActivityType = "reviewer-delete"
-	PullReqActivityTypeBranchUpdate   PullReqActivityType = "branch-update"
-	PullReqActivityTypeBranchDelete   PullReqActivityType = "branch-delete"
-	PullReqActivityTypeBranchRestore  PullReqActivityType = "branch-restore"
-	PullReqActivityTypeMerge          PullReqActivityType = "merge"
-	PullReqActivityTypeLabelModify    PullReqActivityType = "label-modify"
+	PullReqActivityTypeComment            PullReqActivityType = "comment"
+	PullReqActivityTypeCodeComment        PullReqActivityType = "code-comment"
+	PullReqActivityTypeTitleChange        PullReqActivityType = "title-change"
+	PullReqActivityTypeStateChange        PullReqActivityType = "state-change"
+	PullReqActivityTypeReviewSubmit       PullReqActivityType = "review-submit"
+	PullReqActivityTypeReviewDismiss      PullReqActivityType = "review-dismiss"
+	PullReqActivityTypeReviewerAdd        PullReqActivityType = "reviewer-add"
+	PullReqActivityTypeReviewerDelete     PullReqActivityType = "reviewer-delete"
+	PullReqActivityTypeBranchUpdate       PullReqActivityType = "branch-update"
+	PullReqActivityTypeBranchDelete       PullReqActivityType = "branch-delete"
+	PullReqActivityTypeBranchRestore      PullReqActivityType = "branch-restore"
+	PullReqActivityTypeMerge              PullReqActivityType = "merge"
+	PullReqActivityTypeLabelModify        PullReqActivityType = "label-modify"
+	PullReqActivityTypeReference          PullReqActivityType = "reference"
+	PullReqActivityTypeMergeQueue         PullReqActivityType = "merge-queue"
+	PullReqActivityTypeCherryPick         PullReqActivityType = "cherry-pick"
+	PullReqActivityTypeRevert             PullReqActivityType = "revert"
+	PullReqActivityTypeTargetBranchChange PullReqActivityType = "target-branch-change"
+	PullReqActivityTypeLock               PullReqActivityType = "lock"
+	PullReqActivityTypeUnlock             PullReqActivityType = "unlock"
 )
 
 var pullReqActivityTypes = sortEnum([]PullReqActivityType{
@@ -98,6 +106,7 @@ var pullReqActivityTypes = sortEnum([]PullReqActivityType{
 	PullReqActivityTypeTitleChange,
 	PullReqActivityTypeStateChange,
 	PullReqActivityTypeReviewSubmit,
+	PullReqActivityTypeReviewDismiss,
 	PullReqActivityTypeReviewerAdd,
 	PullReqActivityTypeReviewerDelete,
 	PullReqActivityTypeBranchUpdate,
@@ -105,6 +114,13 @@ var pullReqActivityTypes = sortEnum([]PullReqActivityType{
 	PullReqActivityTypeBranchRestore,
 	PullReqActivityTypeMerge,
 	PullReqActivityTypeLabelModify,
+	PullReqActivityTypeReference,
+	PullReqActivityTypeMergeQueue,
+	PullReqActivityTypeCherryPick,
+	PullReqActivityTypeRevert,
+	PullReqActivityTypeTargetBranchChange,
+	PullReqActivityTypeLock,
+	PullReqActivityTypeUnlock,
 })
 
 // PullReqActivityKind defines kind of pull request activity system message.
@@ -272,3 +288,152 @@ var LabelActivityTypes = sortEnum([]PullReqLabelActivityType{
 	LabelActivityReassign,
 	LabelActivityNoop,
 })
+
+// PullReqReaction defines a reaction to a pull request comment or description.
+type PullReqReaction string
+
+func (PullReqReaction) Enum() []interface{} { return toInterfaceSlice(pullReqReactions) }
+
+func (r PullReqReaction) Sanitize() (PullReqReaction, bool) {
+	return Sanitize(r, GetAllPullReqReactions)
+}
+
+func GetAllPullReqReactions() ([]PullReqReaction, PullReqReaction) {
+	return pullReqReactions, "" // No default value
+}
+
+// PullReqReaction enumeration.
+const (
+	PullReqReactionThumbsUp   PullReqReaction = "+1"
+	PullReqReactionThumbsDown PullReqReaction = "-1"
+	PullReqReactionLaugh      PullReqReaction = "laugh"
+	PullReqReactionHooray     PullReqReaction = "hooray"
+	PullReqReactionConfused   PullReqReaction = "confused"
+	PullReqReactionHeart      PullReqReaction = "heart"
+	PullReqReactionRocket     PullReqReaction = "rocket"
+	PullReqReactionEyes       PullReqReaction = "eyes"
+)
+
+var pullReqReactions = sortEnum([]PullReqReaction{
+	PullReqReactionThumbsUp,
+	PullReqReactionThumbsDown,
+	PullReqReactionLaugh,
+	PullReqReactionHooray,
+	PullReqReactionConfused,
+	PullReqReactionHeart,
+	PullReqReactionRocket,
+	PullReqReactionEyes,
+})
+
+// PullReqExportFormat defines the format in which a pull request can be downloaded.
+type PullReqExportFormat string
+
+func (PullReqExportFormat) Enum() []interface{} { return toInterfaceSlice(pullReqExportFormats) }
+
+func (f PullReqExportFormat) Sanitize() (PullReqExportFormat, bool) {
+	return Sanitize(f, GetAllPullReqExportFormats)
+}
+
+func GetAllPullReqExportFormats() ([]PullReqExportFormat, PullReqExportFormat) {
+	return pullReqExportFormats, PullReqExportFormatDiff
+}
+
+// PullReqExportFormat enumeration.
+const (
+	// PullReqExportFormatDiff is the unified diff of all changes of the pull request.
+	PullReqExportFormatDiff PullReqExportFormat = "diff"
+	// PullReqExportFormatPatch is a single patch, in the email format, with all changes of the pull request.
+	PullReqExportFormatPatch PullReqExportFormat = "patch"
+	// PullReqExportFormatMbox is a series of patches, one for each commit of the pull request, in the mbox format.
+	PullReqExportFormatMbox PullReqExportFormat = "mbox"
+)
+
+var pullReqExportFormats = sortEnum([]PullReqExportFormat{
+	PullReqExportFormatDiff,
+	PullReqExportFormatPatch,
+	PullReqExportFormatMbox,
+})
+
+// PullReqCommentRevisionType defines the reason a revision of a pull request comment was recorded.
+type PullReqCommentRevisionType string
+
+func (PullReqCommentRevisionType) Enum() []interface{} {
+	return toInterfaceSlice(pullReqCommentRevisionTypes)
+}
+
+func (t PullReqCommentRevisionType) Sanitize() (PullReqCommentRevisionType, bool) {
+	return Sanitize(t, GetAllPullReqCommentRevisionTypes)
+}
+
+func GetAllPullReqCommentRevisionTypes() ([]PullReqCommentRevisionType, PullReqCommentRevisionType) {
+	return pullReqCommentRevisionTypes, PullReqCommentRevisionTypeEdit
+}
+
+// PullReqCommentRevisionType enumeration.
+const (
+	// PullReqCommentRevisionTypeEdit is the text of the comment as it was before an edit.
+	PullReqCommentRevisionTypeEdit PullReqCommentRevisionType = "edit"
+	// PullReqCommentRevisionTypeDelete is the tombstone of a deleted comment, with the text it had when deleted.
+	PullReqCommentRevisionTypeDelete PullReqCommentRevisionType = "delete"
+)
+
+var pullReqCommentRevisionTypes = sortEnum([]PullReqCommentRevisionType{
+	PullReqCommentRevisionTypeEdit,
+	PullReqCommentRevisionTypeDelete,
+})
+
+// PullReqParticipantRole defines the way a principal takes part in a pull request.
+type PullReqParticipantRole string
+
+func (PullReqParticipantRole) Enum() []interface{} { return toInterfaceSlice(pullReqParticipantRoles) }
+
+func (r PullReqParticipantRole) Sanitize() (PullReqParticipantRole, bool) {
+	return Sanitize(r, GetAllPullReqParticipantRoles)
+}
+
+func GetAllPullReqParticipantRoles() ([]PullReqParticipantRole, PullReqParticipantRole) {
+	return pullReqParticipantRoles, "" // No default value
+}
+
+// PullReqParticipantRole enumeration.
+const (
+	PullReqParticipantRoleAuthor    PullReqParticipantRole = "author"
+	PullReqParticipantRoleReviewer  PullReqParticipantRole = "reviewer"
+	PullReqParticipantRoleCommenter PullReqParticipantRole = "commenter"
+	PullReqParticipantRoleMentioned PullReqParticipantRole = "mentioned"
+)
+
+var pullReqParticipantRoles = sortEnum([]PullReqParticipantRole{
+	PullReqParticipantRoleAuthor,
+	PullReqParticipantRoleReviewer,
+	PullReqParticipantRoleCommenter,
+	PullReqParticipantRoleMentioned,
+})
+
+// PullReqLockReason defines the reason a pull request conversation has been locked.
+type PullReqLockReason string
+
+func (PullReqLockReason) Enum() []interface{} { return toInterfaceSlice(pullReqLockReasons) }
+
+func (r PullReqLockReason) Sanitize() (PullReqLockReason, bool) {
+	return Sanitize(r, GetAllPullReqLockReasons)
+}
+
+func GetAllPullReqLockReasons() ([]PullReqLockReason, PullReqLockReason) {
+	return pullReqLockReasons, "" // No default value
+}
+
+// PullReqLockReason enumeration.
+const (
+	PullReqLockReasonOffTopic  PullReqLockReason = "off_topic"
+	PullReqLockReasonTooHeated PullReqLockReason = "too_heated"
+	PullReqLockReasonResolved  PullReqLockReason = "resolved"
+	PullReqLockReasonSpam      PullReqLockReason = "spam"
+)
+
+var pullReqLockReasons = sortEnum([]PullReqLockReason{
+	PullReqLockReasonOffTopic,
+	PullReqLockReasonTooHeated,
+	PullReqLockReasonResolved,
+	PullReqLockReasonSpam,
+})
@@ -0,0 +1,54 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enum
+
+// ReviewRollupMetric defines the pull request review metric aggregated in a daily review rollup.
+type ReviewRollupMetric string
+
+func (ReviewRollupMetric) Enum() []interface{} { return toInterfaceSlice(reviewRollupMetrics) }
+
+func (m ReviewRollupMetric) Sanitize() (ReviewRollupMetric, bool) {
+	return Sanitize(m, GetAllReviewRollupMetrics)
+}
+
+func GetAllReviewRollupMetrics() ([]ReviewRollupMetric, ReviewRollupMetric) {
+	return reviewRollupMetrics, "" // No default value
+}
+
+// ReviewRollupMetric enumeration.
+const (
+	// ReviewRollupMetricOpened counts the pull requests opened on the day.
+	ReviewRollupMetricOpened ReviewRollupMetric = "opened"
+	// ReviewRollupMetricMerged counts the pull requests merged on the day, along with their total time to merge.
+	ReviewRollupMetricMerged ReviewRollupMetric = "merged"
+	// ReviewRollupMetricFirstReview counts the pull requests that received their first review on the day,
+	// along with their total time to first review.
+	ReviewRollupMetricFirstReview ReviewRollupMetric = "first_review"
+	// ReviewRollupMetricReviewed counts the reviews submitted on the day, per reviewer.
+	ReviewRollupMetricReviewed ReviewRollupMetric = "reviewed"
+	// ReviewRollupMetricApproved counts the approving reviews submitted on the day, per reviewer.
+	ReviewRollupMetricApproved ReviewRollupMetric = "approved"
+	// ReviewRollupMetricChangeReq counts the reviews requesting changes submitted on the day, per reviewer.
+	ReviewRollupMetricChangeReq ReviewRollupMetric = "changereq"
+)
+
+var reviewRollupMetrics = sortEnum([]ReviewRollupMetric{
+	ReviewRollupMetricOpened,
+	ReviewRollupMetricMerged,
+	ReviewRollupMetricFirstReview,
+	ReviewRollupMetricReviewed,
+	ReviewRollupMetricApproved,
+	ReviewRollupMetricChangeReq,
+})
@@ -21,6 +21,7 @@ type TriggerEvent string
 const (
 	TriggerEventCron        TriggerEvent = "cron"
 	TriggerEventManual      TriggerEvent = "manual"
+	TriggerEventPromote     TriggerEvent = "promote"
 	TriggerEventPush        TriggerEvent = "push"
 	TriggerEventPullRequest TriggerEvent = "pull_request"
 	TriggerEventTag         TriggerEvent = "tag"
@@ -45,6 +46,7 @@ func GetAllTriggerEvents() ([]TriggerEvent, TriggerEvent) {
 var triggerEvents = sortEnum([]TriggerEvent{
 	TriggerEventCron,
 	TriggerEventManual,
+	TriggerEventPromote,
 	TriggerEventPush,
 	TriggerEventPullRequest,
 	TriggerEventTag,
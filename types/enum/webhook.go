@@ -160,6 +160,11 @@ const (
 	WebhookTriggerPullReqMerged WebhookTrigger = "pullreq_merged"
 	// WebhookTriggerPullReqUpdated gets triggered when a pull request gets updated.
 	WebhookTriggerPullReqUpdated WebhookTrigger = "pullreq_updated"
+	// WebhookTriggerPullReqReviewSubmitted gets triggered when a review is submitted on a pull request.
+	WebhookTriggerPullReqReviewSubmitted WebhookTrigger = "pullreq_review_submitted"
+
+	// WebhookTriggerPipelineExecuted gets triggered when a pipeline execution finished.
+	WebhookTriggerPipelineExecuted WebhookTrigger = "pipeline_executed"
 )
 
 var webhookTriggers = sortEnum([]WebhookTrigger{
@@ -176,4 +181,6 @@ var webhookTriggers = sortEnum([]WebhookTrigger{
 	WebhookTriggerPullReqClosed,
 	WebhookTriggerPullReqCommentCreated,
 	WebhookTriggerPullReqMerged,
+	WebhookTriggerPullReqReviewSubmitted,
+	WebhookTriggerPipelineExecuted,
 })
@@ -65,9 +65,10 @@ type ChangeStats struct {
 }
 
 type CommitFileStats struct {
-	Path    string                 `json:"path"`
-	OldPath string                 `json:"old_path,omitempty"`
-	Status  gitenum.FileDiffStatus `json:"status"`
+	Path       string                 `json:"path"`
+	OldPath    string                 `json:"old_path,omitempty"`
+	Status     gitenum.FileDiffStatus `json:"status"`
+	Similarity int                    `json:"similarity,omitempty"` // similarity index of renamed and copied files
 	ChangeStats
 }
 
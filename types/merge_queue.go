@@ -0,0 +1,48 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "github.com/harness/gitness/types/enum"
+
+// MergeQueueEntry represents a pull request waiting in the merge queue of its target branch.
+type MergeQueueEntry struct {
+	ID            int64                     `json:"id"`
+	RepoID        int64                     `json:"repo_id"`
+	PullReqID     int64                     `json:"pullreq_id"`
+	PullReqNumber int64                     `json:"pullreq_number"`
+	TargetBranch  string                    `json:"target_branch"`
+	State         enum.MergeQueueEntryState `json:"state"`
+	Method        enum.MergeMethod          `json:"method"`
+
+	// SourceSHA is the pull request source branch commit that has been added to the queue.
+	SourceSHA string `json:"source_sha"`
+	// BaseSHA is the commit the speculative merge commit is based on:
+	// Either the target branch commit or the speculative merge commit of the previous entry.
+	BaseSHA string `json:"base_sha,omitempty"`
+	// MergeBaseSHA is the merge base of SourceSHA and BaseSHA.
+	MergeBaseSHA string `json:"merge_base_sha,omitempty"`
+	// MergeSHA is the speculative merge commit that is tested by the status checks.
+	MergeSHA string `json:"merge_sha,omitempty"`
+
+	CreatedBy int64 `json:"created_by"`
+	Created   int64 `json:"created"`
+	Updated   int64 `json:"updated"`
+}
+
+// MergeQueueKey identifies a merge queue.
+type MergeQueueKey struct {
+	RepoID       int64  `db:"merge_queue_entry_repo_id"`
+	TargetBranch string `db:"merge_queue_entry_target_branch"`
+}
@@ -0,0 +1,42 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// NotificationSettings holds the notification preferences of a principal.
+type NotificationSettings struct {
+	PrincipalID int64 `json:"-"`
+
+	// ReviewerRemindersDisabled opts the principal out of reminders about pending review requests.
+	ReviewerRemindersDisabled bool `json:"reviewer_reminders_disabled"`
+
+	// QuietHoursStart and QuietHoursEnd are the hours of the day (0-23, in Timezone) between which
+	// no reminders are sent. The range can span midnight, e.g. from 22 to 7.
+	QuietHoursStart *int   `json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd   *int   `json:"quiet_hours_end,omitempty"`
+	Timezone        string `json:"timezone,omitempty"` // IANA time zone name, UTC if empty
+
+	Updated int64 `json:"updated,omitempty"`
+}
+
+// NotificationSettingsUpdate holds the notification preferences that should be updated.
+type NotificationSettingsUpdate struct {
+	ReviewerRemindersDisabled *bool   `json:"reviewer_reminders_disabled"`
+	QuietHoursStart           *int    `json:"quiet_hours_start"`
+	QuietHoursEnd             *int    `json:"quiet_hours_end"`
+	Timezone                  *string `json:"timezone"`
+
+	// ClearQuietHours removes the quiet hours.
+	ClearQuietHours bool `json:"clear_quiet_hours"`
+}
@@ -30,14 +30,23 @@ type PullReq struct {
 	Edited    int64  `json:"edited"` // TODO: Remove. Field Edited is equal to Updated
 	Closed    *int64 `json:"closed,omitempty"`
 
+	// ContentEdited is the time of the latest edit of the title or the description, nil if never edited.
+	ContentEdited *int64 `json:"content_edited,omitempty"`
+
 	State   enum.PullReqState `json:"state"`
 	IsDraft bool              `json:"is_draft"`
 
+	// Locked is the time the conversation got locked, nil if the conversation isn't locked.
+	// Only principals with the repo edit permission can comment on pull requests with a locked conversation.
+	Locked     *int64                  `json:"locked,omitempty"`
+	LockReason *enum.PullReqLockReason `json:"lock_reason,omitempty"`
+
 	CommentCount    int `json:"-"` // returned as "conversations" in the Stats
 	UnresolvedCount int `json:"-"` // returned as "unresolved_count" in the Stats
 
 	Title       string `json:"title"`
 	Description string `json:"description"`
+	Template    string `json:"template,omitempty"` // name of the template used for the description
 
 	SourceRepoID int64  `json:"source_repo_id"`
 	SourceBranch string `json:"source_branch"`
@@ -140,6 +149,16 @@ type PullReqStats struct {
 	DiffStats
 	Conversations   int `json:"conversations,omitempty"`
 	UnresolvedCount int `json:"unresolved_count,omitempty"`
+
+	// Approvals and ChangeRequests count reviewers by their latest review decision.
+	// ApprovalsLatest counts only approvals given for the current source SHA.
+	Approvals       int `json:"approvals,omitempty"`
+	ApprovalsLatest int `json:"approvals_latest,omitempty"`
+	ChangeRequests  int `json:"change_requests,omitempty"`
+
+	// Files are the cached per-file diff statistics. They're only returned for a single pull request
+	// and only if the pull request doesn't change too many files.
+	Files []CommitFileStats `json:"files,omitempty"`
 }
 
 // PullReqFilter stores pull request query parameters.
@@ -171,6 +190,8 @@ type PullReqFilter struct {
 	// internal use only
 	SpaceIDs        []int64
 	RepoIDBlacklist []int64
+	SearchText      string   // matched against the title, the description and the comments
+	LabelKeys       []string // pull request must have a label with each of the keys
 }
 
 // PullReqReview holds pull request review.
@@ -222,6 +243,10 @@ type UserGroupReviewer struct {
 
 	//	Reviewers Info
 	Reviewers []UserGroupReviewerDecision `json:"reviewers"`
+
+	// Decision is the aggregated decision of the group: An approval of any member satisfies the request.
+	Decision enum.PullReqReviewDecision `json:"decision"`
+	SHA      string                     `json:"sha,omitempty"`
 }
 
 type UserGroupReviewerDecision struct {
@@ -244,6 +269,128 @@ type PullReqFileView struct {
 	Updated int64 `json:"-"`
 }
 
+// PullReqTemplate represents a pull request description template stored in the repository.
+type PullReqTemplate struct {
+	Name    string `json:"name"`
+	Path    string `json:"path"`
+	Content string `json:"content"`
+}
+
+// PullReqReaction represents a reaction of a principal to a pull request comment or description.
+type PullReqReaction struct {
+	PullReqID   int64 `json:"-"`
+	ActivityID  int64 `json:"-"` // zero for reactions to the pull request description
+	PrincipalID int64 `json:"-"`
+
+	Reaction enum.PullReqReaction `json:"reaction"`
+
+	Created int64 `json:"-"`
+}
+
+// PullReqReactionSummary aggregates all reactions of the same kind to a pull request comment or description.
+type PullReqReactionSummary struct {
+	Reaction     enum.PullReqReaction `json:"reaction"`
+	Count        int                  `json:"count"`
+	PrincipalIDs []int64              `json:"principal_ids"`
+}
+
+// PullReqEdit is a prior version of the title and the description of a pull request,
+// recorded when the pull request is edited.
+type PullReqEdit struct {
+	ID        int64 `json:"id"`
+	PullReqID int64 `json:"-"`
+
+	CreatedBy int64 `json:"-"` // not returned, because the editor info is in the Editor field
+	Created   int64 `json:"created"`
+
+	Title       string `json:"title"`
+	Description string `json:"description"`
+
+	// Editor is the principal who replaced this version of the title and the description.
+	Editor PrincipalInfo `json:"editor"`
+}
+
+// PullReqDependency declares that a pull request can't be merged before another pull request is merged.
+type PullReqDependency struct {
+	PullReqID   int64 `json:"-"`
+	DependsOnID int64 `json:"-"`
+
+	CreatedBy int64 `json:"-"`
+	Created   int64 `json:"created"`
+}
+
+// PullReqDependencies is the dependency chain of a pull request.
+type PullReqDependencies struct {
+	// DependsOn lists the pull requests the pull request depends on,
+	// first the direct dependencies and then the transitive ones.
+	DependsOn []*PullReq `json:"depends_on"`
+
+	// Dependents lists the pull requests that directly depend on the pull request.
+	Dependents []*PullReq `json:"dependents"`
+}
+
+// PullReqFileStats are the cached per-file diff statistics of a pull request,
+// computed for the pull request's source SHA and merge base SHA.
+type PullReqFileStats struct {
+	PullReqID    int64             `json:"-"`
+	SourceSHA    string            `json:"source_sha"`
+	MergeBaseSHA string            `json:"merge_base_sha"`
+	Files        []CommitFileStats `json:"files"`
+	Updated      int64             `json:"updated"`
+}
+
+// PullReqMention is a principal mentioned in the description of a pull request.
+type PullReqMention struct {
+	PullReqID   int64 `json:"-"`
+	PrincipalID int64 `json:"principal_id"`
+
+	CreatedBy int64 `json:"-"`
+	Created   int64 `json:"created"`
+}
+
+// PullReqParticipant is a principal taking part in a pull request, with all the roles it has in it.
+type PullReqParticipant struct {
+	Principal PrincipalInfo                 `json:"principal"`
+	Roles     []enum.PullReqParticipantRole `json:"roles"`
+}
+
+// PullReqCommentRevision is a prior version of a pull request comment,
+// recorded when the comment is edited or deleted.
+type PullReqCommentRevision struct {
+	ID         int64 `json:"id"`
+	ActivityID int64 `json:"-"`
+
+	Type enum.PullReqCommentRevisionType `json:"type"`
+
+	CreatedBy int64 `json:"-"` // not returned, because the author info is in the Author field
+	Created   int64 `json:"created"`
+
+	Text string `json:"text"`
+
+	// Author is the principal who edited or deleted the comment.
+	Author PrincipalInfo `json:"author"`
+}
+
+// CherryPickOutput is the result of cherry-picking a merged pull request to another branch.
+type CherryPickOutput struct {
+	CommitSHA string `json:"commit_sha,omitempty"`
+	// Branch is the branch that contains the new commit.
+	Branch string `json:"branch,omitempty"`
+	// PullReq is the backport pull request, if requested.
+	PullReq *PullReq `json:"pull_request,omitempty"`
+	DryRunRulesOutput
+}
+
+// RevertOutput is the result of reverting a merged pull request.
+type RevertOutput struct {
+	CommitSHA string `json:"commit_sha,omitempty"`
+	// Branch is the new branch that contains the revert commit.
+	Branch string `json:"branch,omitempty"`
+	// PullReq is the pull request that reverts the changes.
+	PullReq *PullReq `json:"pull_request,omitempty"`
+	DryRunRulesOutput
+}
+
 type MergeResponse struct {
 	SHA            string           `json:"sha,omitempty"`
 	BranchDeleted  bool             `json:"branch_deleted,omitempty"`
@@ -51,12 +51,18 @@ type PullReqActivity struct {
 	ResolvedBy *int64 `json:"-"` // not returned, because the resolver info is in the Resolver field
 	Resolved   *int64 `json:"resolved,omitempty"`
 
+	// Pending is set for comments that are part of a review that hasn't been submitted yet.
+	// Pending comments are visible only to their author.
+	Pending bool `json:"pending,omitempty"`
+
 	Author   PrincipalInfo  `json:"author"`
 	Resolver *PrincipalInfo `json:"resolver,omitempty"`
 
 	CodeComment *CodeCommentFields `json:"code_comment,omitempty"`
 
 	Mentions map[int64]*PrincipalInfo `json:"mentions,omitempty"` // used only in response
+
+	Reactions []PullReqReactionSummary `json:"reactions,omitempty"` // used only in response
 }
 
 func (a *PullReqActivity) IsValidCodeComment() bool {
@@ -163,6 +169,23 @@ type PullReqActivityFilter struct {
 	Before int64 `json:"before"`
 	Limit  int   `json:"limit"`
 
+	// AfterID together with After forms a (created, id) cursor: Only activities created after the
+	// After timestamp, or created at it with an ID greater than AfterID, are returned.
+	// Limited lists are ordered by the cursor, so the created time and the ID of the last activity
+	// of a page are the cursor of the next page.
+	AfterID int64 `json:"after_id"`
+
 	Types []enum.PullReqActivityType `json:"type"`
 	Kinds []enum.PullReqActivityKind `json:"kind"`
+
+	AuthorID int64 `json:"author_id"`
+
+	// CommentStatus and Path are applied to whole comment threads:
+	// A reply is included if the top-level comment of its thread matches.
+	CommentStatus enum.PullReqCommentStatus `json:"comment_status"`
+	Path          string                    `json:"path"`
+
+	// PendingAuthorID is the ID of the principal whose pending comments should be included.
+	// Pending comments of all other principals are always excluded.
+	PendingAuthorID int64 `json:"-"`
 }
@@ -58,9 +58,19 @@ var allPullReqActivityPayloads = func(
 	func() PullReqActivityPayload { return &PullRequestActivityPayloadStateChange{} },
 	func() PullReqActivityPayload { return &PullRequestActivityPayloadTitleChange{} },
 	func() PullReqActivityPayload { return &PullRequestActivityPayloadReviewSubmit{} },
+	func() PullReqActivityPayload { return &PullRequestActivityPayloadReviewDismiss{} },
+	func() PullReqActivityPayload { return &PullRequestActivityPayloadReviewerAdd{} },
+	func() PullReqActivityPayload { return &PullRequestActivityPayloadReviewerDelete{} },
 	func() PullReqActivityPayload { return &PullRequestActivityPayloadBranchUpdate{} },
 	func() PullReqActivityPayload { return &PullRequestActivityPayloadBranchDelete{} },
 	func() PullReqActivityPayload { return &PullRequestActivityPayloadBranchRestore{} },
+	func() PullReqActivityPayload { return &PullRequestActivityPayloadReference{} },
+	func() PullReqActivityPayload { return &PullRequestActivityPayloadMergeQueue{} },
+	func() PullReqActivityPayload { return &PullRequestActivityPayloadCherryPick{} },
+	func() PullReqActivityPayload { return &PullRequestActivityPayloadRevert{} },
+	func() PullReqActivityPayload { return &PullRequestActivityPayloadTargetBranchChange{} },
+	func() PullReqActivityPayload { return &PullRequestActivityPayloadLock{} },
+	func() PullReqActivityPayload { return &PullRequestActivityPayloadUnlock{} },
 })
 
 // newPayloadForActivity returns a new payload instance for the requested activity type.
@@ -131,6 +141,17 @@ func (a *PullRequestActivityPayloadReviewSubmit) ActivityType() enum.PullReqActi
 	return enum.PullReqActivityTypeReviewSubmit
 }
 
+// PullRequestActivityPayloadReviewDismiss is the payload of the activity written
+// when approvals are dismissed because new commits were pushed to the source branch.
+type PullRequestActivityPayloadReviewDismiss struct {
+	CommitSHA    string  `json:"commit_sha"`
+	PrincipalIDs []int64 `json:"principal_ids"`
+}
+
+func (a *PullRequestActivityPayloadReviewDismiss) ActivityType() enum.PullReqActivityType {
+	return enum.PullReqActivityTypeReviewDismiss
+}
+
 type PullRequestActivityPayloadReviewerAdd struct {
 	PrincipalID  int64                    `json:"principal_id"`
 	ReviewerType enum.PullReqReviewerType `json:"reviewer_type"`
@@ -178,6 +199,63 @@ func (a *PullRequestActivityPayloadBranchRestore) ActivityType() enum.PullReqAct
 	return enum.PullReqActivityTypeBranchRestore
 }
 
+// PullRequestActivityPayloadReference is the payload of the activity written to both pull requests
+// when a merged pull request references another one using a closing keyword (e.g. "fixes #123").
+type PullRequestActivityPayloadReference struct {
+	// Number is the number of the other pull request.
+	Number int64 `json:"number"`
+	// Incoming is true if the activity is written to the referenced pull request.
+	Incoming bool `json:"incoming"`
+	// Closed is true if the referenced pull request got closed because of the reference.
+	Closed bool `json:"closed"`
+}
+
+func (a *PullRequestActivityPayloadReference) ActivityType() enum.PullReqActivityType {
+	return enum.PullReqActivityTypeReference
+}
+
+// PullRequestActivityPayloadMergeQueue is the payload of the activity written
+// when a pull request is added to or removed from the merge queue.
+type PullRequestActivityPayloadMergeQueue struct {
+	Action       enum.MergeQueueAction `json:"action"`
+	TargetBranch string                `json:"target_branch"`
+	// Reason explains why the pull request has been removed from the merge queue.
+	Reason string `json:"reason,omitempty"`
+}
+
+func (a *PullRequestActivityPayloadMergeQueue) ActivityType() enum.PullReqActivityType {
+	return enum.PullReqActivityTypeMergeQueue
+}
+
+// PullRequestActivityPayloadCherryPick is the payload of the activity written when a merged pull request
+// is cherry-picked to another branch. If a backport pull request is opened, the activity is written to both.
+type PullRequestActivityPayloadCherryPick struct {
+	TargetBranch string `json:"target_branch"`
+	CommitSHA    string `json:"commit_sha"`
+	// Number is the number of the other pull request, zero if no backport pull request is opened.
+	Number int64 `json:"number,omitempty"`
+	// Incoming is true if the activity is written to the backport pull request.
+	Incoming bool `json:"incoming"`
+}
+
+func (a *PullRequestActivityPayloadCherryPick) ActivityType() enum.PullReqActivityType {
+	return enum.PullReqActivityTypeCherryPick
+}
+
+// PullRequestActivityPayloadRevert is the payload of the activity written to both pull requests
+// when a pull request that reverts a merged pull request is opened.
+type PullRequestActivityPayloadRevert struct {
+	// Number is the number of the other pull request.
+	Number int64 `json:"number"`
+	// Incoming is true if the activity is written to the reverting pull request.
+	Incoming  bool   `json:"incoming"`
+	CommitSHA string `json:"commit_sha"`
+}
+
+func (a *PullRequestActivityPayloadRevert) ActivityType() enum.PullReqActivityType {
+	return enum.PullReqActivityTypeRevert
+}
+
 type PullRequestActivityLabel struct {
 	Label         string                        `json:"label"`
 	LabelColor    enum.LabelColor               `json:"label_color"`
@@ -192,3 +270,35 @@ type PullRequestActivityLabel struct {
 func (a *PullRequestActivityLabel) ActivityType() enum.PullReqActivityType {
 	return enum.PullReqActivityTypeLabelModify
 }
+
+// PullRequestActivityPayloadTargetBranchChange is the payload of the activity written
+// when the target branch of a pull request is changed, e.g. when the pull request it depends on is merged.
+type PullRequestActivityPayloadTargetBranchChange struct {
+	Old string `json:"old"`
+	New string `json:"new"`
+
+	// DependencyNumber is the number of the merged pull request that caused the change, if any.
+	DependencyNumber int64 `json:"dependency_number,omitempty"`
+}
+
+func (a *PullRequestActivityPayloadTargetBranchChange) ActivityType() enum.PullReqActivityType {
+	return enum.PullReqActivityTypeTargetBranchChange
+}
+
+// PullRequestActivityPayloadLock is the payload of the activity written when the conversation is locked.
+type PullRequestActivityPayloadLock struct {
+	Reason enum.PullReqLockReason `json:"reason"`
+}
+
+func (a *PullRequestActivityPayloadLock) ActivityType() enum.PullReqActivityType {
+	return enum.PullReqActivityTypeLock
+}
+
+// PullRequestActivityPayloadUnlock is the payload of the activity written when the conversation is unlocked.
+type PullRequestActivityPayloadUnlock struct {
+	Reason enum.PullReqLockReason `json:"reason"` // the reason the conversation had been locked for
+}
+
+func (a *PullRequestActivityPayloadUnlock) ActivityType() enum.PullReqActivityType {
+	return enum.PullReqActivityTypeUnlock
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 Harness, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "github.com/harness/gitness/types/enum"
+
+// ReviewRollup is a daily aggregate of a pull request review metric.
+type ReviewRollup struct {
+	RepoID      int64                   `json:"repo_id"`
+	Day         int64                   `json:"day"` // start of the UTC day, in milliseconds
+	Metric      enum.ReviewRollupMetric `json:"metric"`
+	PrincipalID int64                   `json:"principal_id"` // the reviewer, zero for metrics that aren't per reviewer
+	Count       int64                   `json:"count"`
+	Duration    int64                   `json:"duration"` // total duration in milliseconds, if applicable
+}
+
+// ReviewRollupFilter selects the review rollups of a repository or of the repositories in a set of spaces.
+type ReviewRollupFilter struct {
+	RepoID   int64
+	SpaceIDs []int64
+	From     int64
+	To       int64
+}
+
+// ReviewAnalyticsFilter defines the time range of review analytics, in milliseconds.
+type ReviewAnalyticsFilter struct {
+	Since int64 `json:"since"`
+	Until int64 `json:"until"`
+}
+
+// ReviewAnalytics holds the aggregated review metrics of a repository or a space.
+type ReviewAnalytics struct {
+	Since             int64               `json:"since"`
+	Until             int64               `json:"until"`
+	TimeToFirstReview ReviewDurationStats `json:"time_to_first_review"`
+	TimeToMerge       ReviewDurationStats `json:"time_to_merge"`
+	Throughput        []ReviewThroughput  `json:"throughput"`
+	ReviewLoad        []ReviewerLoad      `json:"review_load"`
+}
+
+// ReviewDurationStats holds the number of pull requests and their average duration in milliseconds.
+type ReviewDurationStats struct {
+	Count   int64 `json:"count"`
+	Average int64 `json:"average"`
+}
+
+// ReviewThroughput holds the number of pull requests opened and merged on a day.
+type ReviewThroughput struct {
+	Day    int64 `json:"day"`
+	Opened int64 `json:"opened"`
+	Merged int64 `json:"merged"`
+}
+
+// ReviewerLoad holds the number of reviews submitted by a reviewer.
+type ReviewerLoad struct {
+	Reviewer         *PrincipalInfo `json:"reviewer"`
+	Reviews          int64          `json:"reviews"`
+	Approved         int64          `json:"approved"`
+	ChangesRequested int64          `json:"changes_requested"`
+}
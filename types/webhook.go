@@ -41,9 +41,24 @@ type Webhook struct {
 	Enabled               bool                         `json:"enabled"`
 	Insecure              bool                         `json:"insecure"`
 	Triggers              []enum.WebhookTrigger        `json:"triggers"`
+	ExtraHeaders          []WebhookExtraHeader         `json:"-"`
 	LatestExecutionResult *enum.WebhookExecutionResult `json:"latest_execution_result,omitempty"`
 }
 
+// WebhookExtraHeader represents a custom header that is sent with every execution of a webhook.
+// The value might contain credentials, it's stored encrypted (base64 encoded) as the secret of the webhook
+// and isn't returned by the API (see MarshalJSON).
+type WebhookExtraHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// WebhookExtraHeaderInfo represents a custom header of a webhook as returned by the API, without its value.
+type WebhookExtraHeaderInfo struct {
+	Key      string `json:"key"`
+	HasValue bool   `json:"has_value"`
+}
+
 // MarshalJSON overrides the default json marshaling for `Webhook` allowing us to inject the `HasSecret` field.
 // NOTE: This is required as we don't expose the `Secret` field and thus the caller wouldn't know whether
 // the webhook contains a secret or not.
@@ -55,17 +70,36 @@ func (w *Webhook) MarshalJSON() ([]byte, error) {
 	type WebhookAlias Webhook
 	return json.Marshal(&struct {
 		*WebhookAlias
-		HasSecret bool `json:"has_secret"`
+		HasSecret    bool                     `json:"has_secret"`
+		ExtraHeaders []WebhookExtraHeaderInfo `json:"extra_headers"`
 		// TODO [CODE-1363]: remove after identifier migration.
 		UID string `json:"uid"`
 	}{
 		WebhookAlias: (*WebhookAlias)(w),
 		HasSecret:    w != nil && w.Secret != "",
+		ExtraHeaders: w.extraHeaderInfos(),
 		// TODO [CODE-1363]: remove after identifier migration.
 		UID: w.Identifier,
 	})
 }
 
+// extraHeaderInfos returns the extra headers of the webhook without their values.
+func (w *Webhook) extraHeaderInfos() []WebhookExtraHeaderInfo {
+	if w == nil {
+		return nil
+	}
+
+	infos := make([]WebhookExtraHeaderInfo, len(w.ExtraHeaders))
+	for i, header := range w.ExtraHeaders {
+		infos[i] = WebhookExtraHeaderInfo{
+			Key:      header.Key,
+			HasValue: header.Value != "",
+		}
+	}
+
+	return infos
+}
+
 // WebhookExecution represents a single execution of a webhook.
 type WebhookExecution struct {
 	ID            int64                       `json:"id"`